@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// AuditListOptions filter ListAuditEvents and AllAuditEvents
+type AuditListOptions struct {
+	ListOptions           // Sortable by id and created_at; newest first by default
+	Entity      string    // Only events for this entity type, e.g. Projects
+	EntityID    int       // Only events for this entity ID
+	ActorID     int       // Only events caused by this user
+	Since       time.Time // Only events at or after this time
+	Until       time.Time // Only events before this time
+}
+
+// ListAuditEvents returns one page of the audit log. Requires the admin role.
+func (c *Client) ListAuditEvents(ctx context.Context, opts *AuditListOptions) (*Page[AuditEventResponse], error) {
+	o := orZero(opts)
+	q := o.values()
+	setString(q, "entity", o.Entity)
+	setInt(q, "entity_id", o.EntityID)
+	setInt(q, "actor", o.ActorID)
+	setTime(q, "since", o.Since)
+	setTime(q, "until", o.Until)
+	return list[AuditEventResponse](ctx, c, "/api/audit", q)
+}
+
+// AllAuditEvents iterates over every audit event matching opts, fetching
+// pages as needed. Requires the admin role.
+func (c *Client) AllAuditEvents(ctx context.Context, opts *AuditListOptions) iter.Seq2[AuditEventResponse, error] {
+	o := orZero(opts)
+	return paginate(o.ListOptions, func(page ListOptions) (*Page[AuditEventResponse], error) {
+		o.ListOptions = page
+		return c.ListAuditEvents(ctx, &o)
+	})
+}
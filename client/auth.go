@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"project-manager/internal/models"
+)
+
+// Login exchanges an email and password for a token pair, which the client
+// then sends with every request and refreshes when the access token expires
+func (c *Client) Login(ctx context.Context, email, password string) (*TokenResponse, error) {
+	tokens := &TokenResponse{}
+	_, err := c.do(ctx, &request{
+		method:    http.MethodPost,
+		path:      "/api/auth/login",
+		body:      models.LoginData{Email: email, Password: password},
+		anonymous: true,
+	}, tokens)
+	if err != nil {
+		return nil, err
+	}
+	c.setTokens(tokens)
+	return tokens, nil
+}
+
+// Refresh exchanges the current refresh token for a new token pair. The
+// client calls it by itself when a request is rejected with 401.
+func (c *Client) Refresh(ctx context.Context) (*TokenResponse, error) {
+	_, refresh := c.Tokens()
+	if refresh == "" {
+		return nil, errors.New("client: no refresh token; call Login first")
+	}
+
+	tokens := &TokenResponse{}
+	_, err := c.do(ctx, &request{
+		method:    http.MethodPost,
+		path:      "/api/auth/refresh",
+		body:      models.RefreshData{RefreshToken: refresh},
+		anonymous: true,
+	}, tokens)
+	if err != nil {
+		return nil, err
+	}
+	c.setTokens(tokens)
+	return tokens, nil
+}
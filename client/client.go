@@ -0,0 +1,266 @@
+// Package client is a typed Go client for the project manager API. Request
+// and response bodies are the types of internal/models, re-exported in
+// types.go so that other modules can name them.
+//
+// Writes to projects, packages and clients are guarded by ETags: pass the
+// ETag returned by the last read or write of the entity, or AnyVersion to
+// overwrite whatever version is current.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AnyVersion may be passed instead of an ETag to skip the version check
+const AnyVersion = "*"
+
+// mergePatchType is the media type of the Patch methods' bodies (RFC 7396)
+const mergePatchType = "application/merge-patch+json"
+
+const (
+	defaultAttempts = 3
+	defaultBackoff  = 200 * time.Millisecond
+	maxBackoff      = 5 * time.Second
+)
+
+// Client calls the API at one base URL. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	attempts   int           // Tries per request, including the first
+	backoff    time.Duration // Delay before the first retry; doubled for each further one
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sends requests through hc instead of http.DefaultClient
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithTokens authenticates requests with an access token saved from an
+// earlier Login. When refresh is set, an expired access token is renewed
+// with it and the request sent again.
+func WithTokens(access, refresh string) Option {
+	return func(c *Client) { c.accessToken, c.refreshToken = access, refresh }
+}
+
+// WithRetries sets how many times a request is tried and the delay before
+// the first retry. Each further retry waits twice as long, up to 5s, with
+// jitter. Pass 1 attempt to disable retries.
+func WithRetries(attempts int, backoff time.Duration) Option {
+	return func(c *Client) { c.attempts, c.backoff = max(attempts, 1), backoff }
+}
+
+// New returns a client for the API served at baseURL, e.g. https://pm.example.com
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("client: base URL must be an absolute http or https URL, got %q", baseURL)
+	}
+
+	c := &Client{
+		baseURL:    strings.TrimSuffix(u.String(), "/"),
+		httpClient: http.DefaultClient,
+		attempts:   defaultAttempts,
+		backoff:    defaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// Tokens returns the current access and refresh tokens, so that they can be
+// saved and passed to WithTokens later
+func (c *Client) Tokens() (access, refresh string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.accessToken, c.refreshToken
+}
+
+func (c *Client) setTokens(tokens *TokenResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.accessToken, c.refreshToken = tokens.AccessToken, tokens.RefreshToken
+}
+
+// request describes one API call
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	body        any
+	contentType string // Defaults to application/json when body is set
+	ifMatch     string
+	anonymous   bool // Sent without a bearer token and never refreshed, as for the auth routes
+}
+
+// do sends req and decodes a successful JSON response into out, which may be
+// nil. It returns the response headers. Failed responses are returned as
+// *Error. Idempotent requests are retried after network errors and 429,
+// 502, 503 and 504 responses; any request that is rejected with 401 is sent
+// again once after refreshing the access token.
+func (c *Client) do(ctx context.Context, req *request, out any) (http.Header, error) {
+	var body []byte
+	if req.body != nil {
+		var err error
+		if body, err = json.Marshal(req.body); err != nil {
+			return nil, fmt.Errorf("client: encode %s %s body: %w", req.method, req.path, err)
+		}
+	}
+
+	refreshed := false
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, req, body)
+
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && !req.anonymous && !refreshed && c.canRefresh() {
+			discard(resp)
+			if _, err := c.Refresh(ctx); err != nil {
+				return nil, err
+			}
+			refreshed = true
+			attempt--
+			continue
+		}
+
+		if attempt < c.attempts && retryable(req.method, resp, err) && ctx.Err() == nil {
+			delay := c.delay(attempt, resp)
+			if resp != nil {
+				discard(resp)
+			}
+			select {
+			case <-time.After(delay):
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			return resp.Header, decodeError(resp)
+		}
+		if out != nil {
+			if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+				return resp.Header, fmt.Errorf("client: decode %s %s response: %w", req.method, req.path, err)
+			}
+		}
+		return resp.Header, nil
+	}
+}
+
+// tagged sends req and returns the entity it answers with, and its ETag
+func tagged[T any](ctx context.Context, c *Client, req *request) (*T, string, error) {
+	v := new(T)
+	header, err := c.do(ctx, req, v)
+	if err != nil {
+		return nil, "", err
+	}
+	return v, header.Get("ETag"), nil
+}
+
+// send makes one attempt at req
+func (c *Client) send(ctx context.Context, req *request, body []byte) (*http.Response, error) {
+	target := c.baseURL + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	r, err := http.NewRequestWithContext(ctx, req.method, target, reader)
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+
+	r.Header.Set("Accept", "application/json, application/problem+json")
+	if body != nil {
+		contentType := req.contentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		r.Header.Set("Content-Type", contentType)
+	}
+	if req.ifMatch != "" {
+		r.Header.Set("If-Match", req.ifMatch)
+	}
+	if !req.anonymous {
+		if access, _ := c.Tokens(); access != "" {
+			r.Header.Set("Authorization", "Bearer "+access)
+		}
+	}
+
+	return c.httpClient.Do(r)
+}
+
+func (c *Client) canRefresh() bool {
+	_, refresh := c.Tokens()
+	return refresh != ""
+}
+
+// retryable reports whether a failed attempt may be repeated. Only methods
+// that are idempotent by definition are retried. A retried write whose first
+// attempt did succeed answers 412 or 404, because If-Match no longer matches.
+func retryable(method string, resp *http.Response, err error) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+	default:
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// delay returns how long to wait before the retry that follows attempt. A
+// Retry-After header in seconds is honoured up to the maximum backoff.
+func (c *Client) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, maxBackoff)
+		}
+	}
+
+	d := min(c.backoff<<(attempt-1), maxBackoff)
+	if d <= 0 {
+		return 0
+	}
+	// Half fixed and half random, so that clients failing together spread out
+	return d/2 + rand.N(d/2+1)
+}
+
+// discard drains and closes a response that is not used, so its connection can be reused
+func discard(resp *http.Response) {
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+}
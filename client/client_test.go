@@ -0,0 +1,247 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"project-manager/client"
+	"project-manager/ent/users"
+	"project-manager/internal/config"
+	"project-manager/internal/testutil"
+)
+
+const (
+	adminEmail    = "admin@example.com"
+	adminPassword = "correct-horse-battery"
+)
+
+// newAPI serves the full router over a fresh in-memory database with one
+// admin user and returns its URL
+func newAPI(t *testing.T) string {
+	t.Helper()
+
+	app := testutil.NewApp(t, config.Features{}, config.Server{})
+	testutil.CreateUser(t, app.Client, adminEmail, "", adminPassword, users.RoleAdmin)
+
+	srv := httptest.NewServer(app.Router)
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+// newClient returns a client logged in as the seeded admin
+func newClient(t *testing.T, baseURL string, opts ...client.Option) *client.Client {
+	t.Helper()
+
+	c, err := client.New(baseURL, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Login(context.Background(), adminEmail, adminPassword); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	return c
+}
+
+func TestPackageLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t, newAPI(t))
+
+	created, etag, err := c.CreatePackage(ctx, client.PackageData{Name: "ent", Link: "https://entgo.io", Stacks: []string{"Go"}})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.ID == 0 || etag == "" {
+		t.Fatalf("create returned ID %d and ETag %q", created.ID, etag)
+	}
+
+	data := created.PackageData
+	data.Description = "Entity framework"
+	updated, newTag, err := c.UpdatePackage(ctx, created.ID, etag, data)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.Description != "Entity framework" || newTag == etag {
+		t.Errorf("update returned %+v with ETag %q", updated, newTag)
+	}
+
+	// The first ETag is stale now
+	if _, _, err := c.UpdatePackage(ctx, created.ID, etag, data); !client.IsPreconditionFailed(err) {
+		t.Errorf("update with a stale ETag: err = %v, want 412", err)
+	}
+
+	patched, newTag, err := c.PatchPackage(ctx, created.ID, newTag, map[string]any{"description": nil})
+	if err != nil {
+		t.Fatalf("patch: %v", err)
+	}
+	if patched.Description != "" || patched.Link != "https://entgo.io" {
+		t.Errorf("patch returned %+v", patched)
+	}
+
+	if err := c.DeletePackage(ctx, created.ID, newTag); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, _, err := c.GetPackage(ctx, created.ID); !client.IsNotFound(err) {
+		t.Errorf("get after delete: err = %v, want 404", err)
+	}
+	if _, _, err := c.RestorePackage(ctx, created.ID); err != nil {
+		t.Errorf("restore: %v", err)
+	}
+}
+
+func TestValidationErrorsAreDecoded(t *testing.T) {
+	c := newClient(t, newAPI(t))
+
+	_, _, err := c.CreateClient(context.Background(), client.ClientData{Name: " Acme"})
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *client.Error", err)
+	}
+	if apiErr.StatusCode != http.StatusUnprocessableEntity || apiErr.Code != "validation_failed" {
+		t.Errorf("got %d %s, want 422 validation_failed", apiErr.StatusCode, apiErr.Code)
+	}
+	var fields []string
+	for _, fe := range apiErr.Errors {
+		fields = append(fields, fe.Field+":"+fe.Rule)
+	}
+	if got := strings.Join(fields, " "); got != "name:trimmed imageUrl:required" {
+		t.Errorf("errors = %s", got)
+	}
+}
+
+func TestAllProjectsFollowsCursors(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t, newAPI(t))
+
+	for i := 1; i <= 5; i++ {
+		_, _, err := c.CreateProject(ctx, client.ProjectData{
+			Name:        fmt.Sprintf("Project %d", i),
+			ImageUrl:    "https://example.com/image.png",
+			Link:        "https://example.com",
+			Description: "Sample",
+			Stacks:      []string{"Go"},
+		})
+		if err != nil {
+			t.Fatalf("create project %d: %v", i, err)
+		}
+	}
+
+	opts := &client.ProjectListOptions{ListOptions: client.ListOptions{Limit: 2, Sort: "-id"}, Stack: "go"}
+	page, err := c.ListProjects(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 2 || page.Total != 5 || page.NextCursor == "" {
+		t.Fatalf("first page has %d items of %d and cursor %q", len(page.Items), page.Total, page.NextCursor)
+	}
+
+	var ids []int
+	for project, err := range c.AllProjects(ctx, opts) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, project.ID)
+	}
+	if got := fmt.Sprint(ids); got != "[5 4 3 2 1]" {
+		t.Errorf("ids = %s, want [5 4 3 2 1]", got)
+	}
+
+	// Breaking out early stops the iteration
+	count := 0
+	for range c.AllProjects(ctx, opts) {
+		if count++; count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("iterated %d projects after break, want 3", count)
+	}
+}
+
+func TestExpiredAccessTokenIsRefreshed(t *testing.T) {
+	ctx := context.Background()
+	baseURL := newAPI(t)
+	_, refresh := newClient(t, baseURL).Tokens()
+	acme := client.ClientData{Name: "Acme", Link: "https://acme.example.com", ImageUrl: "https://acme.example.com/logo.png"}
+
+	c, err := client.New(baseURL, client.WithTokens("expired", refresh))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.CreateClient(ctx, acme); err != nil {
+		t.Fatalf("create with an expired token: %v", err)
+	}
+	if access, _ := c.Tokens(); access == "expired" {
+		t.Error("access token was not replaced")
+	}
+
+	// Without a refresh token the 401 is returned as is
+	c, _ = client.New(baseURL, client.WithTokens("expired", ""))
+	_, _, err = c.CreateClient(ctx, acme)
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("err = %v, want 401", err)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	cases := []struct {
+		name     string
+		method   string
+		failures int // Responses answered with status before succeeding
+		status   int
+		calls    int
+		wantErr  bool
+	}{
+		{"GET recovers", http.MethodGet, 2, http.StatusServiceUnavailable, 3, false},
+		{"GET gives up", http.MethodGet, 5, http.StatusBadGateway, 3, true},
+		{"POST is not retried", http.MethodPost, 1, http.StatusServiceUnavailable, 1, true},
+		{"client errors are not retried", http.MethodGet, 1, http.StatusNotFound, 1, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if int(calls.Add(1)) <= tc.failures {
+					w.Header().Set("Content-Type", "text/plain")
+					w.WriteHeader(tc.status)
+					w.Write([]byte("upstream unavailable"))
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":1,"name":"Go","slug":"go"}`))
+			}))
+			defer srv.Close()
+
+			c, err := client.New(srv.URL, client.WithRetries(3, time.Millisecond))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.method == http.MethodGet {
+				_, err = c.GetStack(context.Background(), "go")
+			} else {
+				_, err = c.CreateStack(context.Background(), client.StackData{Name: "Go"})
+			}
+
+			if got := int(calls.Load()); got != tc.calls {
+				t.Errorf("server saw %d calls, want %d", got, tc.calls)
+			}
+			if !tc.wantErr {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			var apiErr *client.Error
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tc.status || apiErr.Detail != "upstream unavailable" {
+				t.Errorf("err = %#v, want status %d with the body as detail", err, tc.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ClientListOptions filter ListClients and AllClients
+type ClientListOptions struct {
+	ListOptions            // Sortable by id, name, created_at and updated_at
+	NameContains string    // Only clients whose name contains this text, ignoring case
+	UpdatedSince time.Time // Only clients updated at or after this time
+}
+
+// CreateClient creates a client and returns it with its ETag. Requires the
+// admin or editor role.
+func (c *Client) CreateClient(ctx context.Context, data ClientData) (*ClientResponse, string, error) {
+	return tagged[ClientResponse](ctx, c, &request{method: http.MethodPost, path: "/api/clients/new", body: data})
+}
+
+// ListClients returns one page of clients
+func (c *Client) ListClients(ctx context.Context, opts *ClientListOptions) (*Page[ClientResponse], error) {
+	o := orZero(opts)
+	q := o.values()
+	setString(q, "name_contains", o.NameContains)
+	setTime(q, "updated_since", o.UpdatedSince)
+	return list[ClientResponse](ctx, c, "/api/clients", q)
+}
+
+// AllClients iterates over every client matching opts, fetching pages as needed
+func (c *Client) AllClients(ctx context.Context, opts *ClientListOptions) iter.Seq2[ClientResponse, error] {
+	o := orZero(opts)
+	return paginate(o.ListOptions, func(page ListOptions) (*Page[ClientResponse], error) {
+		o.ListOptions = page
+		return c.ListClients(ctx, &o)
+	})
+}
+
+// GetClient returns a client and its ETag
+func (c *Client) GetClient(ctx context.Context, id int) (*ClientResponse, string, error) {
+	return tagged[ClientResponse](ctx, c, &request{method: http.MethodGet, path: clientPath(id)})
+}
+
+// ListClientProjects returns every project built for a client
+func (c *Client) ListClientProjects(ctx context.Context, id int) ([]ProjectResponse, error) {
+	var projects []ProjectResponse
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: clientPath(id) + "/projects"}, &projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// UpdateClient replaces every field of the client at etag. It returns the
+// client and its new ETag. Requires the admin or editor role.
+func (c *Client) UpdateClient(ctx context.Context, id int, etag string, data ClientData) (*ClientResponse, string, error) {
+	return tagged[ClientResponse](ctx, c, &request{method: http.MethodPut, path: clientPath(id), body: data, ifMatch: etag})
+}
+
+// PatchClient applies a JSON Merge Patch to the client at etag, as
+// PatchProject does. Requires the admin or editor role.
+func (c *Client) PatchClient(ctx context.Context, id int, etag string, patch any) (*ClientResponse, string, error) {
+	return tagged[ClientResponse](ctx, c, &request{method: http.MethodPatch, path: clientPath(id), body: patch, contentType: mergePatchType, ifMatch: etag})
+}
+
+// DeleteClient moves the client at etag to the trash. Requires the admin role.
+func (c *Client) DeleteClient(ctx context.Context, id int, etag string) error {
+	_, err := c.do(ctx, &request{method: http.MethodDelete, path: clientPath(id), ifMatch: etag}, nil)
+	return err
+}
+
+func clientPath(id int) string {
+	return "/api/clients/" + strconv.Itoa(id)
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Error is a failed API response. The API answers with RFC 7807 problem
+// details; other bodies, such as a proxy's error page, fill only StatusCode,
+// Title and Detail.
+type Error struct {
+	StatusCode int          `json:"status"`
+	Type       string       `json:"type"`
+	Title      string       `json:"title"`
+	Detail     string       `json:"detail"`
+	Instance   string       `json:"instance"`
+	Code       string       `json:"code"` // Machine-readable, e.g. not_found or validation_failed
+	Errors     []FieldError `json:"errors"`
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString("client: ")
+	b.WriteString(strconv.Itoa(e.StatusCode))
+	if e.Code != "" {
+		b.WriteString(" " + e.Code)
+	}
+	switch {
+	case len(e.Errors) > 0:
+		messages := make([]string, len(e.Errors))
+		for i, fe := range e.Errors {
+			messages[i] = fe.Message
+		}
+		b.WriteString(": " + strings.Join(messages, "; "))
+	case e.Detail != "":
+		b.WriteString(": " + e.Detail)
+	case e.Title != "":
+		b.WriteString(": " + e.Title)
+	}
+	return b.String()
+}
+
+// IsNotFound reports whether err is a 404 from the API
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsPreconditionFailed reports whether err is a 412 from the API, which means
+// the entity was modified since the ETag sent with the write was read
+func IsPreconditionFailed(err error) bool {
+	return hasStatus(err, http.StatusPreconditionFailed)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// decodeError reads a failed response into an *Error
+func decodeError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	apiErr := &Error{}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == "application/problem+json" || mediaType == "application/json" {
+		if json.Unmarshal(body, apiErr) != nil {
+			*apiErr = Error{}
+		}
+	}
+
+	// The status line wins over the body, which a proxy may have written
+	apiErr.StatusCode = resp.StatusCode
+	if apiErr.Title == "" {
+		apiErr.Title = http.StatusText(resp.StatusCode)
+	}
+	if apiErr.Code == "" && apiErr.Detail == "" {
+		apiErr.Detail = strings.TrimSpace(string(body))
+	}
+	return apiErr
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// PackageListOptions filter ListPackages and AllPackages
+type PackageListOptions struct {
+	ListOptions            // Sortable by id, name, created_at and updated_at
+	NameContains string    // Only packages whose name contains this text, ignoring case
+	Stack        string    // Only packages for this stack, by name or slug
+	UpdatedSince time.Time // Only packages updated at or after this time
+}
+
+// CreatePackage creates a package and returns it with its ETag. Requires
+// the admin or editor role.
+func (c *Client) CreatePackage(ctx context.Context, data PackageData) (*PackageResponse, string, error) {
+	return tagged[PackageResponse](ctx, c, &request{method: http.MethodPost, path: "/api/packages/new", body: data})
+}
+
+// ListPackages returns one page of packages
+func (c *Client) ListPackages(ctx context.Context, opts *PackageListOptions) (*Page[PackageResponse], error) {
+	o := orZero(opts)
+	q := o.values()
+	setString(q, "name_contains", o.NameContains)
+	setString(q, "stack", o.Stack)
+	setTime(q, "updated_since", o.UpdatedSince)
+	return list[PackageResponse](ctx, c, "/api/packages", q)
+}
+
+// AllPackages iterates over every package matching opts, fetching pages as needed
+func (c *Client) AllPackages(ctx context.Context, opts *PackageListOptions) iter.Seq2[PackageResponse, error] {
+	o := orZero(opts)
+	return paginate(o.ListOptions, func(page ListOptions) (*Page[PackageResponse], error) {
+		o.ListOptions = page
+		return c.ListPackages(ctx, &o)
+	})
+}
+
+// GetPackage returns a package and its ETag
+func (c *Client) GetPackage(ctx context.Context, id int) (*PackageResponse, string, error) {
+	return tagged[PackageResponse](ctx, c, &request{method: http.MethodGet, path: packagePath(id)})
+}
+
+// ListPackageProjects returns every project that uses a package
+func (c *Client) ListPackageProjects(ctx context.Context, id int) ([]ProjectResponse, error) {
+	var projects []ProjectResponse
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: packagePath(id) + "/projects"}, &projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// UpdatePackage replaces every field of the package at etag, so omitted
+// optional fields are cleared. It returns the package and its new ETag.
+// Requires the admin or editor role.
+func (c *Client) UpdatePackage(ctx context.Context, id int, etag string, data PackageData) (*PackageResponse, string, error) {
+	return tagged[PackageResponse](ctx, c, &request{method: http.MethodPut, path: packagePath(id), body: data, ifMatch: etag})
+}
+
+// PatchPackage applies a JSON Merge Patch to the package at etag, as
+// PatchProject does. Requires the admin or editor role.
+func (c *Client) PatchPackage(ctx context.Context, id int, etag string, patch any) (*PackageResponse, string, error) {
+	return tagged[PackageResponse](ctx, c, &request{method: http.MethodPatch, path: packagePath(id), body: patch, contentType: mergePatchType, ifMatch: etag})
+}
+
+// DeletePackage moves the package at etag to the trash. Requires the admin role.
+func (c *Client) DeletePackage(ctx context.Context, id int, etag string) error {
+	_, err := c.do(ctx, &request{method: http.MethodDelete, path: packagePath(id), ifMatch: etag}, nil)
+	return err
+}
+
+func packagePath(id int) string {
+	return "/api/packages/" + strconv.Itoa(id)
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ListOptions are the paging and sorting options of every paged list.
+// Zero values leave the server defaults in place.
+type ListOptions struct {
+	Limit  int    // Page size; the server caps it at 100 and defaults to 50
+	Offset int    // Items to skip; switches the server to offset paging
+	Cursor string // NextCursor of the previous page; cannot be combined with Offset
+	Sort   string // Comma-separated fields, each optionally prefixed with - for descending
+}
+
+func (o ListOptions) values() url.Values {
+	q := url.Values{}
+	setInt(q, "limit", o.Limit)
+	setInt(q, "offset", o.Offset)
+	setString(q, "cursor", o.Cursor)
+	setString(q, "sort", o.Sort)
+	return q
+}
+
+// Page is one page of a paged list
+type Page[T any] struct {
+	Items      []T
+	Total      int    // Items matching the filters across every page
+	NextCursor string // Cursor of the following page; empty on the last page and with offset paging
+}
+
+// list fetches one page of T from path
+func list[T any](ctx context.Context, c *Client, path string, query url.Values) (*Page[T], error) {
+	page := &Page[T]{}
+	header, err := c.do(ctx, &request{method: http.MethodGet, path: path, query: query}, &page.Items)
+	if err != nil {
+		return nil, err
+	}
+	page.Total, _ = strconv.Atoi(header.Get("X-Total-Count"))
+	page.NextCursor = header.Get("X-Next-Cursor")
+	return page, nil
+}
+
+// paginate yields every item of every page, following cursors from opts.
+// Offset is ignored, since offset pages carry no cursor. Iteration stops
+// after the first error, which is yielded with a zero item.
+func paginate[T any](opts ListOptions, fetch func(ListOptions) (*Page[T], error)) iter.Seq2[T, error] {
+	opts.Offset = 0
+	return func(yield func(T, error) bool) {
+		for {
+			page, err := fetch(opts)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if page.NextCursor == "" {
+				return
+			}
+			opts.Cursor = page.NextCursor
+		}
+	}
+}
+
+// orZero returns *opts, or the zero options when opts is nil
+func orZero[T any](opts *T) T {
+	if opts == nil {
+		var zero T
+		return zero
+	}
+	return *opts
+}
+
+func setString(q url.Values, name, value string) {
+	if value != "" {
+		q.Set(name, value)
+	}
+}
+
+func setInt(q url.Values, name string, value int) {
+	if value != 0 {
+		q.Set(name, strconv.Itoa(value))
+	}
+}
+
+func setTime(q url.Values, name string, value time.Time) {
+	if !value.IsZero() {
+		q.Set(name, value.Format(time.RFC3339Nano))
+	}
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ProjectListOptions filter ListProjects and AllProjects
+type ProjectListOptions struct {
+	ListOptions            // Sortable by id, name, created_at and updated_at
+	NameContains string    // Only projects whose name contains this text, ignoring case
+	Stack        string    // Only projects built with this stack, by name or slug
+	ClientID     int       // Only projects built for this client
+	PackageID    int       // Only projects using this package
+	UpdatedSince time.Time // Only projects updated at or after this time
+}
+
+// CreateProject creates a project and returns it with its ETag. Stacks are
+// matched by name or slug and created when new. Requires the admin or editor role.
+func (c *Client) CreateProject(ctx context.Context, data ProjectData) (*ProjectResponse, string, error) {
+	return tagged[ProjectResponse](ctx, c, &request{method: http.MethodPost, path: "/api/projects/new", body: data})
+}
+
+// ListProjects returns one page of projects
+func (c *Client) ListProjects(ctx context.Context, opts *ProjectListOptions) (*Page[ProjectResponse], error) {
+	o := orZero(opts)
+	q := o.values()
+	setString(q, "name_contains", o.NameContains)
+	setString(q, "stack", o.Stack)
+	setInt(q, "client", o.ClientID)
+	setInt(q, "package", o.PackageID)
+	setTime(q, "updated_since", o.UpdatedSince)
+	return list[ProjectResponse](ctx, c, "/api/projects", q)
+}
+
+// AllProjects iterates over every project matching opts, fetching pages as needed
+func (c *Client) AllProjects(ctx context.Context, opts *ProjectListOptions) iter.Seq2[ProjectResponse, error] {
+	o := orZero(opts)
+	return paginate(o.ListOptions, func(page ListOptions) (*Page[ProjectResponse], error) {
+		o.ListOptions = page
+		return c.ListProjects(ctx, &o)
+	})
+}
+
+// GetProject returns a project and its ETag
+func (c *Client) GetProject(ctx context.Context, id int) (*ProjectResponse, string, error) {
+	return tagged[ProjectResponse](ctx, c, &request{method: http.MethodGet, path: projectPath(id)})
+}
+
+// UpdateProject replaces every field and edge of the project at etag, so
+// omitting ClientID detaches the client. It returns the project and its new
+// ETag. Requires the admin or editor role.
+func (c *Client) UpdateProject(ctx context.Context, id int, etag string, data ProjectData) (*ProjectResponse, string, error) {
+	return tagged[ProjectResponse](ctx, c, &request{method: http.MethodPut, path: projectPath(id), body: data, ifMatch: etag})
+}
+
+// PatchProject applies a JSON Merge Patch to the project at etag. patch is
+// encoded as JSON, so a map or a struct with omitempty fields works; a null
+// field is cleared. Requires the admin or editor role.
+func (c *Client) PatchProject(ctx context.Context, id int, etag string, patch any) (*ProjectResponse, string, error) {
+	return tagged[ProjectResponse](ctx, c, &request{method: http.MethodPatch, path: projectPath(id), body: patch, contentType: mergePatchType, ifMatch: etag})
+}
+
+// DeleteProject moves the project at etag to the trash. Requires the admin role.
+func (c *Client) DeleteProject(ctx context.Context, id int, etag string) error {
+	_, err := c.do(ctx, &request{method: http.MethodDelete, path: projectPath(id), ifMatch: etag}, nil)
+	return err
+}
+
+func projectPath(id int) string {
+	return "/api/projects/" + strconv.Itoa(id)
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListProjectRevisions returns one page of a project's revisions, newest
+// first unless opts sorts by revision or created_at
+func (c *Client) ListProjectRevisions(ctx context.Context, id int, opts *ListOptions) (*Page[ProjectRevisionResponse], error) {
+	return list[ProjectRevisionResponse](ctx, c, projectPath(id)+"/revisions", orZero(opts).values())
+}
+
+// AllProjectRevisions iterates over every revision of a project, fetching pages as needed
+func (c *Client) AllProjectRevisions(ctx context.Context, id int, opts *ListOptions) iter.Seq2[ProjectRevisionResponse, error] {
+	return paginate(orZero(opts), func(page ListOptions) (*Page[ProjectRevisionResponse], error) {
+		return c.ListProjectRevisions(ctx, id, &page)
+	})
+}
+
+// GetProjectRevision returns a project as it was saved at revision rev
+func (c *Client) GetProjectRevision(ctx context.Context, id, rev int) (*ProjectRevisionResponse, error) {
+	revision := &ProjectRevisionResponse{}
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: revisionPath(id, rev)}, revision); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// DiffProjectRevisions returns the fields that differ between two revisions
+// of a project, keyed by field name
+func (c *Client) DiffProjectRevisions(ctx context.Context, id, from, to int) (map[string]FieldChange, error) {
+	query := url.Values{"from": {strconv.Itoa(from)}, "to": {strconv.Itoa(to)}}
+	var changes map[string]FieldChange
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: projectPath(id) + "/revisions/diff", query: query}, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// RestoreProjectRevision rolls the project at etag back to revision rev,
+// saved as a new version. It returns the project and its new ETag.
+// Requires the admin or editor role.
+func (c *Client) RestoreProjectRevision(ctx context.Context, id, rev int, etag string) (*ProjectResponse, string, error) {
+	return tagged[ProjectResponse](ctx, c, &request{method: http.MethodPost, path: revisionPath(id, rev) + "/restore", ifMatch: etag})
+}
+
+func revisionPath(id, rev int) string {
+	return projectPath(id) + "/revisions/" + strconv.Itoa(rev)
+}
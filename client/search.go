@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SearchOptions narrow Search
+type SearchOptions struct {
+	Limit int      // Maximum number of hits; the server caps it at 100 and defaults to 20
+	Kinds []string // KindProject, KindPackage or KindClient; every kind when empty
+}
+
+// Search matches names, descriptions and stacks of projects, packages and
+// clients. Hits are ranked best first.
+func (c *Client) Search(ctx context.Context, q string, opts *SearchOptions) ([]SearchHit, error) {
+	o := orZero(opts)
+	query := url.Values{"q": {q}}
+	setInt(query, "limit", o.Limit)
+	setString(query, "kind", strings.Join(o.Kinds, ","))
+
+	var hits []SearchHit
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: "/api/search", query: query}, &hits); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
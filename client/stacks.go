@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CreateStack creates a stack; the slug is derived from the name when
+// omitted. Requires the admin or editor role.
+func (c *Client) CreateStack(ctx context.Context, data StackData) (*StackResponse, error) {
+	stack := &StackResponse{}
+	if _, err := c.do(ctx, &request{method: http.MethodPost, path: "/api/stacks/new", body: data}, stack); err != nil {
+		return nil, err
+	}
+	return stack, nil
+}
+
+// ListStacks returns every stack, ordered by name
+func (c *Client) ListStacks(ctx context.Context) ([]StackResponse, error) {
+	var stacks []StackResponse
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: "/api/stacks"}, &stacks); err != nil {
+		return nil, err
+	}
+	return stacks, nil
+}
+
+// GetStack returns the stack with the given slug
+func (c *Client) GetStack(ctx context.Context, slug string) (*StackResponse, error) {
+	stack := &StackResponse{}
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: stackPath(slug)}, stack); err != nil {
+		return nil, err
+	}
+	return stack, nil
+}
+
+// ListStackProjects returns every project built with a stack
+func (c *Client) ListStackProjects(ctx context.Context, slug string) ([]ProjectResponse, error) {
+	var projects []ProjectResponse
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: stackPath(slug) + "/projects"}, &projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// UpdateStack replaces every field of a stack; an empty slug is derived from
+// the name again. Requires the admin or editor role.
+func (c *Client) UpdateStack(ctx context.Context, slug string, data StackData) (*StackResponse, error) {
+	stack := &StackResponse{}
+	if _, err := c.do(ctx, &request{method: http.MethodPut, path: stackPath(slug), body: data}, stack); err != nil {
+		return nil, err
+	}
+	return stack, nil
+}
+
+// PatchStack applies a JSON Merge Patch to a stack, as PatchProject does.
+// Stacks are not versioned, so no ETag is needed. Requires the admin or editor role.
+func (c *Client) PatchStack(ctx context.Context, slug string, patch any) (*StackResponse, error) {
+	stack := &StackResponse{}
+	if _, err := c.do(ctx, &request{method: http.MethodPatch, path: stackPath(slug), body: patch, contentType: mergePatchType}, stack); err != nil {
+		return nil, err
+	}
+	return stack, nil
+}
+
+// DeleteStack deletes a stack and detaches it from its projects and
+// packages. Requires the admin role.
+func (c *Client) DeleteStack(ctx context.Context, slug string) error {
+	_, err := c.do(ctx, &request{method: http.MethodDelete, path: stackPath(slug)}, nil)
+	return err
+}
+
+func stackPath(slug string) string {
+	return "/api/stacks/" + url.PathEscape(slug)
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Status returns the server's build information and the health of its dependencies
+func (c *Client) Status(ctx context.Context) (*StatusResponse, error) {
+	status := &StatusResponse{}
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: "/api/status"}, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ListTrash returns the soft-deleted entities of the given kinds, or of
+// every kind when none is given, most recently deleted first. Requires the
+// admin role.
+func (c *Client) ListTrash(ctx context.Context, kinds ...string) ([]TrashItem, error) {
+	query := url.Values{}
+	setString(query, "kind", strings.Join(kinds, ","))
+	var items []TrashItem
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: "/api/trash", query: query}, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// RestoreProject moves a project out of the trash and returns it with its
+// ETag. Requires the admin role.
+func (c *Client) RestoreProject(ctx context.Context, id int) (*ProjectResponse, string, error) {
+	return tagged[ProjectResponse](ctx, c, &request{method: http.MethodPost, path: projectPath(id) + "/restore"})
+}
+
+// RestorePackage moves a package out of the trash and returns it with its
+// ETag. Requires the admin role.
+func (c *Client) RestorePackage(ctx context.Context, id int) (*PackageResponse, string, error) {
+	return tagged[PackageResponse](ctx, c, &request{method: http.MethodPost, path: packagePath(id) + "/restore"})
+}
+
+// RestoreClient moves a client out of the trash and returns it with its
+// ETag. Requires the admin role.
+func (c *Client) RestoreClient(ctx context.Context, id int) (*ClientResponse, string, error) {
+	return tagged[ClientResponse](ctx, c, &request{method: http.MethodPost, path: clientPath(id) + "/restore"})
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"project-manager/internal/models"
+	"project-manager/internal/validation"
+)
+
+// Request and response bodies. They are aliases of the types the server
+// encodes, so both sides always agree on the JSON.
+type (
+	ProjectData             = models.ProjectData
+	PackageData             = models.PackageData
+	ClientData              = models.ClientData
+	StackData               = models.StackData
+	UserData                = models.UserData
+	ProjectResponse         = models.ProjectResponse
+	PackageResponse         = models.PackageResponse
+	ClientResponse          = models.ClientResponse
+	StackResponse           = models.StackResponse
+	UserResponse            = models.UserResponse
+	TokenResponse           = models.TokenResponse
+	ProjectRevisionResponse = models.ProjectRevisionResponse
+	FieldChange             = models.FieldChange
+	AuditEventResponse      = models.AuditEventResponse
+	SearchHit               = models.SearchHit
+	TrashItem               = models.TrashItem
+	StatusResponse          = models.StatusResponse
+	DependencyStatus        = models.DependencyStatus
+
+	// FieldError is one invalid field of a rejected request
+	FieldError = validation.FieldError
+)
+
+// Entity kinds accepted by Search and ListTrash
+const (
+	KindProject = "project"
+	KindPackage = "package"
+	KindClient  = "client"
+)
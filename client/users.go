@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// CreateUser creates a user; the role defaults to viewer. Requires the admin role.
+func (c *Client) CreateUser(ctx context.Context, data UserData) (*UserResponse, error) {
+	user := &UserResponse{}
+	if _, err := c.do(ctx, &request{method: http.MethodPost, path: "/api/users/new", body: data}, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ListUsers returns every user. Requires the admin role.
+func (c *Client) ListUsers(ctx context.Context) ([]UserResponse, error) {
+	var users []UserResponse
+	if _, err := c.do(ctx, &request{method: http.MethodGet, path: "/api/users"}, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// DeleteUser deletes a user. Requires the admin role.
+func (c *Client) DeleteUser(ctx context.Context, id int) error {
+	_, err := c.do(ctx, &request{method: http.MethodDelete, path: "/api/users/" + strconv.Itoa(id)}, nil)
+	return err
+}
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager/ent/auditevents"
+	"project-manager/internal/models"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditEventsCreate is the builder for creating a AuditEvents entity.
+type AuditEventsCreate struct {
+	config
+	mutation *AuditEventsMutation
+	hooks    []Hook
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aec *AuditEventsCreate) SetEntityType(s string) *AuditEventsCreate {
+	aec.mutation.SetEntityType(s)
+	return aec
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aec *AuditEventsCreate) SetEntityID(i int) *AuditEventsCreate {
+	aec.mutation.SetEntityID(i)
+	return aec
+}
+
+// SetOperation sets the "operation" field.
+func (aec *AuditEventsCreate) SetOperation(a auditevents.Operation) *AuditEventsCreate {
+	aec.mutation.SetOperation(a)
+	return aec
+}
+
+// SetActorID sets the "actor_id" field.
+func (aec *AuditEventsCreate) SetActorID(i int) *AuditEventsCreate {
+	aec.mutation.SetActorID(i)
+	return aec
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aec *AuditEventsCreate) SetNillableActorID(i *int) *AuditEventsCreate {
+	if i != nil {
+		aec.SetActorID(*i)
+	}
+	return aec
+}
+
+// SetChanges sets the "changes" field.
+func (aec *AuditEventsCreate) SetChanges(mc map[string]models.FieldChange) *AuditEventsCreate {
+	aec.mutation.SetChanges(mc)
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEventsCreate) SetCreatedAt(t time.Time) *AuditEventsCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuditEventsCreate) SetNillableCreatedAt(t *time.Time) *AuditEventsCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// Mutation returns the AuditEventsMutation object of the builder.
+func (aec *AuditEventsCreate) Mutation() *AuditEventsMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEvents in the database.
+func (aec *AuditEventsCreate) Save(ctx context.Context) (*AuditEvents, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEventsCreate) SaveX(ctx context.Context) *AuditEvents {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEventsCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEventsCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEventsCreate) defaults() {
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := auditevents.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEventsCreate) check() error {
+	if _, ok := aec.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "AuditEvents.entity_type"`)}
+	}
+	if v, ok := aec.mutation.EntityType(); ok {
+		if err := auditevents.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "AuditEvents.entity_type": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "AuditEvents.entity_id"`)}
+	}
+	if _, ok := aec.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "AuditEvents.operation"`)}
+	}
+	if v, ok := aec.mutation.Operation(); ok {
+		if err := auditevents.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "AuditEvents.operation": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditEvents.created_at"`)}
+	}
+	return nil
+}
+
+func (aec *AuditEventsCreate) sqlSave(ctx context.Context) (*AuditEvents, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEventsCreate) createSpec() (*AuditEvents, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEvents{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditevents.Table, sqlgraph.NewFieldSpec(auditevents.FieldID, field.TypeInt))
+	)
+	if value, ok := aec.mutation.EntityType(); ok {
+		_spec.SetField(auditevents.FieldEntityType, field.TypeString, value)
+		_node.EntityType = value
+	}
+	if value, ok := aec.mutation.EntityID(); ok {
+		_spec.SetField(auditevents.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = value
+	}
+	if value, ok := aec.mutation.Operation(); ok {
+		_spec.SetField(auditevents.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := aec.mutation.ActorID(); ok {
+		_spec.SetField(auditevents.FieldActorID, field.TypeInt, value)
+		_node.ActorID = &value
+	}
+	if value, ok := aec.mutation.Changes(); ok {
+		_spec.SetField(auditevents.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(auditevents.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditEventsCreateBulk is the builder for creating many AuditEvents entities in bulk.
+type AuditEventsCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEventsCreate
+}
+
+// Save creates the AuditEvents entities in the database.
+func (aecb *AuditEventsCreateBulk) Save(ctx context.Context) ([]*AuditEvents, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEvents, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEventsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEventsCreateBulk) SaveX(ctx context.Context) []*AuditEvents {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEventsCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEventsCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -11,13 +11,18 @@ import (
 
 	"project-manager/ent/migrate"
 
+	"project-manager/ent/auditevents"
 	"project-manager/ent/clients"
 	"project-manager/ent/packages"
+	"project-manager/ent/projectrevisions"
 	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"project-manager/ent/users"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,12 +30,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditEvents is the client for interacting with the AuditEvents builders.
+	AuditEvents *AuditEventsClient
 	// Clients is the client for interacting with the Clients builders.
 	Clients *ClientsClient
 	// Packages is the client for interacting with the Packages builders.
 	Packages *PackagesClient
+	// ProjectRevisions is the client for interacting with the ProjectRevisions builders.
+	ProjectRevisions *ProjectRevisionsClient
 	// Projects is the client for interacting with the Projects builders.
 	Projects *ProjectsClient
+	// Stacks is the client for interacting with the Stacks builders.
+	Stacks *StacksClient
+	// Users is the client for interacting with the Users builders.
+	Users *UsersClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,9 +55,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditEvents = NewAuditEventsClient(c.config)
 	c.Clients = NewClientsClient(c.config)
 	c.Packages = NewPackagesClient(c.config)
+	c.ProjectRevisions = NewProjectRevisionsClient(c.config)
 	c.Projects = NewProjectsClient(c.config)
+	c.Stacks = NewStacksClient(c.config)
+	c.Users = NewUsersClient(c.config)
 }
 
 type (
@@ -135,11 +152,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Clients:  NewClientsClient(cfg),
-		Packages: NewPackagesClient(cfg),
-		Projects: NewProjectsClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		AuditEvents:      NewAuditEventsClient(cfg),
+		Clients:          NewClientsClient(cfg),
+		Packages:         NewPackagesClient(cfg),
+		ProjectRevisions: NewProjectRevisionsClient(cfg),
+		Projects:         NewProjectsClient(cfg),
+		Stacks:           NewStacksClient(cfg),
+		Users:            NewUsersClient(cfg),
 	}, nil
 }
 
@@ -157,18 +178,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Clients:  NewClientsClient(cfg),
-		Packages: NewPackagesClient(cfg),
-		Projects: NewProjectsClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		AuditEvents:      NewAuditEventsClient(cfg),
+		Clients:          NewClientsClient(cfg),
+		Packages:         NewPackagesClient(cfg),
+		ProjectRevisions: NewProjectRevisionsClient(cfg),
+		Projects:         NewProjectsClient(cfg),
+		Stacks:           NewStacksClient(cfg),
+		Users:            NewUsersClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Clients.
+//		AuditEvents.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,33 +215,180 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Clients.Use(hooks...)
-	c.Packages.Use(hooks...)
-	c.Projects.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditEvents, c.Clients, c.Packages, c.ProjectRevisions, c.Projects, c.Stacks,
+		c.Users,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Clients.Intercept(interceptors...)
-	c.Packages.Intercept(interceptors...)
-	c.Projects.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditEvents, c.Clients, c.Packages, c.ProjectRevisions, c.Projects, c.Stacks,
+		c.Users,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditEventsMutation:
+		return c.AuditEvents.mutate(ctx, m)
 	case *ClientsMutation:
 		return c.Clients.mutate(ctx, m)
 	case *PackagesMutation:
 		return c.Packages.mutate(ctx, m)
+	case *ProjectRevisionsMutation:
+		return c.ProjectRevisions.mutate(ctx, m)
 	case *ProjectsMutation:
 		return c.Projects.mutate(ctx, m)
+	case *StacksMutation:
+		return c.Stacks.mutate(ctx, m)
+	case *UsersMutation:
+		return c.Users.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AuditEventsClient is a client for the AuditEvents schema.
+type AuditEventsClient struct {
+	config
+}
+
+// NewAuditEventsClient returns a client for the AuditEvents from the given config.
+func NewAuditEventsClient(c config) *AuditEventsClient {
+	return &AuditEventsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevents.Hooks(f(g(h())))`.
+func (c *AuditEventsClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvents = append(c.hooks.AuditEvents, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditevents.Intercept(f(g(h())))`.
+func (c *AuditEventsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvents = append(c.inters.AuditEvents, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEvents entity.
+func (c *AuditEventsClient) Create() *AuditEventsCreate {
+	mutation := newAuditEventsMutation(c.config, OpCreate)
+	return &AuditEventsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvents entities.
+func (c *AuditEventsClient) CreateBulk(builders ...*AuditEventsCreate) *AuditEventsCreateBulk {
+	return &AuditEventsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEventsClient) MapCreateBulk(slice any, setFunc func(*AuditEventsCreate, int)) *AuditEventsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEventsCreateBulk{err: fmt.Errorf("calling to AuditEventsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEventsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEventsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvents.
+func (c *AuditEventsClient) Update() *AuditEventsUpdate {
+	mutation := newAuditEventsMutation(c.config, OpUpdate)
+	return &AuditEventsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventsClient) UpdateOne(ae *AuditEvents) *AuditEventsUpdateOne {
+	mutation := newAuditEventsMutation(c.config, OpUpdateOne, withAuditEvents(ae))
+	return &AuditEventsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventsClient) UpdateOneID(id int) *AuditEventsUpdateOne {
+	mutation := newAuditEventsMutation(c.config, OpUpdateOne, withAuditEventsID(id))
+	return &AuditEventsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvents.
+func (c *AuditEventsClient) Delete() *AuditEventsDelete {
+	mutation := newAuditEventsMutation(c.config, OpDelete)
+	return &AuditEventsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEventsClient) DeleteOne(ae *AuditEvents) *AuditEventsDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEventsClient) DeleteOneID(id int) *AuditEventsDeleteOne {
+	builder := c.Delete().Where(auditevents.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventsDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvents.
+func (c *AuditEventsClient) Query() *AuditEventsQuery {
+	return &AuditEventsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEvents},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEvents entity by its id.
+func (c *AuditEventsClient) Get(ctx context.Context, id int) (*AuditEvents, error) {
+	return c.Query().Where(auditevents.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventsClient) GetX(ctx context.Context, id int) *AuditEvents {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventsClient) Hooks() []Hook {
+	return c.hooks.AuditEvents
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEventsClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvents
+}
+
+func (c *AuditEventsClient) mutate(ctx context.Context, m *AuditEventsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEventsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEventsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEventsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEventsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditEvents mutation op: %q", m.Op())
+	}
+}
+
 // ClientsClient is a client for the Clients schema.
 type ClientsClient struct {
 	config
@@ -325,14 +497,32 @@ func (c *ClientsClient) GetX(ctx context.Context, id int) *Clients {
 	return obj
 }
 
+// QueryProjects queries the projects edge of a Clients.
+func (c *ClientsClient) QueryProjects(cl *Clients) *ProjectsQuery {
+	query := (&ProjectsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(clients.Table, clients.FieldID, id),
+			sqlgraph.To(projects.Table, projects.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, clients.ProjectsTable, clients.ProjectsColumn),
+		)
+		fromV = sqlgraph.Neighbors(cl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ClientsClient) Hooks() []Hook {
-	return c.hooks.Clients
+	hooks := c.hooks.Clients
+	return append(hooks[:len(hooks):len(hooks)], clients.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *ClientsClient) Interceptors() []Interceptor {
-	return c.inters.Clients
+	inters := c.inters.Clients
+	return append(inters[:len(inters):len(inters)], clients.Interceptors[:]...)
 }
 
 func (c *ClientsClient) mutate(ctx context.Context, m *ClientsMutation) (Value, error) {
@@ -458,14 +648,48 @@ func (c *PackagesClient) GetX(ctx context.Context, id int) *Packages {
 	return obj
 }
 
+// QueryProjects queries the projects edge of a Packages.
+func (c *PackagesClient) QueryProjects(pa *Packages) *ProjectsQuery {
+	query := (&ProjectsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packages.Table, packages.FieldID, id),
+			sqlgraph.To(projects.Table, projects.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, packages.ProjectsTable, packages.ProjectsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStacks queries the stacks edge of a Packages.
+func (c *PackagesClient) QueryStacks(pa *Packages) *StacksQuery {
+	query := (&StacksClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packages.Table, packages.FieldID, id),
+			sqlgraph.To(stacks.Table, stacks.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, packages.StacksTable, packages.StacksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PackagesClient) Hooks() []Hook {
-	return c.hooks.Packages
+	hooks := c.hooks.Packages
+	return append(hooks[:len(hooks):len(hooks)], packages.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *PackagesClient) Interceptors() []Interceptor {
-	return c.inters.Packages
+	inters := c.inters.Packages
+	return append(inters[:len(inters):len(inters)], packages.Interceptors[:]...)
 }
 
 func (c *PackagesClient) mutate(ctx context.Context, m *PackagesMutation) (Value, error) {
@@ -483,6 +707,155 @@ func (c *PackagesClient) mutate(ctx context.Context, m *PackagesMutation) (Value
 	}
 }
 
+// ProjectRevisionsClient is a client for the ProjectRevisions schema.
+type ProjectRevisionsClient struct {
+	config
+}
+
+// NewProjectRevisionsClient returns a client for the ProjectRevisions from the given config.
+func NewProjectRevisionsClient(c config) *ProjectRevisionsClient {
+	return &ProjectRevisionsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `projectrevisions.Hooks(f(g(h())))`.
+func (c *ProjectRevisionsClient) Use(hooks ...Hook) {
+	c.hooks.ProjectRevisions = append(c.hooks.ProjectRevisions, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `projectrevisions.Intercept(f(g(h())))`.
+func (c *ProjectRevisionsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProjectRevisions = append(c.inters.ProjectRevisions, interceptors...)
+}
+
+// Create returns a builder for creating a ProjectRevisions entity.
+func (c *ProjectRevisionsClient) Create() *ProjectRevisionsCreate {
+	mutation := newProjectRevisionsMutation(c.config, OpCreate)
+	return &ProjectRevisionsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProjectRevisions entities.
+func (c *ProjectRevisionsClient) CreateBulk(builders ...*ProjectRevisionsCreate) *ProjectRevisionsCreateBulk {
+	return &ProjectRevisionsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProjectRevisionsClient) MapCreateBulk(slice any, setFunc func(*ProjectRevisionsCreate, int)) *ProjectRevisionsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProjectRevisionsCreateBulk{err: fmt.Errorf("calling to ProjectRevisionsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProjectRevisionsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProjectRevisionsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProjectRevisions.
+func (c *ProjectRevisionsClient) Update() *ProjectRevisionsUpdate {
+	mutation := newProjectRevisionsMutation(c.config, OpUpdate)
+	return &ProjectRevisionsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProjectRevisionsClient) UpdateOne(pr *ProjectRevisions) *ProjectRevisionsUpdateOne {
+	mutation := newProjectRevisionsMutation(c.config, OpUpdateOne, withProjectRevisions(pr))
+	return &ProjectRevisionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProjectRevisionsClient) UpdateOneID(id int) *ProjectRevisionsUpdateOne {
+	mutation := newProjectRevisionsMutation(c.config, OpUpdateOne, withProjectRevisionsID(id))
+	return &ProjectRevisionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProjectRevisions.
+func (c *ProjectRevisionsClient) Delete() *ProjectRevisionsDelete {
+	mutation := newProjectRevisionsMutation(c.config, OpDelete)
+	return &ProjectRevisionsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProjectRevisionsClient) DeleteOne(pr *ProjectRevisions) *ProjectRevisionsDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProjectRevisionsClient) DeleteOneID(id int) *ProjectRevisionsDeleteOne {
+	builder := c.Delete().Where(projectrevisions.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProjectRevisionsDeleteOne{builder}
+}
+
+// Query returns a query builder for ProjectRevisions.
+func (c *ProjectRevisionsClient) Query() *ProjectRevisionsQuery {
+	return &ProjectRevisionsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProjectRevisions},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProjectRevisions entity by its id.
+func (c *ProjectRevisionsClient) Get(ctx context.Context, id int) (*ProjectRevisions, error) {
+	return c.Query().Where(projectrevisions.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProjectRevisionsClient) GetX(ctx context.Context, id int) *ProjectRevisions {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a ProjectRevisions.
+func (c *ProjectRevisionsClient) QueryProject(pr *ProjectRevisions) *ProjectsQuery {
+	query := (&ProjectsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projectrevisions.Table, projectrevisions.FieldID, id),
+			sqlgraph.To(projects.Table, projects.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, projectrevisions.ProjectTable, projectrevisions.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProjectRevisionsClient) Hooks() []Hook {
+	return c.hooks.ProjectRevisions
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProjectRevisionsClient) Interceptors() []Interceptor {
+	return c.inters.ProjectRevisions
+}
+
+func (c *ProjectRevisionsClient) mutate(ctx context.Context, m *ProjectRevisionsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProjectRevisionsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProjectRevisionsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProjectRevisionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProjectRevisionsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProjectRevisions mutation op: %q", m.Op())
+	}
+}
+
 // ProjectsClient is a client for the Projects schema.
 type ProjectsClient struct {
 	config
@@ -591,14 +964,80 @@ func (c *ProjectsClient) GetX(ctx context.Context, id int) *Projects {
 	return obj
 }
 
+// QueryClient queries the client edge of a Projects.
+func (c *ProjectsClient) QueryClient(pr *Projects) *ClientsQuery {
+	query := (&ClientsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projects.Table, projects.FieldID, id),
+			sqlgraph.To(clients.Table, clients.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, projects.ClientTable, projects.ClientColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackages queries the packages edge of a Projects.
+func (c *ProjectsClient) QueryPackages(pr *Projects) *PackagesQuery {
+	query := (&PackagesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projects.Table, projects.FieldID, id),
+			sqlgraph.To(packages.Table, packages.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, projects.PackagesTable, projects.PackagesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStacks queries the stacks edge of a Projects.
+func (c *ProjectsClient) QueryStacks(pr *Projects) *StacksQuery {
+	query := (&StacksClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projects.Table, projects.FieldID, id),
+			sqlgraph.To(stacks.Table, stacks.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, projects.StacksTable, projects.StacksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Projects.
+func (c *ProjectsClient) QueryRevisions(pr *Projects) *ProjectRevisionsQuery {
+	query := (&ProjectRevisionsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projects.Table, projects.FieldID, id),
+			sqlgraph.To(projectrevisions.Table, projectrevisions.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, projects.RevisionsTable, projects.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ProjectsClient) Hooks() []Hook {
-	return c.hooks.Projects
+	hooks := c.hooks.Projects
+	return append(hooks[:len(hooks):len(hooks)], projects.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *ProjectsClient) Interceptors() []Interceptor {
-	return c.inters.Projects
+	inters := c.inters.Projects
+	return append(inters[:len(inters):len(inters)], projects.Interceptors[:]...)
 }
 
 func (c *ProjectsClient) mutate(ctx context.Context, m *ProjectsMutation) (Value, error) {
@@ -616,12 +1055,312 @@ func (c *ProjectsClient) mutate(ctx context.Context, m *ProjectsMutation) (Value
 	}
 }
 
+// StacksClient is a client for the Stacks schema.
+type StacksClient struct {
+	config
+}
+
+// NewStacksClient returns a client for the Stacks from the given config.
+func NewStacksClient(c config) *StacksClient {
+	return &StacksClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stacks.Hooks(f(g(h())))`.
+func (c *StacksClient) Use(hooks ...Hook) {
+	c.hooks.Stacks = append(c.hooks.Stacks, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `stacks.Intercept(f(g(h())))`.
+func (c *StacksClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Stacks = append(c.inters.Stacks, interceptors...)
+}
+
+// Create returns a builder for creating a Stacks entity.
+func (c *StacksClient) Create() *StacksCreate {
+	mutation := newStacksMutation(c.config, OpCreate)
+	return &StacksCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Stacks entities.
+func (c *StacksClient) CreateBulk(builders ...*StacksCreate) *StacksCreateBulk {
+	return &StacksCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StacksClient) MapCreateBulk(slice any, setFunc func(*StacksCreate, int)) *StacksCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StacksCreateBulk{err: fmt.Errorf("calling to StacksClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StacksCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StacksCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Stacks.
+func (c *StacksClient) Update() *StacksUpdate {
+	mutation := newStacksMutation(c.config, OpUpdate)
+	return &StacksUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StacksClient) UpdateOne(s *Stacks) *StacksUpdateOne {
+	mutation := newStacksMutation(c.config, OpUpdateOne, withStacks(s))
+	return &StacksUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StacksClient) UpdateOneID(id int) *StacksUpdateOne {
+	mutation := newStacksMutation(c.config, OpUpdateOne, withStacksID(id))
+	return &StacksUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Stacks.
+func (c *StacksClient) Delete() *StacksDelete {
+	mutation := newStacksMutation(c.config, OpDelete)
+	return &StacksDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StacksClient) DeleteOne(s *Stacks) *StacksDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StacksClient) DeleteOneID(id int) *StacksDeleteOne {
+	builder := c.Delete().Where(stacks.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StacksDeleteOne{builder}
+}
+
+// Query returns a query builder for Stacks.
+func (c *StacksClient) Query() *StacksQuery {
+	return &StacksQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStacks},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Stacks entity by its id.
+func (c *StacksClient) Get(ctx context.Context, id int) (*Stacks, error) {
+	return c.Query().Where(stacks.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StacksClient) GetX(ctx context.Context, id int) *Stacks {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProjects queries the projects edge of a Stacks.
+func (c *StacksClient) QueryProjects(s *Stacks) *ProjectsQuery {
+	query := (&ProjectsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stacks.Table, stacks.FieldID, id),
+			sqlgraph.To(projects.Table, projects.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, stacks.ProjectsTable, stacks.ProjectsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackages queries the packages edge of a Stacks.
+func (c *StacksClient) QueryPackages(s *Stacks) *PackagesQuery {
+	query := (&PackagesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stacks.Table, stacks.FieldID, id),
+			sqlgraph.To(packages.Table, packages.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, stacks.PackagesTable, stacks.PackagesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StacksClient) Hooks() []Hook {
+	return c.hooks.Stacks
+}
+
+// Interceptors returns the client interceptors.
+func (c *StacksClient) Interceptors() []Interceptor {
+	return c.inters.Stacks
+}
+
+func (c *StacksClient) mutate(ctx context.Context, m *StacksMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StacksCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StacksUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StacksUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StacksDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Stacks mutation op: %q", m.Op())
+	}
+}
+
+// UsersClient is a client for the Users schema.
+type UsersClient struct {
+	config
+}
+
+// NewUsersClient returns a client for the Users from the given config.
+func NewUsersClient(c config) *UsersClient {
+	return &UsersClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `users.Hooks(f(g(h())))`.
+func (c *UsersClient) Use(hooks ...Hook) {
+	c.hooks.Users = append(c.hooks.Users, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `users.Intercept(f(g(h())))`.
+func (c *UsersClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Users = append(c.inters.Users, interceptors...)
+}
+
+// Create returns a builder for creating a Users entity.
+func (c *UsersClient) Create() *UsersCreate {
+	mutation := newUsersMutation(c.config, OpCreate)
+	return &UsersCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Users entities.
+func (c *UsersClient) CreateBulk(builders ...*UsersCreate) *UsersCreateBulk {
+	return &UsersCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsersClient) MapCreateBulk(slice any, setFunc func(*UsersCreate, int)) *UsersCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsersCreateBulk{err: fmt.Errorf("calling to UsersClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsersCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsersCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Users.
+func (c *UsersClient) Update() *UsersUpdate {
+	mutation := newUsersMutation(c.config, OpUpdate)
+	return &UsersUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsersClient) UpdateOne(u *Users) *UsersUpdateOne {
+	mutation := newUsersMutation(c.config, OpUpdateOne, withUsers(u))
+	return &UsersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsersClient) UpdateOneID(id int) *UsersUpdateOne {
+	mutation := newUsersMutation(c.config, OpUpdateOne, withUsersID(id))
+	return &UsersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Users.
+func (c *UsersClient) Delete() *UsersDelete {
+	mutation := newUsersMutation(c.config, OpDelete)
+	return &UsersDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsersClient) DeleteOne(u *Users) *UsersDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsersClient) DeleteOneID(id int) *UsersDeleteOne {
+	builder := c.Delete().Where(users.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsersDeleteOne{builder}
+}
+
+// Query returns a query builder for Users.
+func (c *UsersClient) Query() *UsersQuery {
+	return &UsersQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsers},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Users entity by its id.
+func (c *UsersClient) Get(ctx context.Context, id int) (*Users, error) {
+	return c.Query().Where(users.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsersClient) GetX(ctx context.Context, id int) *Users {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UsersClient) Hooks() []Hook {
+	return c.hooks.Users
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsersClient) Interceptors() []Interceptor {
+	return c.inters.Users
+}
+
+func (c *UsersClient) mutate(ctx context.Context, m *UsersMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsersCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsersUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsersDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Users mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Clients, Packages, Projects []ent.Hook
+		AuditEvents, Clients, Packages, ProjectRevisions, Projects, Stacks,
+		Users []ent.Hook
 	}
 	inters struct {
-		Clients, Packages, Projects []ent.Interceptor
+		AuditEvents, Clients, Packages, ProjectRevisions, Projects, Stacks,
+		Users []ent.Interceptor
 	}
 )
@@ -17,29 +17,54 @@ type Clients struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// The time the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The time the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The revision counter compared against If-Match on writes
+	Version int `json:"version,omitempty"`
+	// When the row was moved to the trash; NULL while it is live
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// The name of the package
 	Name string `json:"name,omitempty"`
 	// The link to the package
 	Link string `json:"link,omitempty"`
 	// The image URL of the client
 	ImageUrl string `json:"imageUrl,omitempty"`
-	// The time the package was created
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	// The time the package was last updated
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ClientsQuery when eager-loading is set.
+	Edges        ClientsEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// ClientsEdges holds the relations/edges for other nodes in the graph.
+type ClientsEdges struct {
+	// The projects built for the client
+	Projects []*Projects `json:"projects,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectsOrErr returns the Projects value or an error if the edge
+// was not loaded in eager-loading.
+func (e ClientsEdges) ProjectsOrErr() ([]*Projects, error) {
+	if e.loadedTypes[0] {
+		return e.Projects, nil
+	}
+	return nil, &NotLoadedError{edge: "projects"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Clients) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case clients.FieldID:
+		case clients.FieldID, clients.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case clients.FieldName, clients.FieldLink, clients.FieldImageUrl:
 			values[i] = new(sql.NullString)
-		case clients.FieldCreatedAt, clients.FieldUpdatedAt:
+		case clients.FieldCreatedAt, clients.FieldUpdatedAt, clients.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -62,6 +87,31 @@ func (c *Clients) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			c.ID = int(value.Int64)
+		case clients.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case clients.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case clients.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				c.Version = int(value.Int64)
+			}
+		case clients.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
 		case clients.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -80,18 +130,6 @@ func (c *Clients) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.ImageUrl = value.String
 			}
-		case clients.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
-			} else if value.Valid {
-				c.CreatedAt = value.Time
-			}
-		case clients.FieldUpdatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
-			} else if value.Valid {
-				c.UpdatedAt = value.Time
-			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -105,6 +143,11 @@ func (c *Clients) Value(name string) (ent.Value, error) {
 	return c.selectValues.Get(name)
 }
 
+// QueryProjects queries the "projects" edge of the Clients entity.
+func (c *Clients) QueryProjects() *ProjectsQuery {
+	return NewClientsClient(c.config).QueryProjects(c)
+}
+
 // Update returns a builder for updating this Clients.
 // Note that you need to call Clients.Unwrap() before calling this method if this Clients
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -128,6 +171,20 @@ func (c *Clients) String() string {
 	var builder strings.Builder
 	builder.WriteString("Clients(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", c.Version))
+	builder.WriteString(", ")
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(c.Name)
 	builder.WriteString(", ")
@@ -136,12 +193,6 @@ func (c *Clients) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("imageUrl=")
 	builder.WriteString(c.ImageUrl)
-	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
-	builder.WriteString("updated_at=")
-	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"project-manager/ent/clients"
+	"project-manager/ent/projects"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -20,6 +21,62 @@ type ClientsCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (cc *ClientsCreate) SetCreatedAt(t time.Time) *ClientsCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *ClientsCreate) SetNillableCreatedAt(t *time.Time) *ClientsCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *ClientsCreate) SetUpdatedAt(t time.Time) *ClientsCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *ClientsCreate) SetNillableUpdatedAt(t *time.Time) *ClientsCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetVersion sets the "version" field.
+func (cc *ClientsCreate) SetVersion(i int) *ClientsCreate {
+	cc.mutation.SetVersion(i)
+	return cc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cc *ClientsCreate) SetNillableVersion(i *int) *ClientsCreate {
+	if i != nil {
+		cc.SetVersion(*i)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *ClientsCreate) SetDeletedAt(t time.Time) *ClientsCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *ClientsCreate) SetNillableDeletedAt(t *time.Time) *ClientsCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
 // SetName sets the "name" field.
 func (cc *ClientsCreate) SetName(s string) *ClientsCreate {
 	cc.mutation.SetName(s)
@@ -54,32 +111,19 @@ func (cc *ClientsCreate) SetNillableImageUrl(s *string) *ClientsCreate {
 	return cc
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (cc *ClientsCreate) SetCreatedAt(t time.Time) *ClientsCreate {
-	cc.mutation.SetCreatedAt(t)
+// AddProjectIDs adds the "projects" edge to the Projects entity by IDs.
+func (cc *ClientsCreate) AddProjectIDs(ids ...int) *ClientsCreate {
+	cc.mutation.AddProjectIDs(ids...)
 	return cc
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (cc *ClientsCreate) SetNillableCreatedAt(t *time.Time) *ClientsCreate {
-	if t != nil {
-		cc.SetCreatedAt(*t)
+// AddProjects adds the "projects" edges to the Projects entity.
+func (cc *ClientsCreate) AddProjects(p ...*Projects) *ClientsCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return cc
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (cc *ClientsCreate) SetUpdatedAt(t time.Time) *ClientsCreate {
-	cc.mutation.SetUpdatedAt(t)
-	return cc
-}
-
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (cc *ClientsCreate) SetNillableUpdatedAt(t *time.Time) *ClientsCreate {
-	if t != nil {
-		cc.SetUpdatedAt(*t)
-	}
-	return cc
+	return cc.AddProjectIDs(ids...)
 }
 
 // Mutation returns the ClientsMutation object of the builder.
@@ -89,7 +133,9 @@ func (cc *ClientsCreate) Mutation() *ClientsMutation {
 
 // Save creates the Clients in the database.
 func (cc *ClientsCreate) Save(ctx context.Context) (*Clients, error) {
-	cc.defaults()
+	if err := cc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
 }
 
@@ -116,19 +162,44 @@ func (cc *ClientsCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cc *ClientsCreate) defaults() {
+func (cc *ClientsCreate) defaults() error {
 	if _, ok := cc.mutation.CreatedAt(); !ok {
+		if clients.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized clients.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := clients.DefaultCreatedAt()
 		cc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		if clients.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized clients.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := clients.DefaultUpdatedAt()
 		cc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := cc.mutation.Version(); !ok {
+		v := clients.DefaultVersion
+		cc.mutation.SetVersion(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *ClientsCreate) check() error {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Clients.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Clients.updated_at"`)}
+	}
+	if _, ok := cc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Clients.version"`)}
+	}
+	if v, ok := cc.mutation.Version(); ok {
+		if err := clients.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Clients.version": %w`, err)}
+		}
+	}
 	if _, ok := cc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Clients.name"`)}
 	}
@@ -137,12 +208,6 @@ func (cc *ClientsCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Clients.name": %w`, err)}
 		}
 	}
-	if _, ok := cc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Clients.created_at"`)}
-	}
-	if _, ok := cc.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Clients.updated_at"`)}
-	}
 	return nil
 }
 
@@ -169,6 +234,22 @@ func (cc *ClientsCreate) createSpec() (*Clients, *sqlgraph.CreateSpec) {
 		_node = &Clients{config: cc.config}
 		_spec = sqlgraph.NewCreateSpec(clients.Table, sqlgraph.NewFieldSpec(clients.FieldID, field.TypeInt))
 	)
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(clients.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(clients.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cc.mutation.Version(); ok {
+		_spec.SetField(clients.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(clients.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := cc.mutation.Name(); ok {
 		_spec.SetField(clients.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -181,13 +262,21 @@ func (cc *ClientsCreate) createSpec() (*Clients, *sqlgraph.CreateSpec) {
 		_spec.SetField(clients.FieldImageUrl, field.TypeString, value)
 		_node.ImageUrl = value
 	}
-	if value, ok := cc.mutation.CreatedAt(); ok {
-		_spec.SetField(clients.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
-	}
-	if value, ok := cc.mutation.UpdatedAt(); ok {
-		_spec.SetField(clients.FieldUpdatedAt, field.TypeTime, value)
-		_node.UpdatedAt = value
+	if nodes := cc.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   clients.ProjectsTable,
+			Columns: []string{clients.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
@@ -4,10 +4,12 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"project-manager/ent/clients"
 	"project-manager/ent/predicate"
+	"project-manager/ent/projects"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -18,10 +20,11 @@ import (
 // ClientsQuery is the builder for querying Clients entities.
 type ClientsQuery struct {
 	config
-	ctx        *QueryContext
-	order      []clients.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Clients
+	ctx          *QueryContext
+	order        []clients.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Clients
+	withProjects *ProjectsQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +61,28 @@ func (cq *ClientsQuery) Order(o ...clients.OrderOption) *ClientsQuery {
 	return cq
 }
 
+// QueryProjects chains the current query on the "projects" edge.
+func (cq *ClientsQuery) QueryProjects() *ProjectsQuery {
+	query := (&ProjectsClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(clients.Table, clients.FieldID, selector),
+			sqlgraph.To(projects.Table, projects.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, clients.ProjectsTable, clients.ProjectsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Clients entity from the query.
 // Returns a *NotFoundError when no Clients was found.
 func (cq *ClientsQuery) First(ctx context.Context) (*Clients, error) {
@@ -245,29 +270,41 @@ func (cq *ClientsQuery) Clone() *ClientsQuery {
 		return nil
 	}
 	return &ClientsQuery{
-		config:     cq.config,
-		ctx:        cq.ctx.Clone(),
-		order:      append([]clients.OrderOption{}, cq.order...),
-		inters:     append([]Interceptor{}, cq.inters...),
-		predicates: append([]predicate.Clients{}, cq.predicates...),
+		config:       cq.config,
+		ctx:          cq.ctx.Clone(),
+		order:        append([]clients.OrderOption{}, cq.order...),
+		inters:       append([]Interceptor{}, cq.inters...),
+		predicates:   append([]predicate.Clients{}, cq.predicates...),
+		withProjects: cq.withProjects.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
 	}
 }
 
+// WithProjects tells the query-builder to eager-load the nodes that are connected to
+// the "projects" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ClientsQuery) WithProjects(opts ...func(*ProjectsQuery)) *ClientsQuery {
+	query := (&ProjectsClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withProjects = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Clients.Query().
-//		GroupBy(clients.FieldName).
+//		GroupBy(clients.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (cq *ClientsQuery) GroupBy(field string, fields ...string) *ClientsGroupBy {
@@ -285,11 +322,11 @@ func (cq *ClientsQuery) GroupBy(field string, fields ...string) *ClientsGroupBy
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Clients.Query().
-//		Select(clients.FieldName).
+//		Select(clients.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (cq *ClientsQuery) Select(fields ...string) *ClientsSelect {
 	cq.ctx.Fields = append(cq.ctx.Fields, fields...)
@@ -332,8 +369,11 @@ func (cq *ClientsQuery) prepareQuery(ctx context.Context) error {
 
 func (cq *ClientsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Clients, error) {
 	var (
-		nodes = []*Clients{}
-		_spec = cq.querySpec()
+		nodes       = []*Clients{}
+		_spec       = cq.querySpec()
+		loadedTypes = [1]bool{
+			cq.withProjects != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Clients).scanValues(nil, columns)
@@ -341,6 +381,7 @@ func (cq *ClientsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Clie
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Clients{config: cq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +393,48 @@ func (cq *ClientsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Clie
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := cq.withProjects; query != nil {
+		if err := cq.loadProjects(ctx, query, nodes,
+			func(n *Clients) { n.Edges.Projects = []*Projects{} },
+			func(n *Clients, e *Projects) { n.Edges.Projects = append(n.Edges.Projects, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (cq *ClientsQuery) loadProjects(ctx context.Context, query *ProjectsQuery, nodes []*Clients, init func(*Clients), assign func(*Clients, *Projects)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Clients)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Projects(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(clients.ProjectsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.clients_projects
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "clients_projects" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "clients_projects" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (cq *ClientsQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
 	_spec.Node.Columns = cq.ctx.Fields
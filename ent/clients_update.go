@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"project-manager/ent/clients"
 	"project-manager/ent/predicate"
+	"project-manager/ent/projects"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -28,6 +29,53 @@ func (cu *ClientsUpdate) Where(ps ...predicate.Clients) *ClientsUpdate {
 	return cu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *ClientsUpdate) SetUpdatedAt(t time.Time) *ClientsUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetVersion sets the "version" field.
+func (cu *ClientsUpdate) SetVersion(i int) *ClientsUpdate {
+	cu.mutation.ResetVersion()
+	cu.mutation.SetVersion(i)
+	return cu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cu *ClientsUpdate) SetNillableVersion(i *int) *ClientsUpdate {
+	if i != nil {
+		cu.SetVersion(*i)
+	}
+	return cu
+}
+
+// AddVersion adds i to the "version" field.
+func (cu *ClientsUpdate) AddVersion(i int) *ClientsUpdate {
+	cu.mutation.AddVersion(i)
+	return cu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *ClientsUpdate) SetDeletedAt(t time.Time) *ClientsUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *ClientsUpdate) SetNillableDeletedAt(t *time.Time) *ClientsUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *ClientsUpdate) ClearDeletedAt() *ClientsUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
 // SetName sets the "name" field.
 func (cu *ClientsUpdate) SetName(s string) *ClientsUpdate {
 	cu.mutation.SetName(s)
@@ -82,34 +130,52 @@ func (cu *ClientsUpdate) ClearImageUrl() *ClientsUpdate {
 	return cu
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (cu *ClientsUpdate) SetCreatedAt(t time.Time) *ClientsUpdate {
-	cu.mutation.SetCreatedAt(t)
+// AddProjectIDs adds the "projects" edge to the Projects entity by IDs.
+func (cu *ClientsUpdate) AddProjectIDs(ids ...int) *ClientsUpdate {
+	cu.mutation.AddProjectIDs(ids...)
 	return cu
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (cu *ClientsUpdate) SetNillableCreatedAt(t *time.Time) *ClientsUpdate {
-	if t != nil {
-		cu.SetCreatedAt(*t)
+// AddProjects adds the "projects" edges to the Projects entity.
+func (cu *ClientsUpdate) AddProjects(p ...*Projects) *ClientsUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return cu.AddProjectIDs(ids...)
+}
+
+// Mutation returns the ClientsMutation object of the builder.
+func (cu *ClientsUpdate) Mutation() *ClientsMutation {
+	return cu.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Projects entity.
+func (cu *ClientsUpdate) ClearProjects() *ClientsUpdate {
+	cu.mutation.ClearProjects()
 	return cu
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (cu *ClientsUpdate) SetUpdatedAt(t time.Time) *ClientsUpdate {
-	cu.mutation.SetUpdatedAt(t)
+// RemoveProjectIDs removes the "projects" edge to Projects entities by IDs.
+func (cu *ClientsUpdate) RemoveProjectIDs(ids ...int) *ClientsUpdate {
+	cu.mutation.RemoveProjectIDs(ids...)
 	return cu
 }
 
-// Mutation returns the ClientsMutation object of the builder.
-func (cu *ClientsUpdate) Mutation() *ClientsMutation {
-	return cu.mutation
+// RemoveProjects removes "projects" edges to Projects entities.
+func (cu *ClientsUpdate) RemoveProjects(p ...*Projects) *ClientsUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.RemoveProjectIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *ClientsUpdate) Save(ctx context.Context) (int, error) {
-	cu.defaults()
+	if err := cu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
 }
 
@@ -136,15 +202,24 @@ func (cu *ClientsUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cu *ClientsUpdate) defaults() {
+func (cu *ClientsUpdate) defaults() error {
 	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		if clients.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized clients.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := clients.UpdateDefaultUpdatedAt()
 		cu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (cu *ClientsUpdate) check() error {
+	if v, ok := cu.mutation.Version(); ok {
+		if err := clients.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Clients.version": %w`, err)}
+		}
+	}
 	if v, ok := cu.mutation.Name(); ok {
 		if err := clients.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Clients.name": %w`, err)}
@@ -165,6 +240,21 @@ func (cu *ClientsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(clients.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.Version(); ok {
+		_spec.SetField(clients.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedVersion(); ok {
+		_spec.AddField(clients.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(clients.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(clients.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := cu.mutation.Name(); ok {
 		_spec.SetField(clients.FieldName, field.TypeString, value)
 	}
@@ -180,11 +270,50 @@ func (cu *ClientsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.ImageUrlCleared() {
 		_spec.ClearField(clients.FieldImageUrl, field.TypeString)
 	}
-	if value, ok := cu.mutation.CreatedAt(); ok {
-		_spec.SetField(clients.FieldCreatedAt, field.TypeTime, value)
-	}
-	if value, ok := cu.mutation.UpdatedAt(); ok {
-		_spec.SetField(clients.FieldUpdatedAt, field.TypeTime, value)
+	if cu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   clients.ProjectsTable,
+			Columns: []string{clients.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !cu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   clients.ProjectsTable,
+			Columns: []string{clients.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   clients.ProjectsTable,
+			Columns: []string{clients.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -206,6 +335,53 @@ type ClientsUpdateOne struct {
 	mutation *ClientsMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *ClientsUpdateOne) SetUpdatedAt(t time.Time) *ClientsUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetVersion sets the "version" field.
+func (cuo *ClientsUpdateOne) SetVersion(i int) *ClientsUpdateOne {
+	cuo.mutation.ResetVersion()
+	cuo.mutation.SetVersion(i)
+	return cuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cuo *ClientsUpdateOne) SetNillableVersion(i *int) *ClientsUpdateOne {
+	if i != nil {
+		cuo.SetVersion(*i)
+	}
+	return cuo
+}
+
+// AddVersion adds i to the "version" field.
+func (cuo *ClientsUpdateOne) AddVersion(i int) *ClientsUpdateOne {
+	cuo.mutation.AddVersion(i)
+	return cuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *ClientsUpdateOne) SetDeletedAt(t time.Time) *ClientsUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *ClientsUpdateOne) SetNillableDeletedAt(t *time.Time) *ClientsUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *ClientsUpdateOne) ClearDeletedAt() *ClientsUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
 // SetName sets the "name" field.
 func (cuo *ClientsUpdateOne) SetName(s string) *ClientsUpdateOne {
 	cuo.mutation.SetName(s)
@@ -260,29 +436,45 @@ func (cuo *ClientsUpdateOne) ClearImageUrl() *ClientsUpdateOne {
 	return cuo
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (cuo *ClientsUpdateOne) SetCreatedAt(t time.Time) *ClientsUpdateOne {
-	cuo.mutation.SetCreatedAt(t)
+// AddProjectIDs adds the "projects" edge to the Projects entity by IDs.
+func (cuo *ClientsUpdateOne) AddProjectIDs(ids ...int) *ClientsUpdateOne {
+	cuo.mutation.AddProjectIDs(ids...)
 	return cuo
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (cuo *ClientsUpdateOne) SetNillableCreatedAt(t *time.Time) *ClientsUpdateOne {
-	if t != nil {
-		cuo.SetCreatedAt(*t)
+// AddProjects adds the "projects" edges to the Projects entity.
+func (cuo *ClientsUpdateOne) AddProjects(p ...*Projects) *ClientsUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return cuo.AddProjectIDs(ids...)
+}
+
+// Mutation returns the ClientsMutation object of the builder.
+func (cuo *ClientsUpdateOne) Mutation() *ClientsMutation {
+	return cuo.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Projects entity.
+func (cuo *ClientsUpdateOne) ClearProjects() *ClientsUpdateOne {
+	cuo.mutation.ClearProjects()
 	return cuo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (cuo *ClientsUpdateOne) SetUpdatedAt(t time.Time) *ClientsUpdateOne {
-	cuo.mutation.SetUpdatedAt(t)
+// RemoveProjectIDs removes the "projects" edge to Projects entities by IDs.
+func (cuo *ClientsUpdateOne) RemoveProjectIDs(ids ...int) *ClientsUpdateOne {
+	cuo.mutation.RemoveProjectIDs(ids...)
 	return cuo
 }
 
-// Mutation returns the ClientsMutation object of the builder.
-func (cuo *ClientsUpdateOne) Mutation() *ClientsMutation {
-	return cuo.mutation
+// RemoveProjects removes "projects" edges to Projects entities.
+func (cuo *ClientsUpdateOne) RemoveProjects(p ...*Projects) *ClientsUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.RemoveProjectIDs(ids...)
 }
 
 // Where appends a list predicates to the ClientsUpdate builder.
@@ -300,7 +492,9 @@ func (cuo *ClientsUpdateOne) Select(field string, fields ...string) *ClientsUpda
 
 // Save executes the query and returns the updated Clients entity.
 func (cuo *ClientsUpdateOne) Save(ctx context.Context) (*Clients, error) {
-	cuo.defaults()
+	if err := cuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
 }
 
@@ -327,15 +521,24 @@ func (cuo *ClientsUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cuo *ClientsUpdateOne) defaults() {
+func (cuo *ClientsUpdateOne) defaults() error {
 	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		if clients.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized clients.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := clients.UpdateDefaultUpdatedAt()
 		cuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (cuo *ClientsUpdateOne) check() error {
+	if v, ok := cuo.mutation.Version(); ok {
+		if err := clients.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Clients.version": %w`, err)}
+		}
+	}
 	if v, ok := cuo.mutation.Name(); ok {
 		if err := clients.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Clients.name": %w`, err)}
@@ -373,6 +576,21 @@ func (cuo *ClientsUpdateOne) sqlSave(ctx context.Context) (_node *Clients, err e
 			}
 		}
 	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(clients.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.Version(); ok {
+		_spec.SetField(clients.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedVersion(); ok {
+		_spec.AddField(clients.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(clients.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(clients.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := cuo.mutation.Name(); ok {
 		_spec.SetField(clients.FieldName, field.TypeString, value)
 	}
@@ -388,11 +606,50 @@ func (cuo *ClientsUpdateOne) sqlSave(ctx context.Context) (_node *Clients, err e
 	if cuo.mutation.ImageUrlCleared() {
 		_spec.ClearField(clients.FieldImageUrl, field.TypeString)
 	}
-	if value, ok := cuo.mutation.CreatedAt(); ok {
-		_spec.SetField(clients.FieldCreatedAt, field.TypeTime, value)
-	}
-	if value, ok := cuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(clients.FieldUpdatedAt, field.TypeTime, value)
+	if cuo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   clients.ProjectsTable,
+			Columns: []string{clients.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !cuo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   clients.ProjectsTable,
+			Columns: []string{clients.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   clients.ProjectsTable,
+			Columns: []string{clients.ProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &Clients{config: cuo.config}
 	_spec.Assign = _node.assignValues
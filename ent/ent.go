@@ -6,9 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"project-manager/ent/auditevents"
 	"project-manager/ent/clients"
 	"project-manager/ent/packages"
+	"project-manager/ent/projectrevisions"
 	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"project-manager/ent/users"
 	"reflect"
 	"sync"
 
@@ -75,9 +79,13 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			clients.Table:  clients.ValidColumn,
-			packages.Table: packages.ValidColumn,
-			projects.Table: projects.ValidColumn,
+			auditevents.Table:      auditevents.ValidColumn,
+			clients.Table:          clients.ValidColumn,
+			packages.Table:         packages.ValidColumn,
+			projectrevisions.Table: projectrevisions.ValidColumn,
+			projects.Table:         projects.ValidColumn,
+			stacks.Table:           stacks.ValidColumn,
+			users.Table:            users.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -8,6 +8,18 @@ import (
 	"project-manager/ent"
 )
 
+// The AuditEventsFunc type is an adapter to allow the use of ordinary
+// function as AuditEvents mutator.
+type AuditEventsFunc func(context.Context, *ent.AuditEventsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditEventsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditEventsMutation", m)
+}
+
 // The ClientsFunc type is an adapter to allow the use of ordinary
 // function as Clients mutator.
 type ClientsFunc func(context.Context, *ent.ClientsMutation) (ent.Value, error)
@@ -32,6 +44,18 @@ func (f PackagesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PackagesMutation", m)
 }
 
+// The ProjectRevisionsFunc type is an adapter to allow the use of ordinary
+// function as ProjectRevisions mutator.
+type ProjectRevisionsFunc func(context.Context, *ent.ProjectRevisionsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProjectRevisionsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProjectRevisionsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProjectRevisionsMutation", m)
+}
+
 // The ProjectsFunc type is an adapter to allow the use of ordinary
 // function as Projects mutator.
 type ProjectsFunc func(context.Context, *ent.ProjectsMutation) (ent.Value, error)
@@ -44,6 +68,30 @@ func (f ProjectsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProjectsMutation", m)
 }
 
+// The StacksFunc type is an adapter to allow the use of ordinary
+// function as Stacks mutator.
+type StacksFunc func(context.Context, *ent.StacksMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StacksFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StacksMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StacksMutation", m)
+}
+
+// The UsersFunc type is an adapter to allow the use of ordinary
+// function as Users mutator.
+type UsersFunc func(context.Context, *ent.UsersMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsersFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UsersMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UsersMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"project-manager/ent"
+	"project-manager/ent/auditevents"
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
+	"project-manager/ent/predicate"
+	"project-manager/ent/projectrevisions"
+	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"project-manager/ent/users"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// The Query interface represents an operation that queries a graph.
+// By using this interface, users can write generic code that manipulates
+// query builders of different types.
+type Query interface {
+	// Type returns the string representation of the query type.
+	Type() string
+	// Limit the number of records to be returned by this query.
+	Limit(int)
+	// Offset to start from.
+	Offset(int)
+	// Unique configures the query builder to filter duplicate records.
+	Unique(bool)
+	// Order specifies how the records should be ordered.
+	Order(...func(*sql.Selector))
+	// WhereP appends storage-level predicates to the query builder. Using this method, users
+	// can use type-assertion to append predicates that do not depend on any generated package.
+	WhereP(...func(*sql.Selector))
+}
+
+// The Func type is an adapter that allows ordinary functions to be used as interceptors.
+// Unlike traversal functions, interceptors are skipped during graph traversals. Note that the
+// implementation of Func is different from the one defined in entgo.io/ent.InterceptFunc.
+type Func func(context.Context, Query) error
+
+// Intercept calls f(ctx, q) and then applied the next Querier.
+func (f Func) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		query, err := NewQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		if err := f(ctx, query); err != nil {
+			return nil, err
+		}
+		return next.Query(ctx, q)
+	})
+}
+
+// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that calls f.
+type TraverseFunc func(context.Context, Query) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
+	query, err := NewQuery(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, query)
+}
+
+// The AuditEventsFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditEventsFunc func(context.Context, *ent.AuditEventsQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditEventsFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AuditEventsQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AuditEventsQuery", q)
+}
+
+// The TraverseAuditEvents type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditEvents func(context.Context, *ent.AuditEventsQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditEvents) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditEvents) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditEventsQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AuditEventsQuery", q)
+}
+
+// The ClientsFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ClientsFunc func(context.Context, *ent.ClientsQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ClientsFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ClientsQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ClientsQuery", q)
+}
+
+// The TraverseClients type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseClients func(context.Context, *ent.ClientsQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseClients) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseClients) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ClientsQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ClientsQuery", q)
+}
+
+// The PackagesFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PackagesFunc func(context.Context, *ent.PackagesQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PackagesFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PackagesQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PackagesQuery", q)
+}
+
+// The TraversePackages type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePackages func(context.Context, *ent.PackagesQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePackages) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePackages) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PackagesQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PackagesQuery", q)
+}
+
+// The ProjectRevisionsFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ProjectRevisionsFunc func(context.Context, *ent.ProjectRevisionsQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ProjectRevisionsFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ProjectRevisionsQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ProjectRevisionsQuery", q)
+}
+
+// The TraverseProjectRevisions type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseProjectRevisions func(context.Context, *ent.ProjectRevisionsQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseProjectRevisions) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseProjectRevisions) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ProjectRevisionsQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ProjectRevisionsQuery", q)
+}
+
+// The ProjectsFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ProjectsFunc func(context.Context, *ent.ProjectsQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ProjectsFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ProjectsQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ProjectsQuery", q)
+}
+
+// The TraverseProjects type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseProjects func(context.Context, *ent.ProjectsQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseProjects) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseProjects) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ProjectsQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ProjectsQuery", q)
+}
+
+// The StacksFunc type is an adapter to allow the use of ordinary function as a Querier.
+type StacksFunc func(context.Context, *ent.StacksQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f StacksFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.StacksQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.StacksQuery", q)
+}
+
+// The TraverseStacks type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseStacks func(context.Context, *ent.StacksQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseStacks) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseStacks) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StacksQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.StacksQuery", q)
+}
+
+// The UsersFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UsersFunc func(context.Context, *ent.UsersQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UsersFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UsersQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UsersQuery", q)
+}
+
+// The TraverseUsers type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUsers func(context.Context, *ent.UsersQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUsers) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUsers) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UsersQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UsersQuery", q)
+}
+
+// NewQuery returns the generic Query interface for the given typed query.
+func NewQuery(q ent.Query) (Query, error) {
+	switch q := q.(type) {
+	case *ent.AuditEventsQuery:
+		return &query[*ent.AuditEventsQuery, predicate.AuditEvents, auditevents.OrderOption]{typ: ent.TypeAuditEvents, tq: q}, nil
+	case *ent.ClientsQuery:
+		return &query[*ent.ClientsQuery, predicate.Clients, clients.OrderOption]{typ: ent.TypeClients, tq: q}, nil
+	case *ent.PackagesQuery:
+		return &query[*ent.PackagesQuery, predicate.Packages, packages.OrderOption]{typ: ent.TypePackages, tq: q}, nil
+	case *ent.ProjectRevisionsQuery:
+		return &query[*ent.ProjectRevisionsQuery, predicate.ProjectRevisions, projectrevisions.OrderOption]{typ: ent.TypeProjectRevisions, tq: q}, nil
+	case *ent.ProjectsQuery:
+		return &query[*ent.ProjectsQuery, predicate.Projects, projects.OrderOption]{typ: ent.TypeProjects, tq: q}, nil
+	case *ent.StacksQuery:
+		return &query[*ent.StacksQuery, predicate.Stacks, stacks.OrderOption]{typ: ent.TypeStacks, tq: q}, nil
+	case *ent.UsersQuery:
+		return &query[*ent.UsersQuery, predicate.Users, users.OrderOption]{typ: ent.TypeUsers, tq: q}, nil
+	default:
+		return nil, fmt.Errorf("unknown query type %T", q)
+	}
+}
+
+type query[T any, P ~func(*sql.Selector), R ~func(*sql.Selector)] struct {
+	typ string
+	tq  interface {
+		Limit(int) T
+		Offset(int) T
+		Unique(bool) T
+		Order(...R) T
+		Where(...P) T
+	}
+}
+
+func (q query[T, P, R]) Type() string {
+	return q.typ
+}
+
+func (q query[T, P, R]) Limit(limit int) {
+	q.tq.Limit(limit)
+}
+
+func (q query[T, P, R]) Offset(offset int) {
+	q.tq.Offset(offset)
+}
+
+func (q query[T, P, R]) Unique(unique bool) {
+	q.tq.Unique(unique)
+}
+
+func (q query[T, P, R]) Order(orders ...func(*sql.Selector)) {
+	rs := make([]R, len(orders))
+	for i := range orders {
+		rs[i] = orders[i]
+	}
+	q.tq.Order(rs...)
+}
+
+func (q query[T, P, R]) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]P, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	q.tq.Where(p...)
+}
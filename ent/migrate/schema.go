@@ -3,64 +3,307 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore"}},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevents_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[2]},
+			},
+			{
+				Name:    "auditevents_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[4]},
+			},
+			{
+				Name:    "auditevents_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[6]},
+			},
+		},
+	}
 	// ClientsColumns holds the columns for the "clients" table.
 	ClientsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "name", Type: field.TypeString, Unique: true, Size: 100},
-		{Name: "link", Type: field.TypeString, Nullable: true},
-		{Name: "image_url", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "link", Type: field.TypeString, Nullable: true},
+		{Name: "image_url", Type: field.TypeString, Nullable: true},
 	}
 	// ClientsTable holds the schema information for the "clients" table.
 	ClientsTable = &schema.Table{
 		Name:       "clients",
 		Columns:    ClientsColumns,
 		PrimaryKey: []*schema.Column{ClientsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "clients_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ClientsColumns[4]},
+			},
+			{
+				Name:    "clients_name",
+				Unique:  true,
+				Columns: []*schema.Column{ClientsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+		},
 	}
 	// PackagesColumns holds the columns for the "packages" table.
 	PackagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "name", Type: field.TypeString, Unique: true, Size: 100},
-		{Name: "link", Type: field.TypeString, Nullable: true},
-		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
-		{Name: "stacks", Type: field.TypeString, Default: "[]"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "link", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
 	}
 	// PackagesTable holds the schema information for the "packages" table.
 	PackagesTable = &schema.Table{
 		Name:       "packages",
 		Columns:    PackagesColumns,
 		PrimaryKey: []*schema.Column{PackagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "packages_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{PackagesColumns[4]},
+			},
+			{
+				Name:    "packages_name",
+				Unique:  true,
+				Columns: []*schema.Column{PackagesColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+		},
+	}
+	// ProjectRevisionsColumns holds the columns for the "project_revisions" table.
+	ProjectRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "link", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "stacks", Type: field.TypeJSON, Nullable: true},
+		{Name: "client_id", Type: field.TypeInt, Nullable: true},
+		{Name: "package_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// ProjectRevisionsTable holds the schema information for the "project_revisions" table.
+	ProjectRevisionsTable = &schema.Table{
+		Name:       "project_revisions",
+		Columns:    ProjectRevisionsColumns,
+		PrimaryKey: []*schema.Column{ProjectRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_revisions_projects_revisions",
+				Columns:    []*schema.Column{ProjectRevisionsColumns[10]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectrevisions_project_id_revision",
+				Unique:  true,
+				Columns: []*schema.Column{ProjectRevisionsColumns[10], ProjectRevisionsColumns[1]},
+			},
+		},
 	}
 	// ProjectsColumns holds the columns for the "projects" table.
 	ProjectsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "image_url", Type: field.TypeString, Nullable: true},
 		{Name: "link", Type: field.TypeString, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
-		{Name: "stacks", Type: field.TypeString, Default: "[]"},
+		{Name: "clients_projects", Type: field.TypeInt, Nullable: true},
 	}
 	// ProjectsTable holds the schema information for the "projects" table.
 	ProjectsTable = &schema.Table{
 		Name:       "projects",
 		Columns:    ProjectsColumns,
 		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "projects_clients_projects",
+				Columns:    []*schema.Column{ProjectsColumns[9]},
+				RefColumns: []*schema.Column{ClientsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projects_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[4]},
+			},
+		},
+	}
+	// StacksColumns holds the columns for the "stacks" table.
+	StacksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 100},
+		{Name: "category", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "icon_url", Type: field.TypeString, Nullable: true},
+	}
+	// StacksTable holds the schema information for the "stacks" table.
+	StacksTable = &schema.Table{
+		Name:       "stacks",
+		Columns:    StacksColumns,
+		PrimaryKey: []*schema.Column{StacksColumns[0]},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "email", Type: field.TypeString, Unique: true, Size: 254},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "editor", "viewer"}, Default: "viewer"},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// ProjectsPackagesColumns holds the columns for the "projects_packages" table.
+	ProjectsPackagesColumns = []*schema.Column{
+		{Name: "projects_id", Type: field.TypeInt},
+		{Name: "packages_id", Type: field.TypeInt},
+	}
+	// ProjectsPackagesTable holds the schema information for the "projects_packages" table.
+	ProjectsPackagesTable = &schema.Table{
+		Name:       "projects_packages",
+		Columns:    ProjectsPackagesColumns,
+		PrimaryKey: []*schema.Column{ProjectsPackagesColumns[0], ProjectsPackagesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "projects_packages_projects_id",
+				Columns:    []*schema.Column{ProjectsPackagesColumns[0]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "projects_packages_packages_id",
+				Columns:    []*schema.Column{ProjectsPackagesColumns[1]},
+				RefColumns: []*schema.Column{PackagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// StacksProjectsColumns holds the columns for the "stacks_projects" table.
+	StacksProjectsColumns = []*schema.Column{
+		{Name: "stacks_id", Type: field.TypeInt},
+		{Name: "projects_id", Type: field.TypeInt},
+	}
+	// StacksProjectsTable holds the schema information for the "stacks_projects" table.
+	StacksProjectsTable = &schema.Table{
+		Name:       "stacks_projects",
+		Columns:    StacksProjectsColumns,
+		PrimaryKey: []*schema.Column{StacksProjectsColumns[0], StacksProjectsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stacks_projects_stacks_id",
+				Columns:    []*schema.Column{StacksProjectsColumns[0]},
+				RefColumns: []*schema.Column{StacksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "stacks_projects_projects_id",
+				Columns:    []*schema.Column{StacksProjectsColumns[1]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// StacksPackagesColumns holds the columns for the "stacks_packages" table.
+	StacksPackagesColumns = []*schema.Column{
+		{Name: "stacks_id", Type: field.TypeInt},
+		{Name: "packages_id", Type: field.TypeInt},
+	}
+	// StacksPackagesTable holds the schema information for the "stacks_packages" table.
+	StacksPackagesTable = &schema.Table{
+		Name:       "stacks_packages",
+		Columns:    StacksPackagesColumns,
+		PrimaryKey: []*schema.Column{StacksPackagesColumns[0], StacksPackagesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stacks_packages_stacks_id",
+				Columns:    []*schema.Column{StacksPackagesColumns[0]},
+				RefColumns: []*schema.Column{StacksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "stacks_packages_packages_id",
+				Columns:    []*schema.Column{StacksPackagesColumns[1]},
+				RefColumns: []*schema.Column{PackagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEventsTable,
 		ClientsTable,
 		PackagesTable,
+		ProjectRevisionsTable,
 		ProjectsTable,
+		StacksTable,
+		UsersTable,
+		ProjectsPackagesTable,
+		StacksProjectsTable,
+		StacksPackagesTable,
 	}
 )
 
 func init() {
+	ProjectRevisionsTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectsTable.ForeignKeys[0].RefTable = ClientsTable
+	ProjectsPackagesTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectsPackagesTable.ForeignKeys[1].RefTable = PackagesTable
+	StacksProjectsTable.ForeignKeys[0].RefTable = StacksTable
+	StacksProjectsTable.ForeignKeys[1].RefTable = ProjectsTable
+	StacksPackagesTable.ForeignKeys[0].RefTable = StacksTable
+	StacksPackagesTable.ForeignKeys[1].RefTable = PackagesTable
 }
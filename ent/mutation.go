@@ -6,10 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"project-manager/ent/auditevents"
 	"project-manager/ent/clients"
 	"project-manager/ent/packages"
 	"project-manager/ent/predicate"
+	"project-manager/ent/projectrevisions"
 	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"project-manager/ent/users"
+	"project-manager/internal/models"
 	"sync"
 	"time"
 
@@ -26,39 +31,46 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeClients  = "Clients"
-	TypePackages = "Packages"
-	TypeProjects = "Projects"
+	TypeAuditEvents      = "AuditEvents"
+	TypeClients          = "Clients"
+	TypePackages         = "Packages"
+	TypeProjectRevisions = "ProjectRevisions"
+	TypeProjects         = "Projects"
+	TypeStacks           = "Stacks"
+	TypeUsers            = "Users"
 )
 
-// ClientsMutation represents an operation that mutates the Clients nodes in the graph.
-type ClientsMutation struct {
+// AuditEventsMutation represents an operation that mutates the AuditEvents nodes in the graph.
+type AuditEventsMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	name          *string
-	link          *string
-	imageUrl      *string
+	entity_type   *string
+	entity_id     *int
+	addentity_id  *int
+	operation     *auditevents.Operation
+	actor_id      *int
+	addactor_id   *int
+	changes       *map[string]models.FieldChange
 	created_at    *time.Time
-	updated_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Clients, error)
-	predicates    []predicate.Clients
+	oldValue      func(context.Context) (*AuditEvents, error)
+	predicates    []predicate.AuditEvents
 }
 
-var _ ent.Mutation = (*ClientsMutation)(nil)
+var _ ent.Mutation = (*AuditEventsMutation)(nil)
 
-// clientsOption allows management of the mutation configuration using functional options.
-type clientsOption func(*ClientsMutation)
+// auditeventsOption allows management of the mutation configuration using functional options.
+type auditeventsOption func(*AuditEventsMutation)
 
-// newClientsMutation creates new mutation for the Clients entity.
-func newClientsMutation(c config, op Op, opts ...clientsOption) *ClientsMutation {
-	m := &ClientsMutation{
+// newAuditEventsMutation creates new mutation for the AuditEvents entity.
+func newAuditEventsMutation(c config, op Op, opts ...auditeventsOption) *AuditEventsMutation {
+	m := &AuditEventsMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeClients,
+		typ:           TypeAuditEvents,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -67,20 +79,20 @@ func newClientsMutation(c config, op Op, opts ...clientsOption) *ClientsMutation
 	return m
 }
 
-// withClientsID sets the ID field of the mutation.
-func withClientsID(id int) clientsOption {
-	return func(m *ClientsMutation) {
+// withAuditEventsID sets the ID field of the mutation.
+func withAuditEventsID(id int) auditeventsOption {
+	return func(m *AuditEventsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Clients
+			value *AuditEvents
 		)
-		m.oldValue = func(ctx context.Context) (*Clients, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEvents, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Clients.Get(ctx, id)
+					value, err = m.Client().AuditEvents.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -89,10 +101,10 @@ func withClientsID(id int) clientsOption {
 	}
 }
 
-// withClients sets the old Clients of the mutation.
-func withClients(node *Clients) clientsOption {
-	return func(m *ClientsMutation) {
-		m.oldValue = func(context.Context) (*Clients, error) {
+// withAuditEvents sets the old AuditEvents of the mutation.
+func withAuditEvents(node *AuditEvents) auditeventsOption {
+	return func(m *AuditEventsMutation) {
+		m.oldValue = func(context.Context) (*AuditEvents, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -101,7 +113,7 @@ func withClients(node *Clients) clientsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ClientsMutation) Client() *Client {
+func (m AuditEventsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -109,7 +121,7 @@ func (m ClientsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ClientsMutation) Tx() (*Tx, error) {
+func (m AuditEventsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -120,7 +132,7 @@ func (m ClientsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ClientsMutation) ID() (id int, exists bool) {
+func (m *AuditEventsMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -131,7 +143,7 @@ func (m *ClientsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ClientsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditEventsMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -140,227 +152,304 @@ func (m *ClientsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Clients.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEvents.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ClientsMutation) SetName(s string) {
-	m.name = &s
+// SetEntityType sets the "entity_type" field.
+func (m *AuditEventsMutation) SetEntityType(s string) {
+	m.entity_type = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ClientsMutation) Name() (r string, exists bool) {
-	v := m.name
+// EntityType returns the value of the "entity_type" field in the mutation.
+func (m *AuditEventsMutation) EntityType() (r string, exists bool) {
+	v := m.entity_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Clients entity.
-// If the Clients object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityType returns the old "entity_type" field's value of the AuditEvents entity.
+// If the AuditEvents object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClientsMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuditEventsMutation) OldEntityType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldEntityType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityType: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.EntityType, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ClientsMutation) ResetName() {
-	m.name = nil
+// ResetEntityType resets all changes to the "entity_type" field.
+func (m *AuditEventsMutation) ResetEntityType() {
+	m.entity_type = nil
 }
 
-// SetLink sets the "link" field.
-func (m *ClientsMutation) SetLink(s string) {
-	m.link = &s
+// SetEntityID sets the "entity_id" field.
+func (m *AuditEventsMutation) SetEntityID(i int) {
+	m.entity_id = &i
+	m.addentity_id = nil
 }
 
-// Link returns the value of the "link" field in the mutation.
-func (m *ClientsMutation) Link() (r string, exists bool) {
-	v := m.link
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *AuditEventsMutation) EntityID() (r int, exists bool) {
+	v := m.entity_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLink returns the old "link" field's value of the Clients entity.
-// If the Clients object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityID returns the old "entity_id" field's value of the AuditEvents entity.
+// If the AuditEvents object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClientsMutation) OldLink(ctx context.Context) (v string, err error) {
+func (m *AuditEventsMutation) OldEntityID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLink requires an ID field in the mutation")
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
 	}
-	return oldValue.Link, nil
+	return oldValue.EntityID, nil
 }
 
-// ClearLink clears the value of the "link" field.
-func (m *ClientsMutation) ClearLink() {
-	m.link = nil
-	m.clearedFields[clients.FieldLink] = struct{}{}
+// AddEntityID adds i to the "entity_id" field.
+func (m *AuditEventsMutation) AddEntityID(i int) {
+	if m.addentity_id != nil {
+		*m.addentity_id += i
+	} else {
+		m.addentity_id = &i
+	}
 }
 
-// LinkCleared returns if the "link" field was cleared in this mutation.
-func (m *ClientsMutation) LinkCleared() bool {
-	_, ok := m.clearedFields[clients.FieldLink]
-	return ok
+// AddedEntityID returns the value that was added to the "entity_id" field in this mutation.
+func (m *AuditEventsMutation) AddedEntityID() (r int, exists bool) {
+	v := m.addentity_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetLink resets all changes to the "link" field.
-func (m *ClientsMutation) ResetLink() {
-	m.link = nil
-	delete(m.clearedFields, clients.FieldLink)
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *AuditEventsMutation) ResetEntityID() {
+	m.entity_id = nil
+	m.addentity_id = nil
 }
 
-// SetImageUrl sets the "imageUrl" field.
-func (m *ClientsMutation) SetImageUrl(s string) {
-	m.imageUrl = &s
+// SetOperation sets the "operation" field.
+func (m *AuditEventsMutation) SetOperation(a auditevents.Operation) {
+	m.operation = &a
 }
 
-// ImageUrl returns the value of the "imageUrl" field in the mutation.
-func (m *ClientsMutation) ImageUrl() (r string, exists bool) {
-	v := m.imageUrl
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AuditEventsMutation) Operation() (r auditevents.Operation, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageUrl returns the old "imageUrl" field's value of the Clients entity.
-// If the Clients object wasn't provided to the builder, the object is fetched from the database.
+// OldOperation returns the old "operation" field's value of the AuditEvents entity.
+// If the AuditEvents object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClientsMutation) OldImageUrl(ctx context.Context) (v string, err error) {
+func (m *AuditEventsMutation) OldOperation(ctx context.Context) (v auditevents.Operation, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageUrl is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageUrl requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageUrl: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.ImageUrl, nil
+	return oldValue.Operation, nil
 }
 
-// ClearImageUrl clears the value of the "imageUrl" field.
-func (m *ClientsMutation) ClearImageUrl() {
-	m.imageUrl = nil
-	m.clearedFields[clients.FieldImageUrl] = struct{}{}
+// ResetOperation resets all changes to the "operation" field.
+func (m *AuditEventsMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// ImageUrlCleared returns if the "imageUrl" field was cleared in this mutation.
-func (m *ClientsMutation) ImageUrlCleared() bool {
-	_, ok := m.clearedFields[clients.FieldImageUrl]
+// SetActorID sets the "actor_id" field.
+func (m *AuditEventsMutation) SetActorID(i int) {
+	m.actor_id = &i
+	m.addactor_id = nil
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AuditEventsMutation) ActorID() (r int, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the AuditEvents entity.
+// If the AuditEvents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventsMutation) OldActorID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// AddActorID adds i to the "actor_id" field.
+func (m *AuditEventsMutation) AddActorID(i int) {
+	if m.addactor_id != nil {
+		*m.addactor_id += i
+	} else {
+		m.addactor_id = &i
+	}
+}
+
+// AddedActorID returns the value that was added to the "actor_id" field in this mutation.
+func (m *AuditEventsMutation) AddedActorID() (r int, exists bool) {
+	v := m.addactor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *AuditEventsMutation) ClearActorID() {
+	m.actor_id = nil
+	m.addactor_id = nil
+	m.clearedFields[auditevents.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *AuditEventsMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[auditevents.FieldActorID]
 	return ok
 }
 
-// ResetImageUrl resets all changes to the "imageUrl" field.
-func (m *ClientsMutation) ResetImageUrl() {
-	m.imageUrl = nil
-	delete(m.clearedFields, clients.FieldImageUrl)
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AuditEventsMutation) ResetActorID() {
+	m.actor_id = nil
+	m.addactor_id = nil
+	delete(m.clearedFields, auditevents.FieldActorID)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ClientsMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetChanges sets the "changes" field.
+func (m *AuditEventsMutation) SetChanges(mc map[string]models.FieldChange) {
+	m.changes = &mc
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ClientsMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Changes returns the value of the "changes" field in the mutation.
+func (m *AuditEventsMutation) Changes() (r map[string]models.FieldChange, exists bool) {
+	v := m.changes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Clients entity.
-// If the Clients object wasn't provided to the builder, the object is fetched from the database.
+// OldChanges returns the old "changes" field's value of the AuditEvents entity.
+// If the AuditEvents object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClientsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventsMutation) OldChanges(ctx context.Context) (v map[string]models.FieldChange, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldChanges requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Changes, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ClientsMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearChanges clears the value of the "changes" field.
+func (m *AuditEventsMutation) ClearChanges() {
+	m.changes = nil
+	m.clearedFields[auditevents.FieldChanges] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ClientsMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *AuditEventsMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[auditevents.FieldChanges]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ClientsMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetChanges resets all changes to the "changes" field.
+func (m *AuditEventsMutation) ResetChanges() {
+	m.changes = nil
+	delete(m.clearedFields, auditevents.FieldChanges)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEventsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEventsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Clients entity.
-// If the Clients object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditEvents entity.
+// If the AuditEvents object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClientsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ClientsMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEventsMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the ClientsMutation builder.
-func (m *ClientsMutation) Where(ps ...predicate.Clients) {
+// Where appends a list predicates to the AuditEventsMutation builder.
+func (m *AuditEventsMutation) Where(ps ...predicate.AuditEvents) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ClientsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditEventsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ClientsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Clients, len(ps))
+func (m *AuditEventsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvents, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -368,39 +457,42 @@ func (m *ClientsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ClientsMutation) Op() Op {
+func (m *AuditEventsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ClientsMutation) SetOp(op Op) {
+func (m *AuditEventsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Clients).
-func (m *ClientsMutation) Type() string {
+// Type returns the node type of this mutation (AuditEvents).
+func (m *AuditEventsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ClientsMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, clients.FieldName)
+func (m *AuditEventsMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.entity_type != nil {
+		fields = append(fields, auditevents.FieldEntityType)
 	}
-	if m.link != nil {
-		fields = append(fields, clients.FieldLink)
+	if m.entity_id != nil {
+		fields = append(fields, auditevents.FieldEntityID)
 	}
-	if m.imageUrl != nil {
-		fields = append(fields, clients.FieldImageUrl)
+	if m.operation != nil {
+		fields = append(fields, auditevents.FieldOperation)
 	}
-	if m.created_at != nil {
-		fields = append(fields, clients.FieldCreatedAt)
+	if m.actor_id != nil {
+		fields = append(fields, auditevents.FieldActorID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, clients.FieldUpdatedAt)
+	if m.changes != nil {
+		fields = append(fields, auditevents.FieldChanges)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditevents.FieldCreatedAt)
 	}
 	return fields
 }
@@ -408,18 +500,20 @@ func (m *ClientsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ClientsMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditEventsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case clients.FieldName:
-		return m.Name()
-	case clients.FieldLink:
-		return m.Link()
-	case clients.FieldImageUrl:
-		return m.ImageUrl()
-	case clients.FieldCreatedAt:
+	case auditevents.FieldEntityType:
+		return m.EntityType()
+	case auditevents.FieldEntityID:
+		return m.EntityID()
+	case auditevents.FieldOperation:
+		return m.Operation()
+	case auditevents.FieldActorID:
+		return m.ActorID()
+	case auditevents.FieldChanges:
+		return m.Changes()
+	case auditevents.FieldCreatedAt:
 		return m.CreatedAt()
-	case clients.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -427,222 +521,266 @@ func (m *ClientsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ClientsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditEventsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case clients.FieldName:
-		return m.OldName(ctx)
-	case clients.FieldLink:
-		return m.OldLink(ctx)
-	case clients.FieldImageUrl:
-		return m.OldImageUrl(ctx)
-	case clients.FieldCreatedAt:
+	case auditevents.FieldEntityType:
+		return m.OldEntityType(ctx)
+	case auditevents.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case auditevents.FieldOperation:
+		return m.OldOperation(ctx)
+	case auditevents.FieldActorID:
+		return m.OldActorID(ctx)
+	case auditevents.FieldChanges:
+		return m.OldChanges(ctx)
+	case auditevents.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case clients.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Clients field %s", name)
+	return nil, fmt.Errorf("unknown AuditEvents field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ClientsMutation) SetField(name string, value ent.Value) error {
+func (m *AuditEventsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case clients.FieldName:
+	case auditevents.FieldEntityType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetEntityType(v)
 		return nil
-	case clients.FieldLink:
-		v, ok := value.(string)
+	case auditevents.FieldEntityID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLink(v)
+		m.SetEntityID(v)
 		return nil
-	case clients.FieldImageUrl:
-		v, ok := value.(string)
+	case auditevents.FieldOperation:
+		v, ok := value.(auditevents.Operation)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageUrl(v)
+		m.SetOperation(v)
 		return nil
-	case clients.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case auditevents.FieldActorID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetActorID(v)
 		return nil
-	case clients.FieldUpdatedAt:
+	case auditevents.FieldChanges:
+		v, ok := value.(map[string]models.FieldChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case auditevents.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Clients field %s", name)
+	return fmt.Errorf("unknown AuditEvents field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ClientsMutation) AddedFields() []string {
-	return nil
+func (m *AuditEventsMutation) AddedFields() []string {
+	var fields []string
+	if m.addentity_id != nil {
+		fields = append(fields, auditevents.FieldEntityID)
+	}
+	if m.addactor_id != nil {
+		fields = append(fields, auditevents.FieldActorID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ClientsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditEventsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditevents.FieldEntityID:
+		return m.AddedEntityID()
+	case auditevents.FieldActorID:
+		return m.AddedActorID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ClientsMutation) AddField(name string, value ent.Value) error {
+func (m *AuditEventsMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case auditevents.FieldEntityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntityID(v)
+		return nil
+	case auditevents.FieldActorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActorID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Clients numeric field %s", name)
+	return fmt.Errorf("unknown AuditEvents numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ClientsMutation) ClearedFields() []string {
+func (m *AuditEventsMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(clients.FieldLink) {
-		fields = append(fields, clients.FieldLink)
+	if m.FieldCleared(auditevents.FieldActorID) {
+		fields = append(fields, auditevents.FieldActorID)
 	}
-	if m.FieldCleared(clients.FieldImageUrl) {
-		fields = append(fields, clients.FieldImageUrl)
+	if m.FieldCleared(auditevents.FieldChanges) {
+		fields = append(fields, auditevents.FieldChanges)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ClientsMutation) FieldCleared(name string) bool {
+func (m *AuditEventsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ClientsMutation) ClearField(name string) error {
+func (m *AuditEventsMutation) ClearField(name string) error {
 	switch name {
-	case clients.FieldLink:
-		m.ClearLink()
+	case auditevents.FieldActorID:
+		m.ClearActorID()
 		return nil
-	case clients.FieldImageUrl:
-		m.ClearImageUrl()
+	case auditevents.FieldChanges:
+		m.ClearChanges()
 		return nil
 	}
-	return fmt.Errorf("unknown Clients nullable field %s", name)
+	return fmt.Errorf("unknown AuditEvents nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ClientsMutation) ResetField(name string) error {
+func (m *AuditEventsMutation) ResetField(name string) error {
 	switch name {
-	case clients.FieldName:
-		m.ResetName()
+	case auditevents.FieldEntityType:
+		m.ResetEntityType()
 		return nil
-	case clients.FieldLink:
-		m.ResetLink()
+	case auditevents.FieldEntityID:
+		m.ResetEntityID()
 		return nil
-	case clients.FieldImageUrl:
-		m.ResetImageUrl()
+	case auditevents.FieldOperation:
+		m.ResetOperation()
 		return nil
-	case clients.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case auditevents.FieldActorID:
+		m.ResetActorID()
 		return nil
-	case clients.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case auditevents.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case auditevents.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Clients field %s", name)
+	return fmt.Errorf("unknown AuditEvents field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ClientsMutation) AddedEdges() []string {
+func (m *AuditEventsMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ClientsMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditEventsMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ClientsMutation) RemovedEdges() []string {
+func (m *AuditEventsMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ClientsMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditEventsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ClientsMutation) ClearedEdges() []string {
+func (m *AuditEventsMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ClientsMutation) EdgeCleared(name string) bool {
+func (m *AuditEventsMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ClientsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Clients unique edge %s", name)
+func (m *AuditEventsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvents unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ClientsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Clients edge %s", name)
+func (m *AuditEventsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvents edge %s", name)
 }
 
-// PackagesMutation represents an operation that mutates the Packages nodes in the graph.
-type PackagesMutation struct {
+// ClientsMutation represents an operation that mutates the Clients nodes in the graph.
+type ClientsMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	link          *string
-	description   *string
-	stacks        *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Packages, error)
-	predicates    []predicate.Packages
+	op              Op
+	typ             string
+	id              *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	version         *int
+	addversion      *int
+	deleted_at      *time.Time
+	name            *string
+	link            *string
+	imageUrl        *string
+	clearedFields   map[string]struct{}
+	projects        map[int]struct{}
+	removedprojects map[int]struct{}
+	clearedprojects bool
+	done            bool
+	oldValue        func(context.Context) (*Clients, error)
+	predicates      []predicate.Clients
 }
 
-var _ ent.Mutation = (*PackagesMutation)(nil)
+var _ ent.Mutation = (*ClientsMutation)(nil)
 
-// packagesOption allows management of the mutation configuration using functional options.
-type packagesOption func(*PackagesMutation)
+// clientsOption allows management of the mutation configuration using functional options.
+type clientsOption func(*ClientsMutation)
 
-// newPackagesMutation creates new mutation for the Packages entity.
-func newPackagesMutation(c config, op Op, opts ...packagesOption) *PackagesMutation {
-	m := &PackagesMutation{
+// newClientsMutation creates new mutation for the Clients entity.
+func newClientsMutation(c config, op Op, opts ...clientsOption) *ClientsMutation {
+	m := &ClientsMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePackages,
+		typ:           TypeClients,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -651,20 +789,20 @@ func newPackagesMutation(c config, op Op, opts ...packagesOption) *PackagesMutat
 	return m
 }
 
-// withPackagesID sets the ID field of the mutation.
-func withPackagesID(id int) packagesOption {
-	return func(m *PackagesMutation) {
+// withClientsID sets the ID field of the mutation.
+func withClientsID(id int) clientsOption {
+	return func(m *ClientsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Packages
+			value *Clients
 		)
-		m.oldValue = func(ctx context.Context) (*Packages, error) {
+		m.oldValue = func(ctx context.Context) (*Clients, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Packages.Get(ctx, id)
+					value, err = m.Client().Clients.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -673,10 +811,10 @@ func withPackagesID(id int) packagesOption {
 	}
 }
 
-// withPackages sets the old Packages of the mutation.
-func withPackages(node *Packages) packagesOption {
-	return func(m *PackagesMutation) {
-		m.oldValue = func(context.Context) (*Packages, error) {
+// withClients sets the old Clients of the mutation.
+func withClients(node *Clients) clientsOption {
+	return func(m *ClientsMutation) {
+		m.oldValue = func(context.Context) (*Clients, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -685,7 +823,7 @@ func withPackages(node *Packages) packagesOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PackagesMutation) Client() *Client {
+func (m ClientsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -693,7 +831,7 @@ func (m PackagesMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PackagesMutation) Tx() (*Tx, error) {
+func (m ClientsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -704,7 +842,7 @@ func (m PackagesMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PackagesMutation) ID() (id int, exists bool) {
+func (m *ClientsMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -715,7 +853,7 @@ func (m *PackagesMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PackagesMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ClientsMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -724,263 +862,4357 @@ func (m *PackagesMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Packages.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Clients.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *PackagesMutation) SetName(s string) {
-	m.name = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *ClientsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PackagesMutation) Name() (r string, exists bool) {
-	v := m.name
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ClientsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Packages entity.
-// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Clients entity.
+// If the Clients object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PackagesMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ClientsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PackagesMutation) ResetName() {
-	m.name = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ClientsMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetLink sets the "link" field.
-func (m *PackagesMutation) SetLink(s string) {
-	m.link = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ClientsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Link returns the value of the "link" field in the mutation.
-func (m *PackagesMutation) Link() (r string, exists bool) {
-	v := m.link
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ClientsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLink returns the old "link" field's value of the Packages entity.
-// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Clients entity.
+// If the Clients object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PackagesMutation) OldLink(ctx context.Context) (v string, err error) {
+func (m *ClientsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLink requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Link, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearLink clears the value of the "link" field.
-func (m *PackagesMutation) ClearLink() {
-	m.link = nil
-	m.clearedFields[packages.FieldLink] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ClientsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// LinkCleared returns if the "link" field was cleared in this mutation.
-func (m *PackagesMutation) LinkCleared() bool {
-	_, ok := m.clearedFields[packages.FieldLink]
-	return ok
+// SetVersion sets the "version" field.
+func (m *ClientsMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// ResetLink resets all changes to the "link" field.
-func (m *PackagesMutation) ResetLink() {
-	m.link = nil
-	delete(m.clearedFields, packages.FieldLink)
+// Version returns the value of the "version" field in the mutation.
+func (m *ClientsMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetDescription sets the "description" field.
-func (m *PackagesMutation) SetDescription(s string) {
-	m.description = &s
+// OldVersion returns the old "version" field's value of the Clients entity.
+// If the Clients object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientsMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PackagesMutation) Description() (r string, exists bool) {
-	v := m.description
+// AddVersion adds i to the "version" field.
+func (m *ClientsMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ClientsMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Packages entity.
-// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// ResetVersion resets all changes to the "version" field.
+func (m *ClientsMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ClientsMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ClientsMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Clients entity.
+// If the Clients object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PackagesMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ClientsMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *PackagesMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[packages.FieldDescription] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ClientsMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[clients.FieldDeletedAt] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *PackagesMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[packages.FieldDescription]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ClientsMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[clients.FieldDeletedAt]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PackagesMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, packages.FieldDescription)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ClientsMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, clients.FieldDeletedAt)
 }
 
-// SetStacks sets the "stacks" field.
-func (m *PackagesMutation) SetStacks(s string) {
-	m.stacks = &s
+// SetName sets the "name" field.
+func (m *ClientsMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Stacks returns the value of the "stacks" field in the mutation.
-func (m *PackagesMutation) Stacks() (r string, exists bool) {
-	v := m.stacks
+// Name returns the value of the "name" field in the mutation.
+func (m *ClientsMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStacks returns the old "stacks" field's value of the Packages entity.
-// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Clients entity.
+// If the Clients object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PackagesMutation) OldStacks(ctx context.Context) (v string, err error) {
+func (m *ClientsMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStacks is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStacks requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStacks: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Stacks, nil
+	return oldValue.Name, nil
 }
 
-// ResetStacks resets all changes to the "stacks" field.
-func (m *PackagesMutation) ResetStacks() {
-	m.stacks = nil
+// ResetName resets all changes to the "name" field.
+func (m *ClientsMutation) ResetName() {
+	m.name = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PackagesMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLink sets the "link" field.
+func (m *ClientsMutation) SetLink(s string) {
+	m.link = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PackagesMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Link returns the value of the "link" field in the mutation.
+func (m *ClientsMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the Clients entity.
+// If the Clients object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientsMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ClearLink clears the value of the "link" field.
+func (m *ClientsMutation) ClearLink() {
+	m.link = nil
+	m.clearedFields[clients.FieldLink] = struct{}{}
+}
+
+// LinkCleared returns if the "link" field was cleared in this mutation.
+func (m *ClientsMutation) LinkCleared() bool {
+	_, ok := m.clearedFields[clients.FieldLink]
+	return ok
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *ClientsMutation) ResetLink() {
+	m.link = nil
+	delete(m.clearedFields, clients.FieldLink)
+}
+
+// SetImageUrl sets the "imageUrl" field.
+func (m *ClientsMutation) SetImageUrl(s string) {
+	m.imageUrl = &s
+}
+
+// ImageUrl returns the value of the "imageUrl" field in the mutation.
+func (m *ClientsMutation) ImageUrl() (r string, exists bool) {
+	v := m.imageUrl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageUrl returns the old "imageUrl" field's value of the Clients entity.
+// If the Clients object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientsMutation) OldImageUrl(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageUrl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageUrl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageUrl: %w", err)
+	}
+	return oldValue.ImageUrl, nil
+}
+
+// ClearImageUrl clears the value of the "imageUrl" field.
+func (m *ClientsMutation) ClearImageUrl() {
+	m.imageUrl = nil
+	m.clearedFields[clients.FieldImageUrl] = struct{}{}
+}
+
+// ImageUrlCleared returns if the "imageUrl" field was cleared in this mutation.
+func (m *ClientsMutation) ImageUrlCleared() bool {
+	_, ok := m.clearedFields[clients.FieldImageUrl]
+	return ok
+}
+
+// ResetImageUrl resets all changes to the "imageUrl" field.
+func (m *ClientsMutation) ResetImageUrl() {
+	m.imageUrl = nil
+	delete(m.clearedFields, clients.FieldImageUrl)
+}
+
+// AddProjectIDs adds the "projects" edge to the Projects entity by ids.
+func (m *ClientsMutation) AddProjectIDs(ids ...int) {
+	if m.projects == nil {
+		m.projects = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.projects[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProjects clears the "projects" edge to the Projects entity.
+func (m *ClientsMutation) ClearProjects() {
+	m.clearedprojects = true
+}
+
+// ProjectsCleared reports if the "projects" edge to the Projects entity was cleared.
+func (m *ClientsMutation) ProjectsCleared() bool {
+	return m.clearedprojects
+}
+
+// RemoveProjectIDs removes the "projects" edge to the Projects entity by IDs.
+func (m *ClientsMutation) RemoveProjectIDs(ids ...int) {
+	if m.removedprojects == nil {
+		m.removedprojects = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.projects, ids[i])
+		m.removedprojects[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProjects returns the removed IDs of the "projects" edge to the Projects entity.
+func (m *ClientsMutation) RemovedProjectsIDs() (ids []int) {
+	for id := range m.removedprojects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProjectsIDs returns the "projects" edge IDs in the mutation.
+func (m *ClientsMutation) ProjectsIDs() (ids []int) {
+	for id := range m.projects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProjects resets all changes to the "projects" edge.
+func (m *ClientsMutation) ResetProjects() {
+	m.projects = nil
+	m.clearedprojects = false
+	m.removedprojects = nil
+}
+
+// Where appends a list predicates to the ClientsMutation builder.
+func (m *ClientsMutation) Where(ps ...predicate.Clients) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ClientsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ClientsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Clients, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ClientsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ClientsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Clients).
+func (m *ClientsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ClientsMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, clients.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, clients.FieldUpdatedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, clients.FieldVersion)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, clients.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, clients.FieldName)
+	}
+	if m.link != nil {
+		fields = append(fields, clients.FieldLink)
+	}
+	if m.imageUrl != nil {
+		fields = append(fields, clients.FieldImageUrl)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ClientsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case clients.FieldCreatedAt:
+		return m.CreatedAt()
+	case clients.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case clients.FieldVersion:
+		return m.Version()
+	case clients.FieldDeletedAt:
+		return m.DeletedAt()
+	case clients.FieldName:
+		return m.Name()
+	case clients.FieldLink:
+		return m.Link()
+	case clients.FieldImageUrl:
+		return m.ImageUrl()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ClientsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case clients.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case clients.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case clients.FieldVersion:
+		return m.OldVersion(ctx)
+	case clients.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case clients.FieldName:
+		return m.OldName(ctx)
+	case clients.FieldLink:
+		return m.OldLink(ctx)
+	case clients.FieldImageUrl:
+		return m.OldImageUrl(ctx)
+	}
+	return nil, fmt.Errorf("unknown Clients field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClientsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case clients.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case clients.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case clients.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case clients.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case clients.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case clients.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case clients.FieldImageUrl:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageUrl(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Clients field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ClientsMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, clients.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ClientsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case clients.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClientsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case clients.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Clients numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ClientsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(clients.FieldDeletedAt) {
+		fields = append(fields, clients.FieldDeletedAt)
+	}
+	if m.FieldCleared(clients.FieldLink) {
+		fields = append(fields, clients.FieldLink)
+	}
+	if m.FieldCleared(clients.FieldImageUrl) {
+		fields = append(fields, clients.FieldImageUrl)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ClientsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ClientsMutation) ClearField(name string) error {
+	switch name {
+	case clients.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case clients.FieldLink:
+		m.ClearLink()
+		return nil
+	case clients.FieldImageUrl:
+		m.ClearImageUrl()
+		return nil
+	}
+	return fmt.Errorf("unknown Clients nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ClientsMutation) ResetField(name string) error {
+	switch name {
+	case clients.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case clients.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case clients.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case clients.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case clients.FieldName:
+		m.ResetName()
+		return nil
+	case clients.FieldLink:
+		m.ResetLink()
+		return nil
+	case clients.FieldImageUrl:
+		m.ResetImageUrl()
+		return nil
+	}
+	return fmt.Errorf("unknown Clients field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ClientsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.projects != nil {
+		edges = append(edges, clients.EdgeProjects)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ClientsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case clients.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.projects))
+		for id := range m.projects {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ClientsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedprojects != nil {
+		edges = append(edges, clients.EdgeProjects)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ClientsMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case clients.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.removedprojects))
+		for id := range m.removedprojects {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ClientsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprojects {
+		edges = append(edges, clients.EdgeProjects)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ClientsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case clients.EdgeProjects:
+		return m.clearedprojects
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ClientsMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Clients unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ClientsMutation) ResetEdge(name string) error {
+	switch name {
+	case clients.EdgeProjects:
+		m.ResetProjects()
+		return nil
+	}
+	return fmt.Errorf("unknown Clients edge %s", name)
+}
+
+// PackagesMutation represents an operation that mutates the Packages nodes in the graph.
+type PackagesMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	version         *int
+	addversion      *int
+	deleted_at      *time.Time
+	name            *string
+	link            *string
+	description     *string
+	clearedFields   map[string]struct{}
+	projects        map[int]struct{}
+	removedprojects map[int]struct{}
+	clearedprojects bool
+	stacks          map[int]struct{}
+	removedstacks   map[int]struct{}
+	clearedstacks   bool
+	done            bool
+	oldValue        func(context.Context) (*Packages, error)
+	predicates      []predicate.Packages
+}
+
+var _ ent.Mutation = (*PackagesMutation)(nil)
+
+// packagesOption allows management of the mutation configuration using functional options.
+type packagesOption func(*PackagesMutation)
+
+// newPackagesMutation creates new mutation for the Packages entity.
+func newPackagesMutation(c config, op Op, opts ...packagesOption) *PackagesMutation {
+	m := &PackagesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePackages,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPackagesID sets the ID field of the mutation.
+func withPackagesID(id int) packagesOption {
+	return func(m *PackagesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Packages
+		)
+		m.oldValue = func(ctx context.Context) (*Packages, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Packages.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPackages sets the old Packages of the mutation.
+func withPackages(node *Packages) packagesOption {
+	return func(m *PackagesMutation) {
+		m.oldValue = func(context.Context) (*Packages, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PackagesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PackagesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PackagesMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PackagesMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Packages.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PackagesMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PackagesMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Packages entity.
+// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackagesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PackagesMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PackagesMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PackagesMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Packages entity.
+// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackagesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PackagesMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *PackagesMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *PackagesMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Packages entity.
+// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackagesMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *PackagesMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *PackagesMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *PackagesMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PackagesMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PackagesMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Packages entity.
+// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackagesMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PackagesMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[packages.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PackagesMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[packages.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PackagesMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, packages.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *PackagesMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PackagesMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Packages entity.
+// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackagesMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PackagesMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLink sets the "link" field.
+func (m *PackagesMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *PackagesMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the Packages entity.
+// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackagesMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ClearLink clears the value of the "link" field.
+func (m *PackagesMutation) ClearLink() {
+	m.link = nil
+	m.clearedFields[packages.FieldLink] = struct{}{}
+}
+
+// LinkCleared returns if the "link" field was cleared in this mutation.
+func (m *PackagesMutation) LinkCleared() bool {
+	_, ok := m.clearedFields[packages.FieldLink]
+	return ok
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *PackagesMutation) ResetLink() {
+	m.link = nil
+	delete(m.clearedFields, packages.FieldLink)
+}
+
+// SetDescription sets the "description" field.
+func (m *PackagesMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PackagesMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Packages entity.
+// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackagesMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *PackagesMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[packages.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PackagesMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[packages.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PackagesMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, packages.FieldDescription)
+}
+
+// AddProjectIDs adds the "projects" edge to the Projects entity by ids.
+func (m *PackagesMutation) AddProjectIDs(ids ...int) {
+	if m.projects == nil {
+		m.projects = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.projects[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProjects clears the "projects" edge to the Projects entity.
+func (m *PackagesMutation) ClearProjects() {
+	m.clearedprojects = true
+}
+
+// ProjectsCleared reports if the "projects" edge to the Projects entity was cleared.
+func (m *PackagesMutation) ProjectsCleared() bool {
+	return m.clearedprojects
+}
+
+// RemoveProjectIDs removes the "projects" edge to the Projects entity by IDs.
+func (m *PackagesMutation) RemoveProjectIDs(ids ...int) {
+	if m.removedprojects == nil {
+		m.removedprojects = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.projects, ids[i])
+		m.removedprojects[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProjects returns the removed IDs of the "projects" edge to the Projects entity.
+func (m *PackagesMutation) RemovedProjectsIDs() (ids []int) {
+	for id := range m.removedprojects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProjectsIDs returns the "projects" edge IDs in the mutation.
+func (m *PackagesMutation) ProjectsIDs() (ids []int) {
+	for id := range m.projects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProjects resets all changes to the "projects" edge.
+func (m *PackagesMutation) ResetProjects() {
+	m.projects = nil
+	m.clearedprojects = false
+	m.removedprojects = nil
+}
+
+// AddStackIDs adds the "stacks" edge to the Stacks entity by ids.
+func (m *PackagesMutation) AddStackIDs(ids ...int) {
+	if m.stacks == nil {
+		m.stacks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.stacks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStacks clears the "stacks" edge to the Stacks entity.
+func (m *PackagesMutation) ClearStacks() {
+	m.clearedstacks = true
+}
+
+// StacksCleared reports if the "stacks" edge to the Stacks entity was cleared.
+func (m *PackagesMutation) StacksCleared() bool {
+	return m.clearedstacks
+}
+
+// RemoveStackIDs removes the "stacks" edge to the Stacks entity by IDs.
+func (m *PackagesMutation) RemoveStackIDs(ids ...int) {
+	if m.removedstacks == nil {
+		m.removedstacks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.stacks, ids[i])
+		m.removedstacks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStacks returns the removed IDs of the "stacks" edge to the Stacks entity.
+func (m *PackagesMutation) RemovedStacksIDs() (ids []int) {
+	for id := range m.removedstacks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StacksIDs returns the "stacks" edge IDs in the mutation.
+func (m *PackagesMutation) StacksIDs() (ids []int) {
+	for id := range m.stacks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStacks resets all changes to the "stacks" edge.
+func (m *PackagesMutation) ResetStacks() {
+	m.stacks = nil
+	m.clearedstacks = false
+	m.removedstacks = nil
+}
+
+// Where appends a list predicates to the PackagesMutation builder.
+func (m *PackagesMutation) Where(ps ...predicate.Packages) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PackagesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PackagesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Packages, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PackagesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PackagesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Packages).
+func (m *PackagesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PackagesMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, packages.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, packages.FieldUpdatedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, packages.FieldVersion)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, packages.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, packages.FieldName)
+	}
+	if m.link != nil {
+		fields = append(fields, packages.FieldLink)
+	}
+	if m.description != nil {
+		fields = append(fields, packages.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PackagesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case packages.FieldCreatedAt:
+		return m.CreatedAt()
+	case packages.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case packages.FieldVersion:
+		return m.Version()
+	case packages.FieldDeletedAt:
+		return m.DeletedAt()
+	case packages.FieldName:
+		return m.Name()
+	case packages.FieldLink:
+		return m.Link()
+	case packages.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PackagesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case packages.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case packages.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case packages.FieldVersion:
+		return m.OldVersion(ctx)
+	case packages.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case packages.FieldName:
+		return m.OldName(ctx)
+	case packages.FieldLink:
+		return m.OldLink(ctx)
+	case packages.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown Packages field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackagesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case packages.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case packages.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case packages.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case packages.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case packages.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case packages.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case packages.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Packages field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PackagesMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, packages.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PackagesMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case packages.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PackagesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case packages.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Packages numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PackagesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(packages.FieldDeletedAt) {
+		fields = append(fields, packages.FieldDeletedAt)
+	}
+	if m.FieldCleared(packages.FieldLink) {
+		fields = append(fields, packages.FieldLink)
+	}
+	if m.FieldCleared(packages.FieldDescription) {
+		fields = append(fields, packages.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PackagesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PackagesMutation) ClearField(name string) error {
+	switch name {
+	case packages.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case packages.FieldLink:
+		m.ClearLink()
+		return nil
+	case packages.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Packages nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PackagesMutation) ResetField(name string) error {
+	switch name {
+	case packages.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case packages.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case packages.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case packages.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case packages.FieldName:
+		m.ResetName()
+		return nil
+	case packages.FieldLink:
+		m.ResetLink()
+		return nil
+	case packages.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Packages field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PackagesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.projects != nil {
+		edges = append(edges, packages.EdgeProjects)
+	}
+	if m.stacks != nil {
+		edges = append(edges, packages.EdgeStacks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PackagesMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case packages.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.projects))
+		for id := range m.projects {
+			ids = append(ids, id)
+		}
+		return ids
+	case packages.EdgeStacks:
+		ids := make([]ent.Value, 0, len(m.stacks))
+		for id := range m.stacks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PackagesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedprojects != nil {
+		edges = append(edges, packages.EdgeProjects)
+	}
+	if m.removedstacks != nil {
+		edges = append(edges, packages.EdgeStacks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PackagesMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case packages.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.removedprojects))
+		for id := range m.removedprojects {
+			ids = append(ids, id)
+		}
+		return ids
+	case packages.EdgeStacks:
+		ids := make([]ent.Value, 0, len(m.removedstacks))
+		for id := range m.removedstacks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PackagesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprojects {
+		edges = append(edges, packages.EdgeProjects)
+	}
+	if m.clearedstacks {
+		edges = append(edges, packages.EdgeStacks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PackagesMutation) EdgeCleared(name string) bool {
+	switch name {
+	case packages.EdgeProjects:
+		return m.clearedprojects
+	case packages.EdgeStacks:
+		return m.clearedstacks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PackagesMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Packages unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PackagesMutation) ResetEdge(name string) error {
+	switch name {
+	case packages.EdgeProjects:
+		m.ResetProjects()
+		return nil
+	case packages.EdgeStacks:
+		m.ResetStacks()
+		return nil
+	}
+	return fmt.Errorf("unknown Packages edge %s", name)
+}
+
+// ProjectRevisionsMutation represents an operation that mutates the ProjectRevisions nodes in the graph.
+type ProjectRevisionsMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	revision          *int
+	addrevision       *int
+	name              *string
+	imageUrl          *string
+	link              *string
+	description       *string
+	stacks            *[]string
+	appendstacks      []string
+	client_id         *int
+	addclient_id      *int
+	package_ids       *[]int
+	appendpackage_ids []int
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	project           *int
+	clearedproject    bool
+	done              bool
+	oldValue          func(context.Context) (*ProjectRevisions, error)
+	predicates        []predicate.ProjectRevisions
+}
+
+var _ ent.Mutation = (*ProjectRevisionsMutation)(nil)
+
+// projectrevisionsOption allows management of the mutation configuration using functional options.
+type projectrevisionsOption func(*ProjectRevisionsMutation)
+
+// newProjectRevisionsMutation creates new mutation for the ProjectRevisions entity.
+func newProjectRevisionsMutation(c config, op Op, opts ...projectrevisionsOption) *ProjectRevisionsMutation {
+	m := &ProjectRevisionsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProjectRevisions,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectRevisionsID sets the ID field of the mutation.
+func withProjectRevisionsID(id int) projectrevisionsOption {
+	return func(m *ProjectRevisionsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProjectRevisions
+		)
+		m.oldValue = func(ctx context.Context) (*ProjectRevisions, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProjectRevisions.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProjectRevisions sets the old ProjectRevisions of the mutation.
+func withProjectRevisions(node *ProjectRevisions) projectrevisionsOption {
+	return func(m *ProjectRevisionsMutation) {
+		m.oldValue = func(context.Context) (*ProjectRevisions, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectRevisionsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectRevisionsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectRevisionsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectRevisionsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProjectRevisions.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *ProjectRevisionsMutation) SetProjectID(i int) {
+	m.project = &i
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *ProjectRevisionsMutation) ProjectID() (r int, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldProjectID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *ProjectRevisionsMutation) ResetProjectID() {
+	m.project = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *ProjectRevisionsMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *ProjectRevisionsMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *ProjectRevisionsMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *ProjectRevisionsMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *ProjectRevisionsMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetName sets the "name" field.
+func (m *ProjectRevisionsMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectRevisionsMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProjectRevisionsMutation) ResetName() {
+	m.name = nil
+}
+
+// SetImageUrl sets the "imageUrl" field.
+func (m *ProjectRevisionsMutation) SetImageUrl(s string) {
+	m.imageUrl = &s
+}
+
+// ImageUrl returns the value of the "imageUrl" field in the mutation.
+func (m *ProjectRevisionsMutation) ImageUrl() (r string, exists bool) {
+	v := m.imageUrl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageUrl returns the old "imageUrl" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldImageUrl(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageUrl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageUrl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageUrl: %w", err)
+	}
+	return oldValue.ImageUrl, nil
+}
+
+// ClearImageUrl clears the value of the "imageUrl" field.
+func (m *ProjectRevisionsMutation) ClearImageUrl() {
+	m.imageUrl = nil
+	m.clearedFields[projectrevisions.FieldImageUrl] = struct{}{}
+}
+
+// ImageUrlCleared returns if the "imageUrl" field was cleared in this mutation.
+func (m *ProjectRevisionsMutation) ImageUrlCleared() bool {
+	_, ok := m.clearedFields[projectrevisions.FieldImageUrl]
+	return ok
+}
+
+// ResetImageUrl resets all changes to the "imageUrl" field.
+func (m *ProjectRevisionsMutation) ResetImageUrl() {
+	m.imageUrl = nil
+	delete(m.clearedFields, projectrevisions.FieldImageUrl)
+}
+
+// SetLink sets the "link" field.
+func (m *ProjectRevisionsMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *ProjectRevisionsMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ClearLink clears the value of the "link" field.
+func (m *ProjectRevisionsMutation) ClearLink() {
+	m.link = nil
+	m.clearedFields[projectrevisions.FieldLink] = struct{}{}
+}
+
+// LinkCleared returns if the "link" field was cleared in this mutation.
+func (m *ProjectRevisionsMutation) LinkCleared() bool {
+	_, ok := m.clearedFields[projectrevisions.FieldLink]
+	return ok
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *ProjectRevisionsMutation) ResetLink() {
+	m.link = nil
+	delete(m.clearedFields, projectrevisions.FieldLink)
+}
+
+// SetDescription sets the "description" field.
+func (m *ProjectRevisionsMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProjectRevisionsMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProjectRevisionsMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[projectrevisions.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProjectRevisionsMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[projectrevisions.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProjectRevisionsMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, projectrevisions.FieldDescription)
+}
+
+// SetStacks sets the "stacks" field.
+func (m *ProjectRevisionsMutation) SetStacks(s []string) {
+	m.stacks = &s
+	m.appendstacks = nil
+}
+
+// Stacks returns the value of the "stacks" field in the mutation.
+func (m *ProjectRevisionsMutation) Stacks() (r []string, exists bool) {
+	v := m.stacks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStacks returns the old "stacks" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldStacks(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStacks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStacks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStacks: %w", err)
+	}
+	return oldValue.Stacks, nil
+}
+
+// AppendStacks adds s to the "stacks" field.
+func (m *ProjectRevisionsMutation) AppendStacks(s []string) {
+	m.appendstacks = append(m.appendstacks, s...)
+}
+
+// AppendedStacks returns the list of values that were appended to the "stacks" field in this mutation.
+func (m *ProjectRevisionsMutation) AppendedStacks() ([]string, bool) {
+	if len(m.appendstacks) == 0 {
+		return nil, false
+	}
+	return m.appendstacks, true
+}
+
+// ClearStacks clears the value of the "stacks" field.
+func (m *ProjectRevisionsMutation) ClearStacks() {
+	m.stacks = nil
+	m.appendstacks = nil
+	m.clearedFields[projectrevisions.FieldStacks] = struct{}{}
+}
+
+// StacksCleared returns if the "stacks" field was cleared in this mutation.
+func (m *ProjectRevisionsMutation) StacksCleared() bool {
+	_, ok := m.clearedFields[projectrevisions.FieldStacks]
+	return ok
+}
+
+// ResetStacks resets all changes to the "stacks" field.
+func (m *ProjectRevisionsMutation) ResetStacks() {
+	m.stacks = nil
+	m.appendstacks = nil
+	delete(m.clearedFields, projectrevisions.FieldStacks)
+}
+
+// SetClientID sets the "client_id" field.
+func (m *ProjectRevisionsMutation) SetClientID(i int) {
+	m.client_id = &i
+	m.addclient_id = nil
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *ProjectRevisionsMutation) ClientID() (r int, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldClientID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// AddClientID adds i to the "client_id" field.
+func (m *ProjectRevisionsMutation) AddClientID(i int) {
+	if m.addclient_id != nil {
+		*m.addclient_id += i
+	} else {
+		m.addclient_id = &i
+	}
+}
+
+// AddedClientID returns the value that was added to the "client_id" field in this mutation.
+func (m *ProjectRevisionsMutation) AddedClientID() (r int, exists bool) {
+	v := m.addclient_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (m *ProjectRevisionsMutation) ClearClientID() {
+	m.client_id = nil
+	m.addclient_id = nil
+	m.clearedFields[projectrevisions.FieldClientID] = struct{}{}
+}
+
+// ClientIDCleared returns if the "client_id" field was cleared in this mutation.
+func (m *ProjectRevisionsMutation) ClientIDCleared() bool {
+	_, ok := m.clearedFields[projectrevisions.FieldClientID]
+	return ok
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *ProjectRevisionsMutation) ResetClientID() {
+	m.client_id = nil
+	m.addclient_id = nil
+	delete(m.clearedFields, projectrevisions.FieldClientID)
+}
+
+// SetPackageIds sets the "package_ids" field.
+func (m *ProjectRevisionsMutation) SetPackageIds(i []int) {
+	m.package_ids = &i
+	m.appendpackage_ids = nil
+}
+
+// PackageIds returns the value of the "package_ids" field in the mutation.
+func (m *ProjectRevisionsMutation) PackageIds() (r []int, exists bool) {
+	v := m.package_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageIds returns the old "package_ids" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldPackageIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageIds: %w", err)
+	}
+	return oldValue.PackageIds, nil
+}
+
+// AppendPackageIds adds i to the "package_ids" field.
+func (m *ProjectRevisionsMutation) AppendPackageIds(i []int) {
+	m.appendpackage_ids = append(m.appendpackage_ids, i...)
+}
+
+// AppendedPackageIds returns the list of values that were appended to the "package_ids" field in this mutation.
+func (m *ProjectRevisionsMutation) AppendedPackageIds() ([]int, bool) {
+	if len(m.appendpackage_ids) == 0 {
+		return nil, false
+	}
+	return m.appendpackage_ids, true
+}
+
+// ClearPackageIds clears the value of the "package_ids" field.
+func (m *ProjectRevisionsMutation) ClearPackageIds() {
+	m.package_ids = nil
+	m.appendpackage_ids = nil
+	m.clearedFields[projectrevisions.FieldPackageIds] = struct{}{}
+}
+
+// PackageIdsCleared returns if the "package_ids" field was cleared in this mutation.
+func (m *ProjectRevisionsMutation) PackageIdsCleared() bool {
+	_, ok := m.clearedFields[projectrevisions.FieldPackageIds]
+	return ok
+}
+
+// ResetPackageIds resets all changes to the "package_ids" field.
+func (m *ProjectRevisionsMutation) ResetPackageIds() {
+	m.package_ids = nil
+	m.appendpackage_ids = nil
+	delete(m.clearedFields, projectrevisions.FieldPackageIds)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectRevisionsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectRevisionsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProjectRevisions entity.
+// If the ProjectRevisions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectRevisionsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectRevisionsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearProject clears the "project" edge to the Projects entity.
+func (m *ProjectRevisionsMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[projectrevisions.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the Projects entity was cleared.
+func (m *ProjectRevisionsMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *ProjectRevisionsMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *ProjectRevisionsMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// Where appends a list predicates to the ProjectRevisionsMutation builder.
+func (m *ProjectRevisionsMutation) Where(ps ...predicate.ProjectRevisions) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectRevisionsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectRevisionsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProjectRevisions, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectRevisionsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectRevisionsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProjectRevisions).
+func (m *ProjectRevisionsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectRevisionsMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.project != nil {
+		fields = append(fields, projectrevisions.FieldProjectID)
+	}
+	if m.revision != nil {
+		fields = append(fields, projectrevisions.FieldRevision)
+	}
+	if m.name != nil {
+		fields = append(fields, projectrevisions.FieldName)
+	}
+	if m.imageUrl != nil {
+		fields = append(fields, projectrevisions.FieldImageUrl)
+	}
+	if m.link != nil {
+		fields = append(fields, projectrevisions.FieldLink)
+	}
+	if m.description != nil {
+		fields = append(fields, projectrevisions.FieldDescription)
+	}
+	if m.stacks != nil {
+		fields = append(fields, projectrevisions.FieldStacks)
+	}
+	if m.client_id != nil {
+		fields = append(fields, projectrevisions.FieldClientID)
+	}
+	if m.package_ids != nil {
+		fields = append(fields, projectrevisions.FieldPackageIds)
+	}
+	if m.created_at != nil {
+		fields = append(fields, projectrevisions.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectRevisionsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case projectrevisions.FieldProjectID:
+		return m.ProjectID()
+	case projectrevisions.FieldRevision:
+		return m.Revision()
+	case projectrevisions.FieldName:
+		return m.Name()
+	case projectrevisions.FieldImageUrl:
+		return m.ImageUrl()
+	case projectrevisions.FieldLink:
+		return m.Link()
+	case projectrevisions.FieldDescription:
+		return m.Description()
+	case projectrevisions.FieldStacks:
+		return m.Stacks()
+	case projectrevisions.FieldClientID:
+		return m.ClientID()
+	case projectrevisions.FieldPackageIds:
+		return m.PackageIds()
+	case projectrevisions.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectRevisionsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case projectrevisions.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case projectrevisions.FieldRevision:
+		return m.OldRevision(ctx)
+	case projectrevisions.FieldName:
+		return m.OldName(ctx)
+	case projectrevisions.FieldImageUrl:
+		return m.OldImageUrl(ctx)
+	case projectrevisions.FieldLink:
+		return m.OldLink(ctx)
+	case projectrevisions.FieldDescription:
+		return m.OldDescription(ctx)
+	case projectrevisions.FieldStacks:
+		return m.OldStacks(ctx)
+	case projectrevisions.FieldClientID:
+		return m.OldClientID(ctx)
+	case projectrevisions.FieldPackageIds:
+		return m.OldPackageIds(ctx)
+	case projectrevisions.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProjectRevisions field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectRevisionsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case projectrevisions.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case projectrevisions.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case projectrevisions.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case projectrevisions.FieldImageUrl:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageUrl(v)
+		return nil
+	case projectrevisions.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case projectrevisions.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case projectrevisions.FieldStacks:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStacks(v)
+		return nil
+	case projectrevisions.FieldClientID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case projectrevisions.FieldPackageIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageIds(v)
+		return nil
+	case projectrevisions.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectRevisions field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectRevisionsMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, projectrevisions.FieldRevision)
+	}
+	if m.addclient_id != nil {
+		fields = append(fields, projectrevisions.FieldClientID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectRevisionsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case projectrevisions.FieldRevision:
+		return m.AddedRevision()
+	case projectrevisions.FieldClientID:
+		return m.AddedClientID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectRevisionsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case projectrevisions.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	case projectrevisions.FieldClientID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClientID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectRevisions numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectRevisionsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(projectrevisions.FieldImageUrl) {
+		fields = append(fields, projectrevisions.FieldImageUrl)
+	}
+	if m.FieldCleared(projectrevisions.FieldLink) {
+		fields = append(fields, projectrevisions.FieldLink)
+	}
+	if m.FieldCleared(projectrevisions.FieldDescription) {
+		fields = append(fields, projectrevisions.FieldDescription)
+	}
+	if m.FieldCleared(projectrevisions.FieldStacks) {
+		fields = append(fields, projectrevisions.FieldStacks)
+	}
+	if m.FieldCleared(projectrevisions.FieldClientID) {
+		fields = append(fields, projectrevisions.FieldClientID)
+	}
+	if m.FieldCleared(projectrevisions.FieldPackageIds) {
+		fields = append(fields, projectrevisions.FieldPackageIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectRevisionsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectRevisionsMutation) ClearField(name string) error {
+	switch name {
+	case projectrevisions.FieldImageUrl:
+		m.ClearImageUrl()
+		return nil
+	case projectrevisions.FieldLink:
+		m.ClearLink()
+		return nil
+	case projectrevisions.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case projectrevisions.FieldStacks:
+		m.ClearStacks()
+		return nil
+	case projectrevisions.FieldClientID:
+		m.ClearClientID()
+		return nil
+	case projectrevisions.FieldPackageIds:
+		m.ClearPackageIds()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectRevisions nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectRevisionsMutation) ResetField(name string) error {
+	switch name {
+	case projectrevisions.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case projectrevisions.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case projectrevisions.FieldName:
+		m.ResetName()
+		return nil
+	case projectrevisions.FieldImageUrl:
+		m.ResetImageUrl()
+		return nil
+	case projectrevisions.FieldLink:
+		m.ResetLink()
+		return nil
+	case projectrevisions.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case projectrevisions.FieldStacks:
+		m.ResetStacks()
+		return nil
+	case projectrevisions.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case projectrevisions.FieldPackageIds:
+		m.ResetPackageIds()
+		return nil
+	case projectrevisions.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectRevisions field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectRevisionsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.project != nil {
+		edges = append(edges, projectrevisions.EdgeProject)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectRevisionsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case projectrevisions.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectRevisionsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectRevisionsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectRevisionsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproject {
+		edges = append(edges, projectrevisions.EdgeProject)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectRevisionsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case projectrevisions.EdgeProject:
+		return m.clearedproject
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectRevisionsMutation) ClearEdge(name string) error {
+	switch name {
+	case projectrevisions.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectRevisions unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectRevisionsMutation) ResetEdge(name string) error {
+	switch name {
+	case projectrevisions.EdgeProject:
+		m.ResetProject()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectRevisions edge %s", name)
+}
+
+// ProjectsMutation represents an operation that mutates the Projects nodes in the graph.
+type ProjectsMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	created_at       *time.Time
+	updated_at       *time.Time
+	version          *int
+	addversion       *int
+	deleted_at       *time.Time
+	name             *string
+	imageUrl         *string
+	link             *string
+	description      *string
+	clearedFields    map[string]struct{}
+	client           *int
+	clearedclient    bool
+	packages         map[int]struct{}
+	removedpackages  map[int]struct{}
+	clearedpackages  bool
+	stacks           map[int]struct{}
+	removedstacks    map[int]struct{}
+	clearedstacks    bool
+	revisions        map[int]struct{}
+	removedrevisions map[int]struct{}
+	clearedrevisions bool
+	done             bool
+	oldValue         func(context.Context) (*Projects, error)
+	predicates       []predicate.Projects
+}
+
+var _ ent.Mutation = (*ProjectsMutation)(nil)
+
+// projectsOption allows management of the mutation configuration using functional options.
+type projectsOption func(*ProjectsMutation)
+
+// newProjectsMutation creates new mutation for the Projects entity.
+func newProjectsMutation(c config, op Op, opts ...projectsOption) *ProjectsMutation {
+	m := &ProjectsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProjects,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectsID sets the ID field of the mutation.
+func withProjectsID(id int) projectsOption {
+	return func(m *ProjectsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Projects
+		)
+		m.oldValue = func(ctx context.Context) (*Projects, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Projects.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProjects sets the old Projects of the mutation.
+func withProjects(node *Projects) projectsOption {
+	return func(m *ProjectsMutation) {
+		m.oldValue = func(context.Context) (*Projects, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Projects.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Projects entity.
+// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProjectsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProjectsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Projects entity.
+// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProjectsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *ProjectsMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ProjectsMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Projects entity.
+// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectsMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *ProjectsMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ProjectsMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ProjectsMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ProjectsMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ProjectsMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Projects entity.
+// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectsMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ProjectsMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[projects.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ProjectsMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[projects.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ProjectsMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, projects.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *ProjectsMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectsMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Projects entity.
+// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectsMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProjectsMutation) ResetName() {
+	m.name = nil
+}
+
+// SetImageUrl sets the "imageUrl" field.
+func (m *ProjectsMutation) SetImageUrl(s string) {
+	m.imageUrl = &s
+}
+
+// ImageUrl returns the value of the "imageUrl" field in the mutation.
+func (m *ProjectsMutation) ImageUrl() (r string, exists bool) {
+	v := m.imageUrl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageUrl returns the old "imageUrl" field's value of the Projects entity.
+// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectsMutation) OldImageUrl(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageUrl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageUrl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageUrl: %w", err)
+	}
+	return oldValue.ImageUrl, nil
+}
+
+// ClearImageUrl clears the value of the "imageUrl" field.
+func (m *ProjectsMutation) ClearImageUrl() {
+	m.imageUrl = nil
+	m.clearedFields[projects.FieldImageUrl] = struct{}{}
+}
+
+// ImageUrlCleared returns if the "imageUrl" field was cleared in this mutation.
+func (m *ProjectsMutation) ImageUrlCleared() bool {
+	_, ok := m.clearedFields[projects.FieldImageUrl]
+	return ok
+}
+
+// ResetImageUrl resets all changes to the "imageUrl" field.
+func (m *ProjectsMutation) ResetImageUrl() {
+	m.imageUrl = nil
+	delete(m.clearedFields, projects.FieldImageUrl)
+}
+
+// SetLink sets the "link" field.
+func (m *ProjectsMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *ProjectsMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the Projects entity.
+// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectsMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ClearLink clears the value of the "link" field.
+func (m *ProjectsMutation) ClearLink() {
+	m.link = nil
+	m.clearedFields[projects.FieldLink] = struct{}{}
+}
+
+// LinkCleared returns if the "link" field was cleared in this mutation.
+func (m *ProjectsMutation) LinkCleared() bool {
+	_, ok := m.clearedFields[projects.FieldLink]
+	return ok
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *ProjectsMutation) ResetLink() {
+	m.link = nil
+	delete(m.clearedFields, projects.FieldLink)
+}
+
+// SetDescription sets the "description" field.
+func (m *ProjectsMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProjectsMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Projects entity.
+// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectsMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProjectsMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[projects.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProjectsMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[projects.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProjectsMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, projects.FieldDescription)
+}
+
+// SetClientID sets the "client" edge to the Clients entity by id.
+func (m *ProjectsMutation) SetClientID(id int) {
+	m.client = &id
+}
+
+// ClearClient clears the "client" edge to the Clients entity.
+func (m *ProjectsMutation) ClearClient() {
+	m.clearedclient = true
+}
+
+// ClientCleared reports if the "client" edge to the Clients entity was cleared.
+func (m *ProjectsMutation) ClientCleared() bool {
+	return m.clearedclient
+}
+
+// ClientID returns the "client" edge ID in the mutation.
+func (m *ProjectsMutation) ClientID() (id int, exists bool) {
+	if m.client != nil {
+		return *m.client, true
+	}
+	return
+}
+
+// ClientIDs returns the "client" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ClientID instead. It exists only for internal usage by the builders.
+func (m *ProjectsMutation) ClientIDs() (ids []int) {
+	if id := m.client; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetClient resets all changes to the "client" edge.
+func (m *ProjectsMutation) ResetClient() {
+	m.client = nil
+	m.clearedclient = false
+}
+
+// AddPackageIDs adds the "packages" edge to the Packages entity by ids.
+func (m *ProjectsMutation) AddPackageIDs(ids ...int) {
+	if m.packages == nil {
+		m.packages = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.packages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPackages clears the "packages" edge to the Packages entity.
+func (m *ProjectsMutation) ClearPackages() {
+	m.clearedpackages = true
+}
+
+// PackagesCleared reports if the "packages" edge to the Packages entity was cleared.
+func (m *ProjectsMutation) PackagesCleared() bool {
+	return m.clearedpackages
+}
+
+// RemovePackageIDs removes the "packages" edge to the Packages entity by IDs.
+func (m *ProjectsMutation) RemovePackageIDs(ids ...int) {
+	if m.removedpackages == nil {
+		m.removedpackages = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.packages, ids[i])
+		m.removedpackages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPackages returns the removed IDs of the "packages" edge to the Packages entity.
+func (m *ProjectsMutation) RemovedPackagesIDs() (ids []int) {
+	for id := range m.removedpackages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PackagesIDs returns the "packages" edge IDs in the mutation.
+func (m *ProjectsMutation) PackagesIDs() (ids []int) {
+	for id := range m.packages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPackages resets all changes to the "packages" edge.
+func (m *ProjectsMutation) ResetPackages() {
+	m.packages = nil
+	m.clearedpackages = false
+	m.removedpackages = nil
+}
+
+// AddStackIDs adds the "stacks" edge to the Stacks entity by ids.
+func (m *ProjectsMutation) AddStackIDs(ids ...int) {
+	if m.stacks == nil {
+		m.stacks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.stacks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStacks clears the "stacks" edge to the Stacks entity.
+func (m *ProjectsMutation) ClearStacks() {
+	m.clearedstacks = true
+}
+
+// StacksCleared reports if the "stacks" edge to the Stacks entity was cleared.
+func (m *ProjectsMutation) StacksCleared() bool {
+	return m.clearedstacks
+}
+
+// RemoveStackIDs removes the "stacks" edge to the Stacks entity by IDs.
+func (m *ProjectsMutation) RemoveStackIDs(ids ...int) {
+	if m.removedstacks == nil {
+		m.removedstacks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.stacks, ids[i])
+		m.removedstacks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStacks returns the removed IDs of the "stacks" edge to the Stacks entity.
+func (m *ProjectsMutation) RemovedStacksIDs() (ids []int) {
+	for id := range m.removedstacks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StacksIDs returns the "stacks" edge IDs in the mutation.
+func (m *ProjectsMutation) StacksIDs() (ids []int) {
+	for id := range m.stacks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStacks resets all changes to the "stacks" edge.
+func (m *ProjectsMutation) ResetStacks() {
+	m.stacks = nil
+	m.clearedstacks = false
+	m.removedstacks = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ProjectRevisions entity by ids.
+func (m *ProjectsMutation) AddRevisionIDs(ids ...int) {
+	if m.revisions == nil {
+		m.revisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the ProjectRevisions entity.
+func (m *ProjectsMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the ProjectRevisions entity was cleared.
+func (m *ProjectsMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the ProjectRevisions entity by IDs.
+func (m *ProjectsMutation) RemoveRevisionIDs(ids ...int) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the ProjectRevisions entity.
+func (m *ProjectsMutation) RemovedRevisionsIDs() (ids []int) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *ProjectsMutation) RevisionsIDs() (ids []int) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *ProjectsMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// Where appends a list predicates to the ProjectsMutation builder.
+func (m *ProjectsMutation) Where(ps ...predicate.Projects) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Projects, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Projects).
+func (m *ProjectsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectsMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, projects.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, projects.FieldUpdatedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, projects.FieldVersion)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, projects.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, projects.FieldName)
+	}
+	if m.imageUrl != nil {
+		fields = append(fields, projects.FieldImageUrl)
+	}
+	if m.link != nil {
+		fields = append(fields, projects.FieldLink)
+	}
+	if m.description != nil {
+		fields = append(fields, projects.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case projects.FieldCreatedAt:
+		return m.CreatedAt()
+	case projects.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case projects.FieldVersion:
+		return m.Version()
+	case projects.FieldDeletedAt:
+		return m.DeletedAt()
+	case projects.FieldName:
+		return m.Name()
+	case projects.FieldImageUrl:
+		return m.ImageUrl()
+	case projects.FieldLink:
+		return m.Link()
+	case projects.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case projects.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case projects.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case projects.FieldVersion:
+		return m.OldVersion(ctx)
+	case projects.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case projects.FieldName:
+		return m.OldName(ctx)
+	case projects.FieldImageUrl:
+		return m.OldImageUrl(ctx)
+	case projects.FieldLink:
+		return m.OldLink(ctx)
+	case projects.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown Projects field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case projects.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case projects.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case projects.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case projects.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case projects.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case projects.FieldImageUrl:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageUrl(v)
+		return nil
+	case projects.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case projects.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Projects field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectsMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, projects.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case projects.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case projects.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Projects numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(projects.FieldDeletedAt) {
+		fields = append(fields, projects.FieldDeletedAt)
+	}
+	if m.FieldCleared(projects.FieldImageUrl) {
+		fields = append(fields, projects.FieldImageUrl)
+	}
+	if m.FieldCleared(projects.FieldLink) {
+		fields = append(fields, projects.FieldLink)
+	}
+	if m.FieldCleared(projects.FieldDescription) {
+		fields = append(fields, projects.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectsMutation) ClearField(name string) error {
+	switch name {
+	case projects.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case projects.FieldImageUrl:
+		m.ClearImageUrl()
+		return nil
+	case projects.FieldLink:
+		m.ClearLink()
+		return nil
+	case projects.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Projects nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectsMutation) ResetField(name string) error {
+	switch name {
+	case projects.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case projects.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case projects.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case projects.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case projects.FieldName:
+		m.ResetName()
+		return nil
+	case projects.FieldImageUrl:
+		m.ResetImageUrl()
+		return nil
+	case projects.FieldLink:
+		m.ResetLink()
+		return nil
+	case projects.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Projects field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.client != nil {
+		edges = append(edges, projects.EdgeClient)
+	}
+	if m.packages != nil {
+		edges = append(edges, projects.EdgePackages)
+	}
+	if m.stacks != nil {
+		edges = append(edges, projects.EdgeStacks)
+	}
+	if m.revisions != nil {
+		edges = append(edges, projects.EdgeRevisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case projects.EdgeClient:
+		if id := m.client; id != nil {
+			return []ent.Value{*id}
+		}
+	case projects.EdgePackages:
+		ids := make([]ent.Value, 0, len(m.packages))
+		for id := range m.packages {
+			ids = append(ids, id)
+		}
+		return ids
+	case projects.EdgeStacks:
+		ids := make([]ent.Value, 0, len(m.stacks))
+		for id := range m.stacks {
+			ids = append(ids, id)
+		}
+		return ids
+	case projects.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedpackages != nil {
+		edges = append(edges, projects.EdgePackages)
+	}
+	if m.removedstacks != nil {
+		edges = append(edges, projects.EdgeStacks)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, projects.EdgeRevisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectsMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case projects.EdgePackages:
+		ids := make([]ent.Value, 0, len(m.removedpackages))
+		for id := range m.removedpackages {
+			ids = append(ids, id)
+		}
+		return ids
+	case projects.EdgeStacks:
+		ids := make([]ent.Value, 0, len(m.removedstacks))
+		for id := range m.removedstacks {
+			ids = append(ids, id)
+		}
+		return ids
+	case projects.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedclient {
+		edges = append(edges, projects.EdgeClient)
+	}
+	if m.clearedpackages {
+		edges = append(edges, projects.EdgePackages)
+	}
+	if m.clearedstacks {
+		edges = append(edges, projects.EdgeStacks)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, projects.EdgeRevisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case projects.EdgeClient:
+		return m.clearedclient
+	case projects.EdgePackages:
+		return m.clearedpackages
+	case projects.EdgeStacks:
+		return m.clearedstacks
+	case projects.EdgeRevisions:
+		return m.clearedrevisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectsMutation) ClearEdge(name string) error {
+	switch name {
+	case projects.EdgeClient:
+		m.ClearClient()
+		return nil
+	}
+	return fmt.Errorf("unknown Projects unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectsMutation) ResetEdge(name string) error {
+	switch name {
+	case projects.EdgeClient:
+		m.ResetClient()
+		return nil
+	case projects.EdgePackages:
+		m.ResetPackages()
+		return nil
+	case projects.EdgeStacks:
+		m.ResetStacks()
+		return nil
+	case projects.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	}
+	return fmt.Errorf("unknown Projects edge %s", name)
+}
+
+// StacksMutation represents an operation that mutates the Stacks nodes in the graph.
+type StacksMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	name            *string
+	slug            *string
+	category        *string
+	iconUrl         *string
+	clearedFields   map[string]struct{}
+	projects        map[int]struct{}
+	removedprojects map[int]struct{}
+	clearedprojects bool
+	packages        map[int]struct{}
+	removedpackages map[int]struct{}
+	clearedpackages bool
+	done            bool
+	oldValue        func(context.Context) (*Stacks, error)
+	predicates      []predicate.Stacks
+}
+
+var _ ent.Mutation = (*StacksMutation)(nil)
+
+// stacksOption allows management of the mutation configuration using functional options.
+type stacksOption func(*StacksMutation)
+
+// newStacksMutation creates new mutation for the Stacks entity.
+func newStacksMutation(c config, op Op, opts ...stacksOption) *StacksMutation {
+	m := &StacksMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStacks,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStacksID sets the ID field of the mutation.
+func withStacksID(id int) stacksOption {
+	return func(m *StacksMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Stacks
+		)
+		m.oldValue = func(ctx context.Context) (*Stacks, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Stacks.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStacks sets the old Stacks of the mutation.
+func withStacks(node *Stacks) stacksOption {
+	return func(m *StacksMutation) {
+		m.oldValue = func(context.Context) (*Stacks, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StacksMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StacksMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StacksMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StacksMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Stacks.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StacksMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StacksMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Stacks entity.
+// If the Stacks object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StacksMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StacksMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StacksMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StacksMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Stacks entity.
+// If the Stacks object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StacksMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StacksMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *StacksMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *StacksMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Stacks entity.
+// If the Stacks object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StacksMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *StacksMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *StacksMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *StacksMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Stacks entity.
+// If the Stacks object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StacksMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *StacksMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *StacksMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *StacksMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Stacks entity.
+// If the Stacks object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StacksMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ClearCategory clears the value of the "category" field.
+func (m *StacksMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[stacks.FieldCategory] = struct{}{}
+}
+
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *StacksMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[stacks.FieldCategory]
+	return ok
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *StacksMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, stacks.FieldCategory)
+}
+
+// SetIconUrl sets the "iconUrl" field.
+func (m *StacksMutation) SetIconUrl(s string) {
+	m.iconUrl = &s
+}
+
+// IconUrl returns the value of the "iconUrl" field in the mutation.
+func (m *StacksMutation) IconUrl() (r string, exists bool) {
+	v := m.iconUrl
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Packages entity.
-// If the Packages object wasn't provided to the builder, the object is fetched from the database.
+// OldIconUrl returns the old "iconUrl" field's value of the Stacks entity.
+// If the Stacks object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PackagesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *StacksMutation) OldIconUrl(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIconUrl is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIconUrl requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIconUrl: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.IconUrl, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PackagesMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearIconUrl clears the value of the "iconUrl" field.
+func (m *StacksMutation) ClearIconUrl() {
+	m.iconUrl = nil
+	m.clearedFields[stacks.FieldIconUrl] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PackagesMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// IconUrlCleared returns if the "iconUrl" field was cleared in this mutation.
+func (m *StacksMutation) IconUrlCleared() bool {
+	_, ok := m.clearedFields[stacks.FieldIconUrl]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PackagesMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ResetIconUrl resets all changes to the "iconUrl" field.
+func (m *StacksMutation) ResetIconUrl() {
+	m.iconUrl = nil
+	delete(m.clearedFields, stacks.FieldIconUrl)
+}
+
+// AddProjectIDs adds the "projects" edge to the Projects entity by ids.
+func (m *StacksMutation) AddProjectIDs(ids ...int) {
+	if m.projects == nil {
+		m.projects = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.projects[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Packages entity.
-// If the Packages object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PackagesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// ClearProjects clears the "projects" edge to the Projects entity.
+func (m *StacksMutation) ClearProjects() {
+	m.clearedprojects = true
+}
+
+// ProjectsCleared reports if the "projects" edge to the Projects entity was cleared.
+func (m *StacksMutation) ProjectsCleared() bool {
+	return m.clearedprojects
+}
+
+// RemoveProjectIDs removes the "projects" edge to the Projects entity by IDs.
+func (m *StacksMutation) RemoveProjectIDs(ids ...int) {
+	if m.removedprojects == nil {
+		m.removedprojects = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.projects, ids[i])
+		m.removedprojects[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+}
+
+// RemovedProjects returns the removed IDs of the "projects" edge to the Projects entity.
+func (m *StacksMutation) RemovedProjectsIDs() (ids []int) {
+	for id := range m.removedprojects {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PackagesMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ProjectsIDs returns the "projects" edge IDs in the mutation.
+func (m *StacksMutation) ProjectsIDs() (ids []int) {
+	for id := range m.projects {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the PackagesMutation builder.
-func (m *PackagesMutation) Where(ps ...predicate.Packages) {
+// ResetProjects resets all changes to the "projects" edge.
+func (m *StacksMutation) ResetProjects() {
+	m.projects = nil
+	m.clearedprojects = false
+	m.removedprojects = nil
+}
+
+// AddPackageIDs adds the "packages" edge to the Packages entity by ids.
+func (m *StacksMutation) AddPackageIDs(ids ...int) {
+	if m.packages == nil {
+		m.packages = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.packages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPackages clears the "packages" edge to the Packages entity.
+func (m *StacksMutation) ClearPackages() {
+	m.clearedpackages = true
+}
+
+// PackagesCleared reports if the "packages" edge to the Packages entity was cleared.
+func (m *StacksMutation) PackagesCleared() bool {
+	return m.clearedpackages
+}
+
+// RemovePackageIDs removes the "packages" edge to the Packages entity by IDs.
+func (m *StacksMutation) RemovePackageIDs(ids ...int) {
+	if m.removedpackages == nil {
+		m.removedpackages = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.packages, ids[i])
+		m.removedpackages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPackages returns the removed IDs of the "packages" edge to the Packages entity.
+func (m *StacksMutation) RemovedPackagesIDs() (ids []int) {
+	for id := range m.removedpackages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PackagesIDs returns the "packages" edge IDs in the mutation.
+func (m *StacksMutation) PackagesIDs() (ids []int) {
+	for id := range m.packages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPackages resets all changes to the "packages" edge.
+func (m *StacksMutation) ResetPackages() {
+	m.packages = nil
+	m.clearedpackages = false
+	m.removedpackages = nil
+}
+
+// Where appends a list predicates to the StacksMutation builder.
+func (m *StacksMutation) Where(ps ...predicate.Stacks) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PackagesMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StacksMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PackagesMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Packages, len(ps))
+func (m *StacksMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Stacks, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -988,42 +5220,42 @@ func (m *PackagesMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PackagesMutation) Op() Op {
+func (m *StacksMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PackagesMutation) SetOp(op Op) {
+func (m *StacksMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Packages).
-func (m *PackagesMutation) Type() string {
+// Type returns the node type of this mutation (Stacks).
+func (m *StacksMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PackagesMutation) Fields() []string {
+func (m *StacksMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, packages.FieldName)
+	if m.created_at != nil {
+		fields = append(fields, stacks.FieldCreatedAt)
 	}
-	if m.link != nil {
-		fields = append(fields, packages.FieldLink)
+	if m.updated_at != nil {
+		fields = append(fields, stacks.FieldUpdatedAt)
 	}
-	if m.description != nil {
-		fields = append(fields, packages.FieldDescription)
+	if m.name != nil {
+		fields = append(fields, stacks.FieldName)
 	}
-	if m.stacks != nil {
-		fields = append(fields, packages.FieldStacks)
+	if m.slug != nil {
+		fields = append(fields, stacks.FieldSlug)
 	}
-	if m.created_at != nil {
-		fields = append(fields, packages.FieldCreatedAt)
+	if m.category != nil {
+		fields = append(fields, stacks.FieldCategory)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, packages.FieldUpdatedAt)
+	if m.iconUrl != nil {
+		fields = append(fields, stacks.FieldIconUrl)
 	}
 	return fields
 }
@@ -1031,20 +5263,20 @@ func (m *PackagesMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PackagesMutation) Field(name string) (ent.Value, bool) {
+func (m *StacksMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case packages.FieldName:
-		return m.Name()
-	case packages.FieldLink:
-		return m.Link()
-	case packages.FieldDescription:
-		return m.Description()
-	case packages.FieldStacks:
-		return m.Stacks()
-	case packages.FieldCreatedAt:
+	case stacks.FieldCreatedAt:
 		return m.CreatedAt()
-	case packages.FieldUpdatedAt:
+	case stacks.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case stacks.FieldName:
+		return m.Name()
+	case stacks.FieldSlug:
+		return m.Slug()
+	case stacks.FieldCategory:
+		return m.Category()
+	case stacks.FieldIconUrl:
+		return m.IconUrl()
 	}
 	return nil, false
 }
@@ -1052,233 +5284,296 @@ func (m *PackagesMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PackagesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StacksMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case packages.FieldName:
-		return m.OldName(ctx)
-	case packages.FieldLink:
-		return m.OldLink(ctx)
-	case packages.FieldDescription:
-		return m.OldDescription(ctx)
-	case packages.FieldStacks:
-		return m.OldStacks(ctx)
-	case packages.FieldCreatedAt:
+	case stacks.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case packages.FieldUpdatedAt:
+	case stacks.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case stacks.FieldName:
+		return m.OldName(ctx)
+	case stacks.FieldSlug:
+		return m.OldSlug(ctx)
+	case stacks.FieldCategory:
+		return m.OldCategory(ctx)
+	case stacks.FieldIconUrl:
+		return m.OldIconUrl(ctx)
 	}
-	return nil, fmt.Errorf("unknown Packages field %s", name)
+	return nil, fmt.Errorf("unknown Stacks field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PackagesMutation) SetField(name string, value ent.Value) error {
+func (m *StacksMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case packages.FieldName:
-		v, ok := value.(string)
+	case stacks.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetCreatedAt(v)
 		return nil
-	case packages.FieldLink:
-		v, ok := value.(string)
+	case stacks.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLink(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case packages.FieldDescription:
+	case stacks.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetName(v)
 		return nil
-	case packages.FieldStacks:
+	case stacks.FieldSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStacks(v)
+		m.SetSlug(v)
 		return nil
-	case packages.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case stacks.FieldCategory:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetCategory(v)
 		return nil
-	case packages.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case stacks.FieldIconUrl:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetIconUrl(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Packages field %s", name)
+	return fmt.Errorf("unknown Stacks field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PackagesMutation) AddedFields() []string {
+func (m *StacksMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PackagesMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StacksMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PackagesMutation) AddField(name string, value ent.Value) error {
+func (m *StacksMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Packages numeric field %s", name)
+	return fmt.Errorf("unknown Stacks numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PackagesMutation) ClearedFields() []string {
+func (m *StacksMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(packages.FieldLink) {
-		fields = append(fields, packages.FieldLink)
+	if m.FieldCleared(stacks.FieldCategory) {
+		fields = append(fields, stacks.FieldCategory)
 	}
-	if m.FieldCleared(packages.FieldDescription) {
-		fields = append(fields, packages.FieldDescription)
+	if m.FieldCleared(stacks.FieldIconUrl) {
+		fields = append(fields, stacks.FieldIconUrl)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PackagesMutation) FieldCleared(name string) bool {
+func (m *StacksMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PackagesMutation) ClearField(name string) error {
+func (m *StacksMutation) ClearField(name string) error {
 	switch name {
-	case packages.FieldLink:
-		m.ClearLink()
+	case stacks.FieldCategory:
+		m.ClearCategory()
 		return nil
-	case packages.FieldDescription:
-		m.ClearDescription()
+	case stacks.FieldIconUrl:
+		m.ClearIconUrl()
 		return nil
 	}
-	return fmt.Errorf("unknown Packages nullable field %s", name)
+	return fmt.Errorf("unknown Stacks nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PackagesMutation) ResetField(name string) error {
+func (m *StacksMutation) ResetField(name string) error {
 	switch name {
-	case packages.FieldName:
-		m.ResetName()
+	case stacks.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case packages.FieldLink:
-		m.ResetLink()
+	case stacks.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case packages.FieldDescription:
-		m.ResetDescription()
+	case stacks.FieldName:
+		m.ResetName()
 		return nil
-	case packages.FieldStacks:
-		m.ResetStacks()
+	case stacks.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case packages.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case stacks.FieldCategory:
+		m.ResetCategory()
 		return nil
-	case packages.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case stacks.FieldIconUrl:
+		m.ResetIconUrl()
 		return nil
 	}
-	return fmt.Errorf("unknown Packages field %s", name)
+	return fmt.Errorf("unknown Stacks field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PackagesMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *StacksMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.projects != nil {
+		edges = append(edges, stacks.EdgeProjects)
+	}
+	if m.packages != nil {
+		edges = append(edges, stacks.EdgePackages)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PackagesMutation) AddedIDs(name string) []ent.Value {
+func (m *StacksMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case stacks.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.projects))
+		for id := range m.projects {
+			ids = append(ids, id)
+		}
+		return ids
+	case stacks.EdgePackages:
+		ids := make([]ent.Value, 0, len(m.packages))
+		for id := range m.packages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PackagesMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *StacksMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedprojects != nil {
+		edges = append(edges, stacks.EdgeProjects)
+	}
+	if m.removedpackages != nil {
+		edges = append(edges, stacks.EdgePackages)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PackagesMutation) RemovedIDs(name string) []ent.Value {
+func (m *StacksMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case stacks.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.removedprojects))
+		for id := range m.removedprojects {
+			ids = append(ids, id)
+		}
+		return ids
+	case stacks.EdgePackages:
+		ids := make([]ent.Value, 0, len(m.removedpackages))
+		for id := range m.removedpackages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PackagesMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *StacksMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprojects {
+		edges = append(edges, stacks.EdgeProjects)
+	}
+	if m.clearedpackages {
+		edges = append(edges, stacks.EdgePackages)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PackagesMutation) EdgeCleared(name string) bool {
+func (m *StacksMutation) EdgeCleared(name string) bool {
+	switch name {
+	case stacks.EdgeProjects:
+		return m.clearedprojects
+	case stacks.EdgePackages:
+		return m.clearedpackages
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PackagesMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Packages unique edge %s", name)
+func (m *StacksMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Stacks unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PackagesMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Packages edge %s", name)
+func (m *StacksMutation) ResetEdge(name string) error {
+	switch name {
+	case stacks.EdgeProjects:
+		m.ResetProjects()
+		return nil
+	case stacks.EdgePackages:
+		m.ResetPackages()
+		return nil
+	}
+	return fmt.Errorf("unknown Stacks edge %s", name)
 }
 
-// ProjectsMutation represents an operation that mutates the Projects nodes in the graph.
-type ProjectsMutation struct {
+// UsersMutation represents an operation that mutates the Users nodes in the graph.
+type UsersMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	email         *string
 	name          *string
-	imageUrl      *string
-	link          *string
-	description   *string
-	stacks        *string
+	password_hash *string
+	role          *users.Role
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Projects, error)
-	predicates    []predicate.Projects
+	oldValue      func(context.Context) (*Users, error)
+	predicates    []predicate.Users
 }
 
-var _ ent.Mutation = (*ProjectsMutation)(nil)
+var _ ent.Mutation = (*UsersMutation)(nil)
 
-// projectsOption allows management of the mutation configuration using functional options.
-type projectsOption func(*ProjectsMutation)
+// usersOption allows management of the mutation configuration using functional options.
+type usersOption func(*UsersMutation)
 
-// newProjectsMutation creates new mutation for the Projects entity.
-func newProjectsMutation(c config, op Op, opts ...projectsOption) *ProjectsMutation {
-	m := &ProjectsMutation{
+// newUsersMutation creates new mutation for the Users entity.
+func newUsersMutation(c config, op Op, opts ...usersOption) *UsersMutation {
+	m := &UsersMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProjects,
+		typ:           TypeUsers,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1287,20 +5582,20 @@ func newProjectsMutation(c config, op Op, opts ...projectsOption) *ProjectsMutat
 	return m
 }
 
-// withProjectsID sets the ID field of the mutation.
-func withProjectsID(id int) projectsOption {
-	return func(m *ProjectsMutation) {
+// withUsersID sets the ID field of the mutation.
+func withUsersID(id int) usersOption {
+	return func(m *UsersMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Projects
+			value *Users
 		)
-		m.oldValue = func(ctx context.Context) (*Projects, error) {
+		m.oldValue = func(ctx context.Context) (*Users, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Projects.Get(ctx, id)
+					value, err = m.Client().Users.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1309,10 +5604,10 @@ func withProjectsID(id int) projectsOption {
 	}
 }
 
-// withProjects sets the old Projects of the mutation.
-func withProjects(node *Projects) projectsOption {
-	return func(m *ProjectsMutation) {
-		m.oldValue = func(context.Context) (*Projects, error) {
+// withUsers sets the old Users of the mutation.
+func withUsers(node *Users) usersOption {
+	return func(m *UsersMutation) {
+		m.oldValue = func(context.Context) (*Users, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1321,7 +5616,7 @@ func withProjects(node *Projects) projectsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProjectsMutation) Client() *Client {
+func (m UsersMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1329,7 +5624,7 @@ func (m ProjectsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProjectsMutation) Tx() (*Tx, error) {
+func (m UsersMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1340,7 +5635,7 @@ func (m ProjectsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProjectsMutation) ID() (id int, exists bool) {
+func (m *UsersMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1351,7 +5646,7 @@ func (m *ProjectsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProjectsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UsersMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1360,240 +5655,250 @@ func (m *ProjectsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Projects.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Users.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ProjectsMutation) SetName(s string) {
-	m.name = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *UsersMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UsersMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UsersMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ProjectsMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UsersMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UsersMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Projects entity.
-// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectsMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UsersMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ProjectsMutation) ResetName() {
-	m.name = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UsersMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetImageUrl sets the "imageUrl" field.
-func (m *ProjectsMutation) SetImageUrl(s string) {
-	m.imageUrl = &s
+// SetEmail sets the "email" field.
+func (m *UsersMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// ImageUrl returns the value of the "imageUrl" field in the mutation.
-func (m *ProjectsMutation) ImageUrl() (r string, exists bool) {
-	v := m.imageUrl
+// Email returns the value of the "email" field in the mutation.
+func (m *UsersMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageUrl returns the old "imageUrl" field's value of the Projects entity.
-// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectsMutation) OldImageUrl(ctx context.Context) (v string, err error) {
+func (m *UsersMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageUrl is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageUrl requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageUrl: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.ImageUrl, nil
-}
-
-// ClearImageUrl clears the value of the "imageUrl" field.
-func (m *ProjectsMutation) ClearImageUrl() {
-	m.imageUrl = nil
-	m.clearedFields[projects.FieldImageUrl] = struct{}{}
-}
-
-// ImageUrlCleared returns if the "imageUrl" field was cleared in this mutation.
-func (m *ProjectsMutation) ImageUrlCleared() bool {
-	_, ok := m.clearedFields[projects.FieldImageUrl]
-	return ok
+	return oldValue.Email, nil
 }
 
-// ResetImageUrl resets all changes to the "imageUrl" field.
-func (m *ProjectsMutation) ResetImageUrl() {
-	m.imageUrl = nil
-	delete(m.clearedFields, projects.FieldImageUrl)
+// ResetEmail resets all changes to the "email" field.
+func (m *UsersMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetLink sets the "link" field.
-func (m *ProjectsMutation) SetLink(s string) {
-	m.link = &s
+// SetName sets the "name" field.
+func (m *UsersMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Link returns the value of the "link" field in the mutation.
-func (m *ProjectsMutation) Link() (r string, exists bool) {
-	v := m.link
+// Name returns the value of the "name" field in the mutation.
+func (m *UsersMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLink returns the old "link" field's value of the Projects entity.
-// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectsMutation) OldLink(ctx context.Context) (v string, err error) {
+func (m *UsersMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLink requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Link, nil
+	return oldValue.Name, nil
 }
 
-// ClearLink clears the value of the "link" field.
-func (m *ProjectsMutation) ClearLink() {
-	m.link = nil
-	m.clearedFields[projects.FieldLink] = struct{}{}
+// ClearName clears the value of the "name" field.
+func (m *UsersMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[users.FieldName] = struct{}{}
 }
 
-// LinkCleared returns if the "link" field was cleared in this mutation.
-func (m *ProjectsMutation) LinkCleared() bool {
-	_, ok := m.clearedFields[projects.FieldLink]
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *UsersMutation) NameCleared() bool {
+	_, ok := m.clearedFields[users.FieldName]
 	return ok
 }
 
-// ResetLink resets all changes to the "link" field.
-func (m *ProjectsMutation) ResetLink() {
-	m.link = nil
-	delete(m.clearedFields, projects.FieldLink)
+// ResetName resets all changes to the "name" field.
+func (m *UsersMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, users.FieldName)
 }
 
-// SetDescription sets the "description" field.
-func (m *ProjectsMutation) SetDescription(s string) {
-	m.description = &s
+// SetPasswordHash sets the "password_hash" field.
+func (m *UsersMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ProjectsMutation) Description() (r string, exists bool) {
-	v := m.description
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UsersMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Projects entity.
-// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectsMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *UsersMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ProjectsMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[projects.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProjectsMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[projects.FieldDescription]
-	return ok
+	return oldValue.PasswordHash, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ProjectsMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, projects.FieldDescription)
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UsersMutation) ResetPasswordHash() {
+	m.password_hash = nil
 }
 
-// SetStacks sets the "stacks" field.
-func (m *ProjectsMutation) SetStacks(s string) {
-	m.stacks = &s
+// SetRole sets the "role" field.
+func (m *UsersMutation) SetRole(u users.Role) {
+	m.role = &u
 }
 
-// Stacks returns the value of the "stacks" field in the mutation.
-func (m *ProjectsMutation) Stacks() (r string, exists bool) {
-	v := m.stacks
+// Role returns the value of the "role" field in the mutation.
+func (m *UsersMutation) Role() (r users.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStacks returns the old "stacks" field's value of the Projects entity.
-// If the Projects object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectsMutation) OldStacks(ctx context.Context) (v string, err error) {
+func (m *UsersMutation) OldRole(ctx context.Context) (v users.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStacks is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStacks requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStacks: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.Stacks, nil
+	return oldValue.Role, nil
 }
 
-// ResetStacks resets all changes to the "stacks" field.
-func (m *ProjectsMutation) ResetStacks() {
-	m.stacks = nil
+// ResetRole resets all changes to the "role" field.
+func (m *UsersMutation) ResetRole() {
+	m.role = nil
 }
 
-// Where appends a list predicates to the ProjectsMutation builder.
-func (m *ProjectsMutation) Where(ps ...predicate.Projects) {
+// Where appends a list predicates to the UsersMutation builder.
+func (m *UsersMutation) Where(ps ...predicate.Users) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProjectsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsersMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProjectsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Projects, len(ps))
+func (m *UsersMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Users, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1601,39 +5906,42 @@ func (m *ProjectsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProjectsMutation) Op() Op {
+func (m *UsersMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProjectsMutation) SetOp(op Op) {
+func (m *UsersMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Projects).
-func (m *ProjectsMutation) Type() string {
+// Type returns the node type of this mutation (Users).
+func (m *UsersMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProjectsMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, projects.FieldName)
+func (m *UsersMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, users.FieldCreatedAt)
 	}
-	if m.imageUrl != nil {
-		fields = append(fields, projects.FieldImageUrl)
+	if m.updated_at != nil {
+		fields = append(fields, users.FieldUpdatedAt)
 	}
-	if m.link != nil {
-		fields = append(fields, projects.FieldLink)
+	if m.email != nil {
+		fields = append(fields, users.FieldEmail)
 	}
-	if m.description != nil {
-		fields = append(fields, projects.FieldDescription)
+	if m.name != nil {
+		fields = append(fields, users.FieldName)
 	}
-	if m.stacks != nil {
-		fields = append(fields, projects.FieldStacks)
+	if m.password_hash != nil {
+		fields = append(fields, users.FieldPasswordHash)
+	}
+	if m.role != nil {
+		fields = append(fields, users.FieldRole)
 	}
 	return fields
 }
@@ -1641,18 +5949,20 @@ func (m *ProjectsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProjectsMutation) Field(name string) (ent.Value, bool) {
+func (m *UsersMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case projects.FieldName:
+	case users.FieldCreatedAt:
+		return m.CreatedAt()
+	case users.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case users.FieldEmail:
+		return m.Email()
+	case users.FieldName:
 		return m.Name()
-	case projects.FieldImageUrl:
-		return m.ImageUrl()
-	case projects.FieldLink:
-		return m.Link()
-	case projects.FieldDescription:
-		return m.Description()
-	case projects.FieldStacks:
-		return m.Stacks()
+	case users.FieldPasswordHash:
+		return m.PasswordHash()
+	case users.FieldRole:
+		return m.Role()
 	}
 	return nil, false
 }
@@ -1660,195 +5970,195 @@ func (m *ProjectsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProjectsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsersMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case projects.FieldName:
+	case users.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case users.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case users.FieldEmail:
+		return m.OldEmail(ctx)
+	case users.FieldName:
 		return m.OldName(ctx)
-	case projects.FieldImageUrl:
-		return m.OldImageUrl(ctx)
-	case projects.FieldLink:
-		return m.OldLink(ctx)
-	case projects.FieldDescription:
-		return m.OldDescription(ctx)
-	case projects.FieldStacks:
-		return m.OldStacks(ctx)
+	case users.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case users.FieldRole:
+		return m.OldRole(ctx)
 	}
-	return nil, fmt.Errorf("unknown Projects field %s", name)
+	return nil, fmt.Errorf("unknown Users field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectsMutation) SetField(name string, value ent.Value) error {
+func (m *UsersMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case projects.FieldName:
-		v, ok := value.(string)
+	case users.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetCreatedAt(v)
 		return nil
-	case projects.FieldImageUrl:
-		v, ok := value.(string)
+	case users.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageUrl(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case projects.FieldLink:
+	case users.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLink(v)
+		m.SetEmail(v)
 		return nil
-	case projects.FieldDescription:
+	case users.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetName(v)
 		return nil
-	case projects.FieldStacks:
+	case users.FieldPasswordHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStacks(v)
+		m.SetPasswordHash(v)
+		return nil
+	case users.FieldRole:
+		v, ok := value.(users.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Projects field %s", name)
+	return fmt.Errorf("unknown Users field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProjectsMutation) AddedFields() []string {
+func (m *UsersMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProjectsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsersMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectsMutation) AddField(name string, value ent.Value) error {
+func (m *UsersMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Projects numeric field %s", name)
+	return fmt.Errorf("unknown Users numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProjectsMutation) ClearedFields() []string {
+func (m *UsersMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(projects.FieldImageUrl) {
-		fields = append(fields, projects.FieldImageUrl)
-	}
-	if m.FieldCleared(projects.FieldLink) {
-		fields = append(fields, projects.FieldLink)
-	}
-	if m.FieldCleared(projects.FieldDescription) {
-		fields = append(fields, projects.FieldDescription)
+	if m.FieldCleared(users.FieldName) {
+		fields = append(fields, users.FieldName)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProjectsMutation) FieldCleared(name string) bool {
+func (m *UsersMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProjectsMutation) ClearField(name string) error {
+func (m *UsersMutation) ClearField(name string) error {
 	switch name {
-	case projects.FieldImageUrl:
-		m.ClearImageUrl()
-		return nil
-	case projects.FieldLink:
-		m.ClearLink()
-		return nil
-	case projects.FieldDescription:
-		m.ClearDescription()
+	case users.FieldName:
+		m.ClearName()
 		return nil
 	}
-	return fmt.Errorf("unknown Projects nullable field %s", name)
+	return fmt.Errorf("unknown Users nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProjectsMutation) ResetField(name string) error {
+func (m *UsersMutation) ResetField(name string) error {
 	switch name {
-	case projects.FieldName:
-		m.ResetName()
+	case users.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case projects.FieldImageUrl:
-		m.ResetImageUrl()
+	case users.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case projects.FieldLink:
-		m.ResetLink()
+	case users.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case projects.FieldDescription:
-		m.ResetDescription()
+	case users.FieldName:
+		m.ResetName()
 		return nil
-	case projects.FieldStacks:
-		m.ResetStacks()
+	case users.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case users.FieldRole:
+		m.ResetRole()
 		return nil
 	}
-	return fmt.Errorf("unknown Projects field %s", name)
+	return fmt.Errorf("unknown Users field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProjectsMutation) AddedEdges() []string {
+func (m *UsersMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProjectsMutation) AddedIDs(name string) []ent.Value {
+func (m *UsersMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProjectsMutation) RemovedEdges() []string {
+func (m *UsersMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProjectsMutation) RemovedIDs(name string) []ent.Value {
+func (m *UsersMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProjectsMutation) ClearedEdges() []string {
+func (m *UsersMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProjectsMutation) EdgeCleared(name string) bool {
+func (m *UsersMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProjectsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Projects unique edge %s", name)
+func (m *UsersMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Users unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProjectsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Projects edge %s", name)
+func (m *UsersMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Users edge %s", name)
 }
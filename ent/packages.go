@@ -17,31 +17,65 @@ type Packages struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// The time the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The time the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The revision counter compared against If-Match on writes
+	Version int `json:"version,omitempty"`
+	// When the row was moved to the trash; NULL while it is live
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// The name of the package
 	Name string `json:"name,omitempty"`
 	// The link to the package
 	Link string `json:"link,omitempty"`
 	// A brief description of the package
 	Description string `json:"description,omitempty"`
-	// Stacks holds the value of the "stacks" field.
-	Stacks string `json:"stacks,omitempty"`
-	// The time the package was created
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	// The time the package was last updated
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PackagesQuery when eager-loading is set.
+	Edges        PackagesEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// PackagesEdges holds the relations/edges for other nodes in the graph.
+type PackagesEdges struct {
+	// The projects that use the package
+	Projects []*Projects `json:"projects,omitempty"`
+	// The technologies the package is built with
+	Stacks []*Stacks `json:"stacks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectsOrErr returns the Projects value or an error if the edge
+// was not loaded in eager-loading.
+func (e PackagesEdges) ProjectsOrErr() ([]*Projects, error) {
+	if e.loadedTypes[0] {
+		return e.Projects, nil
+	}
+	return nil, &NotLoadedError{edge: "projects"}
+}
+
+// StacksOrErr returns the Stacks value or an error if the edge
+// was not loaded in eager-loading.
+func (e PackagesEdges) StacksOrErr() ([]*Stacks, error) {
+	if e.loadedTypes[1] {
+		return e.Stacks, nil
+	}
+	return nil, &NotLoadedError{edge: "stacks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Packages) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case packages.FieldID:
+		case packages.FieldID, packages.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case packages.FieldName, packages.FieldLink, packages.FieldDescription, packages.FieldStacks:
+		case packages.FieldName, packages.FieldLink, packages.FieldDescription:
 			values[i] = new(sql.NullString)
-		case packages.FieldCreatedAt, packages.FieldUpdatedAt:
+		case packages.FieldCreatedAt, packages.FieldUpdatedAt, packages.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -64,6 +98,31 @@ func (pa *Packages) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			pa.ID = int(value.Int64)
+		case packages.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pa.CreatedAt = value.Time
+			}
+		case packages.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pa.UpdatedAt = value.Time
+			}
+		case packages.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pa.Version = int(value.Int64)
+			}
+		case packages.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pa.DeletedAt = new(time.Time)
+				*pa.DeletedAt = value.Time
+			}
 		case packages.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -82,24 +141,6 @@ func (pa *Packages) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pa.Description = value.String
 			}
-		case packages.FieldStacks:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field stacks", values[i])
-			} else if value.Valid {
-				pa.Stacks = value.String
-			}
-		case packages.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
-			} else if value.Valid {
-				pa.CreatedAt = value.Time
-			}
-		case packages.FieldUpdatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
-			} else if value.Valid {
-				pa.UpdatedAt = value.Time
-			}
 		default:
 			pa.selectValues.Set(columns[i], values[i])
 		}
@@ -113,6 +154,16 @@ func (pa *Packages) Value(name string) (ent.Value, error) {
 	return pa.selectValues.Get(name)
 }
 
+// QueryProjects queries the "projects" edge of the Packages entity.
+func (pa *Packages) QueryProjects() *ProjectsQuery {
+	return NewPackagesClient(pa.config).QueryProjects(pa)
+}
+
+// QueryStacks queries the "stacks" edge of the Packages entity.
+func (pa *Packages) QueryStacks() *StacksQuery {
+	return NewPackagesClient(pa.config).QueryStacks(pa)
+}
+
 // Update returns a builder for updating this Packages.
 // Note that you need to call Packages.Unwrap() before calling this method if this Packages
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -136,6 +187,20 @@ func (pa *Packages) String() string {
 	var builder strings.Builder
 	builder.WriteString("Packages(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Version))
+	builder.WriteString(", ")
+	if v := pa.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(pa.Name)
 	builder.WriteString(", ")
@@ -144,15 +209,6 @@ func (pa *Packages) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(pa.Description)
-	builder.WriteString(", ")
-	builder.WriteString("stacks=")
-	builder.WriteString(pa.Stacks)
-	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
-	builder.WriteString("updated_at=")
-	builder.WriteString(pa.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
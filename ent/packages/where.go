@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -54,6 +55,26 @@ func IDLTE(id int) predicate.Packages {
 	return predicate.Packages(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Packages {
+	return predicate.Packages(sql.FieldEQ(FieldVersion, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Packages {
 	return predicate.Packages(sql.FieldEQ(FieldName, v))
@@ -69,21 +90,176 @@ func Description(v string) predicate.Packages {
 	return predicate.Packages(sql.FieldEQ(FieldDescription, v))
 }
 
-// Stacks applies equality check predicate on the "stacks" field. It's identical to StacksEQ.
-func Stacks(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldEQ(FieldStacks, v))
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldEQ(FieldCreatedAt, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldEQ(FieldCreatedAt, v))
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldNEQ(FieldCreatedAt, v))
 }
 
-// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
-func UpdatedAt(v time.Time) predicate.Packages {
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Packages {
 	return predicate.Packages(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Packages {
+	return predicate.Packages(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Packages {
+	return predicate.Packages(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Packages {
+	return predicate.Packages(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Packages {
+	return predicate.Packages(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Packages {
+	return predicate.Packages(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Packages {
+	return predicate.Packages(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Packages {
+	return predicate.Packages(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Packages {
+	return predicate.Packages(sql.FieldLTE(FieldVersion, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Packages {
+	return predicate.Packages(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Packages {
+	return predicate.Packages(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Packages {
+	return predicate.Packages(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Packages {
 	return predicate.Packages(sql.FieldEQ(FieldName, v))
@@ -299,149 +475,50 @@ func DescriptionContainsFold(v string) predicate.Packages {
 	return predicate.Packages(sql.FieldContainsFold(FieldDescription, v))
 }
 
-// StacksEQ applies the EQ predicate on the "stacks" field.
-func StacksEQ(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldEQ(FieldStacks, v))
-}
-
-// StacksNEQ applies the NEQ predicate on the "stacks" field.
-func StacksNEQ(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldNEQ(FieldStacks, v))
-}
-
-// StacksIn applies the In predicate on the "stacks" field.
-func StacksIn(vs ...string) predicate.Packages {
-	return predicate.Packages(sql.FieldIn(FieldStacks, vs...))
-}
-
-// StacksNotIn applies the NotIn predicate on the "stacks" field.
-func StacksNotIn(vs ...string) predicate.Packages {
-	return predicate.Packages(sql.FieldNotIn(FieldStacks, vs...))
-}
-
-// StacksGT applies the GT predicate on the "stacks" field.
-func StacksGT(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldGT(FieldStacks, v))
-}
-
-// StacksGTE applies the GTE predicate on the "stacks" field.
-func StacksGTE(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldGTE(FieldStacks, v))
-}
-
-// StacksLT applies the LT predicate on the "stacks" field.
-func StacksLT(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldLT(FieldStacks, v))
-}
-
-// StacksLTE applies the LTE predicate on the "stacks" field.
-func StacksLTE(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldLTE(FieldStacks, v))
-}
-
-// StacksContains applies the Contains predicate on the "stacks" field.
-func StacksContains(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldContains(FieldStacks, v))
-}
-
-// StacksHasPrefix applies the HasPrefix predicate on the "stacks" field.
-func StacksHasPrefix(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldHasPrefix(FieldStacks, v))
-}
-
-// StacksHasSuffix applies the HasSuffix predicate on the "stacks" field.
-func StacksHasSuffix(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldHasSuffix(FieldStacks, v))
-}
-
-// StacksEqualFold applies the EqualFold predicate on the "stacks" field.
-func StacksEqualFold(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldEqualFold(FieldStacks, v))
-}
-
-// StacksContainsFold applies the ContainsFold predicate on the "stacks" field.
-func StacksContainsFold(v string) predicate.Packages {
-	return predicate.Packages(sql.FieldContainsFold(FieldStacks, v))
-}
-
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldNEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldNotIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldGT(FieldCreatedAt, v))
-}
-
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldGTE(FieldCreatedAt, v))
-}
-
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldLT(FieldCreatedAt, v))
-}
-
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldLTE(FieldCreatedAt, v))
-}
-
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldEQ(FieldUpdatedAt, v))
-}
-
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldNEQ(FieldUpdatedAt, v))
-}
-
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldIn(FieldUpdatedAt, vs...))
-}
-
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldNotIn(FieldUpdatedAt, vs...))
-}
-
-// UpdatedAtGT applies the GT predicate on the "updated_at" field.
-func UpdatedAtGT(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldGT(FieldUpdatedAt, v))
-}
-
-// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
-func UpdatedAtGTE(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldGTE(FieldUpdatedAt, v))
-}
-
-// UpdatedAtLT applies the LT predicate on the "updated_at" field.
-func UpdatedAtLT(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldLT(FieldUpdatedAt, v))
-}
-
-// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
-func UpdatedAtLTE(v time.Time) predicate.Packages {
-	return predicate.Packages(sql.FieldLTE(FieldUpdatedAt, v))
+// HasProjects applies the HasEdge predicate on the "projects" edge.
+func HasProjects() predicate.Packages {
+	return predicate.Packages(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ProjectsTable, ProjectsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectsWith applies the HasEdge predicate on the "projects" edge with a given conditions (other predicates).
+func HasProjectsWith(preds ...predicate.Projects) predicate.Packages {
+	return predicate.Packages(func(s *sql.Selector) {
+		step := newProjectsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStacks applies the HasEdge predicate on the "stacks" edge.
+func HasStacks() predicate.Packages {
+	return predicate.Packages(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, StacksTable, StacksPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStacksWith applies the HasEdge predicate on the "stacks" edge with a given conditions (other predicates).
+func HasStacksWith(preds ...predicate.Stacks) predicate.Packages {
+	return predicate.Packages(func(s *sql.Selector) {
+		step := newStacksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
 }
 
 // And groups predicates with the AND operator between them.
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"project-manager/ent/packages"
+	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -20,6 +22,62 @@ type PackagesCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (pc *PackagesCreate) SetCreatedAt(t time.Time) *PackagesCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PackagesCreate) SetNillableCreatedAt(t *time.Time) *PackagesCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PackagesCreate) SetUpdatedAt(t time.Time) *PackagesCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PackagesCreate) SetNillableUpdatedAt(t *time.Time) *PackagesCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetVersion sets the "version" field.
+func (pc *PackagesCreate) SetVersion(i int) *PackagesCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *PackagesCreate) SetNillableVersion(i *int) *PackagesCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *PackagesCreate) SetDeletedAt(t time.Time) *PackagesCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *PackagesCreate) SetNillableDeletedAt(t *time.Time) *PackagesCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
 // SetName sets the "name" field.
 func (pc *PackagesCreate) SetName(s string) *PackagesCreate {
 	pc.mutation.SetName(s)
@@ -54,46 +112,34 @@ func (pc *PackagesCreate) SetNillableDescription(s *string) *PackagesCreate {
 	return pc
 }
 
-// SetStacks sets the "stacks" field.
-func (pc *PackagesCreate) SetStacks(s string) *PackagesCreate {
-	pc.mutation.SetStacks(s)
+// AddProjectIDs adds the "projects" edge to the Projects entity by IDs.
+func (pc *PackagesCreate) AddProjectIDs(ids ...int) *PackagesCreate {
+	pc.mutation.AddProjectIDs(ids...)
 	return pc
 }
 
-// SetNillableStacks sets the "stacks" field if the given value is not nil.
-func (pc *PackagesCreate) SetNillableStacks(s *string) *PackagesCreate {
-	if s != nil {
-		pc.SetStacks(*s)
+// AddProjects adds the "projects" edges to the Projects entity.
+func (pc *PackagesCreate) AddProjects(p ...*Projects) *PackagesCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return pc
+	return pc.AddProjectIDs(ids...)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (pc *PackagesCreate) SetCreatedAt(t time.Time) *PackagesCreate {
-	pc.mutation.SetCreatedAt(t)
+// AddStackIDs adds the "stacks" edge to the Stacks entity by IDs.
+func (pc *PackagesCreate) AddStackIDs(ids ...int) *PackagesCreate {
+	pc.mutation.AddStackIDs(ids...)
 	return pc
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (pc *PackagesCreate) SetNillableCreatedAt(t *time.Time) *PackagesCreate {
-	if t != nil {
-		pc.SetCreatedAt(*t)
+// AddStacks adds the "stacks" edges to the Stacks entity.
+func (pc *PackagesCreate) AddStacks(s ...*Stacks) *PackagesCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return pc
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (pc *PackagesCreate) SetUpdatedAt(t time.Time) *PackagesCreate {
-	pc.mutation.SetUpdatedAt(t)
-	return pc
-}
-
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (pc *PackagesCreate) SetNillableUpdatedAt(t *time.Time) *PackagesCreate {
-	if t != nil {
-		pc.SetUpdatedAt(*t)
-	}
-	return pc
+	return pc.AddStackIDs(ids...)
 }
 
 // Mutation returns the PackagesMutation object of the builder.
@@ -103,7 +149,9 @@ func (pc *PackagesCreate) Mutation() *PackagesMutation {
 
 // Save creates the Packages in the database.
 func (pc *PackagesCreate) Save(ctx context.Context) (*Packages, error) {
-	pc.defaults()
+	if err := pc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
 }
 
@@ -130,23 +178,44 @@ func (pc *PackagesCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pc *PackagesCreate) defaults() {
-	if _, ok := pc.mutation.Stacks(); !ok {
-		v := packages.DefaultStacks
-		pc.mutation.SetStacks(v)
-	}
+func (pc *PackagesCreate) defaults() error {
 	if _, ok := pc.mutation.CreatedAt(); !ok {
+		if packages.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized packages.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := packages.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		if packages.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized packages.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := packages.DefaultUpdatedAt()
 		pc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := packages.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *PackagesCreate) check() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Packages.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Packages.updated_at"`)}
+	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Packages.version"`)}
+	}
+	if v, ok := pc.mutation.Version(); ok {
+		if err := packages.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Packages.version": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Packages.name"`)}
 	}
@@ -160,15 +229,6 @@ func (pc *PackagesCreate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Packages.description": %w`, err)}
 		}
 	}
-	if _, ok := pc.mutation.Stacks(); !ok {
-		return &ValidationError{Name: "stacks", err: errors.New(`ent: missing required field "Packages.stacks"`)}
-	}
-	if _, ok := pc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Packages.created_at"`)}
-	}
-	if _, ok := pc.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Packages.updated_at"`)}
-	}
 	return nil
 }
 
@@ -195,6 +255,22 @@ func (pc *PackagesCreate) createSpec() (*Packages, *sqlgraph.CreateSpec) {
 		_node = &Packages{config: pc.config}
 		_spec = sqlgraph.NewCreateSpec(packages.Table, sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt))
 	)
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(packages.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(packages.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(packages.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.SetField(packages.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := pc.mutation.Name(); ok {
 		_spec.SetField(packages.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -207,17 +283,37 @@ func (pc *PackagesCreate) createSpec() (*Packages, *sqlgraph.CreateSpec) {
 		_spec.SetField(packages.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
-	if value, ok := pc.mutation.Stacks(); ok {
-		_spec.SetField(packages.FieldStacks, field.TypeString, value)
-		_node.Stacks = value
-	}
-	if value, ok := pc.mutation.CreatedAt(); ok {
-		_spec.SetField(packages.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
+	if nodes := pc.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.ProjectsTable,
+			Columns: packages.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if value, ok := pc.mutation.UpdatedAt(); ok {
-		_spec.SetField(packages.FieldUpdatedAt, field.TypeTime, value)
-		_node.UpdatedAt = value
+	if nodes := pc.mutation.StacksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.StacksTable,
+			Columns: packages.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
@@ -4,10 +4,13 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"project-manager/ent/packages"
 	"project-manager/ent/predicate"
+	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -18,10 +21,12 @@ import (
 // PackagesQuery is the builder for querying Packages entities.
 type PackagesQuery struct {
 	config
-	ctx        *QueryContext
-	order      []packages.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Packages
+	ctx          *QueryContext
+	order        []packages.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Packages
+	withProjects *ProjectsQuery
+	withStacks   *StacksQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +63,50 @@ func (pq *PackagesQuery) Order(o ...packages.OrderOption) *PackagesQuery {
 	return pq
 }
 
+// QueryProjects chains the current query on the "projects" edge.
+func (pq *PackagesQuery) QueryProjects() *ProjectsQuery {
+	query := (&ProjectsClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packages.Table, packages.FieldID, selector),
+			sqlgraph.To(projects.Table, projects.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, packages.ProjectsTable, packages.ProjectsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStacks chains the current query on the "stacks" edge.
+func (pq *PackagesQuery) QueryStacks() *StacksQuery {
+	query := (&StacksClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packages.Table, packages.FieldID, selector),
+			sqlgraph.To(stacks.Table, stacks.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, packages.StacksTable, packages.StacksPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Packages entity from the query.
 // Returns a *NotFoundError when no Packages was found.
 func (pq *PackagesQuery) First(ctx context.Context) (*Packages, error) {
@@ -245,29 +294,53 @@ func (pq *PackagesQuery) Clone() *PackagesQuery {
 		return nil
 	}
 	return &PackagesQuery{
-		config:     pq.config,
-		ctx:        pq.ctx.Clone(),
-		order:      append([]packages.OrderOption{}, pq.order...),
-		inters:     append([]Interceptor{}, pq.inters...),
-		predicates: append([]predicate.Packages{}, pq.predicates...),
+		config:       pq.config,
+		ctx:          pq.ctx.Clone(),
+		order:        append([]packages.OrderOption{}, pq.order...),
+		inters:       append([]Interceptor{}, pq.inters...),
+		predicates:   append([]predicate.Packages{}, pq.predicates...),
+		withProjects: pq.withProjects.Clone(),
+		withStacks:   pq.withStacks.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
 	}
 }
 
+// WithProjects tells the query-builder to eager-load the nodes that are connected to
+// the "projects" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PackagesQuery) WithProjects(opts ...func(*ProjectsQuery)) *PackagesQuery {
+	query := (&ProjectsClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withProjects = query
+	return pq
+}
+
+// WithStacks tells the query-builder to eager-load the nodes that are connected to
+// the "stacks" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PackagesQuery) WithStacks(opts ...func(*StacksQuery)) *PackagesQuery {
+	query := (&StacksClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withStacks = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Packages.Query().
-//		GroupBy(packages.FieldName).
+//		GroupBy(packages.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pq *PackagesQuery) GroupBy(field string, fields ...string) *PackagesGroupBy {
@@ -285,11 +358,11 @@ func (pq *PackagesQuery) GroupBy(field string, fields ...string) *PackagesGroupB
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Packages.Query().
-//		Select(packages.FieldName).
+//		Select(packages.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (pq *PackagesQuery) Select(fields ...string) *PackagesSelect {
 	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
@@ -332,8 +405,12 @@ func (pq *PackagesQuery) prepareQuery(ctx context.Context) error {
 
 func (pq *PackagesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Packages, error) {
 	var (
-		nodes = []*Packages{}
-		_spec = pq.querySpec()
+		nodes       = []*Packages{}
+		_spec       = pq.querySpec()
+		loadedTypes = [2]bool{
+			pq.withProjects != nil,
+			pq.withStacks != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Packages).scanValues(nil, columns)
@@ -341,6 +418,7 @@ func (pq *PackagesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pac
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Packages{config: pq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +430,146 @@ func (pq *PackagesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pac
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := pq.withProjects; query != nil {
+		if err := pq.loadProjects(ctx, query, nodes,
+			func(n *Packages) { n.Edges.Projects = []*Projects{} },
+			func(n *Packages, e *Projects) { n.Edges.Projects = append(n.Edges.Projects, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withStacks; query != nil {
+		if err := pq.loadStacks(ctx, query, nodes,
+			func(n *Packages) { n.Edges.Stacks = []*Stacks{} },
+			func(n *Packages, e *Stacks) { n.Edges.Stacks = append(n.Edges.Stacks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (pq *PackagesQuery) loadProjects(ctx context.Context, query *ProjectsQuery, nodes []*Packages, init func(*Packages), assign func(*Packages, *Projects)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Packages)
+	nids := make(map[int]map[*Packages]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(packages.ProjectsTable)
+		s.Join(joinT).On(s.C(projects.FieldID), joinT.C(packages.ProjectsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(packages.ProjectsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(packages.ProjectsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Packages]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Projects](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "projects" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *PackagesQuery) loadStacks(ctx context.Context, query *StacksQuery, nodes []*Packages, init func(*Packages), assign func(*Packages, *Stacks)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Packages)
+	nids := make(map[int]map[*Packages]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(packages.StacksTable)
+		s.Join(joinT).On(s.C(stacks.FieldID), joinT.C(packages.StacksPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(packages.StacksPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(packages.StacksPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Packages]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Stacks](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "stacks" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
 func (pq *PackagesQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
 	_spec.Node.Columns = pq.ctx.Fields
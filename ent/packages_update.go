@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"project-manager/ent/packages"
 	"project-manager/ent/predicate"
+	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -28,6 +30,53 @@ func (pu *PackagesUpdate) Where(ps ...predicate.Packages) *PackagesUpdate {
 	return pu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PackagesUpdate) SetUpdatedAt(t time.Time) *PackagesUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetVersion sets the "version" field.
+func (pu *PackagesUpdate) SetVersion(i int) *PackagesUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *PackagesUpdate) SetNillableVersion(i *int) *PackagesUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *PackagesUpdate) AddVersion(i int) *PackagesUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *PackagesUpdate) SetDeletedAt(t time.Time) *PackagesUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *PackagesUpdate) SetNillableDeletedAt(t *time.Time) *PackagesUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *PackagesUpdate) ClearDeletedAt() *PackagesUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
 // SetName sets the "name" field.
 func (pu *PackagesUpdate) SetName(s string) *PackagesUpdate {
 	pu.mutation.SetName(s)
@@ -82,48 +131,88 @@ func (pu *PackagesUpdate) ClearDescription() *PackagesUpdate {
 	return pu
 }
 
-// SetStacks sets the "stacks" field.
-func (pu *PackagesUpdate) SetStacks(s string) *PackagesUpdate {
-	pu.mutation.SetStacks(s)
+// AddProjectIDs adds the "projects" edge to the Projects entity by IDs.
+func (pu *PackagesUpdate) AddProjectIDs(ids ...int) *PackagesUpdate {
+	pu.mutation.AddProjectIDs(ids...)
 	return pu
 }
 
-// SetNillableStacks sets the "stacks" field if the given value is not nil.
-func (pu *PackagesUpdate) SetNillableStacks(s *string) *PackagesUpdate {
-	if s != nil {
-		pu.SetStacks(*s)
+// AddProjects adds the "projects" edges to the Projects entity.
+func (pu *PackagesUpdate) AddProjects(p ...*Projects) *PackagesUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return pu.AddProjectIDs(ids...)
+}
+
+// AddStackIDs adds the "stacks" edge to the Stacks entity by IDs.
+func (pu *PackagesUpdate) AddStackIDs(ids ...int) *PackagesUpdate {
+	pu.mutation.AddStackIDs(ids...)
 	return pu
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (pu *PackagesUpdate) SetCreatedAt(t time.Time) *PackagesUpdate {
-	pu.mutation.SetCreatedAt(t)
+// AddStacks adds the "stacks" edges to the Stacks entity.
+func (pu *PackagesUpdate) AddStacks(s ...*Stacks) *PackagesUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddStackIDs(ids...)
+}
+
+// Mutation returns the PackagesMutation object of the builder.
+func (pu *PackagesUpdate) Mutation() *PackagesMutation {
+	return pu.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Projects entity.
+func (pu *PackagesUpdate) ClearProjects() *PackagesUpdate {
+	pu.mutation.ClearProjects()
 	return pu
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (pu *PackagesUpdate) SetNillableCreatedAt(t *time.Time) *PackagesUpdate {
-	if t != nil {
-		pu.SetCreatedAt(*t)
+// RemoveProjectIDs removes the "projects" edge to Projects entities by IDs.
+func (pu *PackagesUpdate) RemoveProjectIDs(ids ...int) *PackagesUpdate {
+	pu.mutation.RemoveProjectIDs(ids...)
+	return pu
+}
+
+// RemoveProjects removes "projects" edges to Projects entities.
+func (pu *PackagesUpdate) RemoveProjects(p ...*Projects) *PackagesUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return pu.RemoveProjectIDs(ids...)
+}
+
+// ClearStacks clears all "stacks" edges to the Stacks entity.
+func (pu *PackagesUpdate) ClearStacks() *PackagesUpdate {
+	pu.mutation.ClearStacks()
 	return pu
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (pu *PackagesUpdate) SetUpdatedAt(t time.Time) *PackagesUpdate {
-	pu.mutation.SetUpdatedAt(t)
+// RemoveStackIDs removes the "stacks" edge to Stacks entities by IDs.
+func (pu *PackagesUpdate) RemoveStackIDs(ids ...int) *PackagesUpdate {
+	pu.mutation.RemoveStackIDs(ids...)
 	return pu
 }
 
-// Mutation returns the PackagesMutation object of the builder.
-func (pu *PackagesUpdate) Mutation() *PackagesMutation {
-	return pu.mutation
+// RemoveStacks removes "stacks" edges to Stacks entities.
+func (pu *PackagesUpdate) RemoveStacks(s ...*Stacks) *PackagesUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveStackIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PackagesUpdate) Save(ctx context.Context) (int, error) {
-	pu.defaults()
+	if err := pu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
 }
 
@@ -150,15 +239,24 @@ func (pu *PackagesUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pu *PackagesUpdate) defaults() {
+func (pu *PackagesUpdate) defaults() error {
 	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		if packages.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized packages.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := packages.UpdateDefaultUpdatedAt()
 		pu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (pu *PackagesUpdate) check() error {
+	if v, ok := pu.mutation.Version(); ok {
+		if err := packages.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Packages.version": %w`, err)}
+		}
+	}
 	if v, ok := pu.mutation.Name(); ok {
 		if err := packages.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Packages.name": %w`, err)}
@@ -184,6 +282,21 @@ func (pu *PackagesUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(packages.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(packages.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(packages.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(packages.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(packages.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.SetField(packages.FieldName, field.TypeString, value)
 	}
@@ -199,14 +312,95 @@ func (pu *PackagesUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.DescriptionCleared() {
 		_spec.ClearField(packages.FieldDescription, field.TypeString)
 	}
-	if value, ok := pu.mutation.Stacks(); ok {
-		_spec.SetField(packages.FieldStacks, field.TypeString, value)
-	}
-	if value, ok := pu.mutation.CreatedAt(); ok {
-		_spec.SetField(packages.FieldCreatedAt, field.TypeTime, value)
-	}
-	if value, ok := pu.mutation.UpdatedAt(); ok {
-		_spec.SetField(packages.FieldUpdatedAt, field.TypeTime, value)
+	if pu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.ProjectsTable,
+			Columns: packages.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !pu.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.ProjectsTable,
+			Columns: packages.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.ProjectsTable,
+			Columns: packages.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.StacksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.StacksTable,
+			Columns: packages.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedStacksIDs(); len(nodes) > 0 && !pu.mutation.StacksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.StacksTable,
+			Columns: packages.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.StacksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.StacksTable,
+			Columns: packages.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -228,6 +422,53 @@ type PackagesUpdateOne struct {
 	mutation *PackagesMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PackagesUpdateOne) SetUpdatedAt(t time.Time) *PackagesUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetVersion sets the "version" field.
+func (puo *PackagesUpdateOne) SetVersion(i int) *PackagesUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *PackagesUpdateOne) SetNillableVersion(i *int) *PackagesUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *PackagesUpdateOne) AddVersion(i int) *PackagesUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *PackagesUpdateOne) SetDeletedAt(t time.Time) *PackagesUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *PackagesUpdateOne) SetNillableDeletedAt(t *time.Time) *PackagesUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *PackagesUpdateOne) ClearDeletedAt() *PackagesUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
 // SetName sets the "name" field.
 func (puo *PackagesUpdateOne) SetName(s string) *PackagesUpdateOne {
 	puo.mutation.SetName(s)
@@ -282,43 +523,81 @@ func (puo *PackagesUpdateOne) ClearDescription() *PackagesUpdateOne {
 	return puo
 }
 
-// SetStacks sets the "stacks" field.
-func (puo *PackagesUpdateOne) SetStacks(s string) *PackagesUpdateOne {
-	puo.mutation.SetStacks(s)
+// AddProjectIDs adds the "projects" edge to the Projects entity by IDs.
+func (puo *PackagesUpdateOne) AddProjectIDs(ids ...int) *PackagesUpdateOne {
+	puo.mutation.AddProjectIDs(ids...)
 	return puo
 }
 
-// SetNillableStacks sets the "stacks" field if the given value is not nil.
-func (puo *PackagesUpdateOne) SetNillableStacks(s *string) *PackagesUpdateOne {
-	if s != nil {
-		puo.SetStacks(*s)
+// AddProjects adds the "projects" edges to the Projects entity.
+func (puo *PackagesUpdateOne) AddProjects(p ...*Projects) *PackagesUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return puo.AddProjectIDs(ids...)
+}
+
+// AddStackIDs adds the "stacks" edge to the Stacks entity by IDs.
+func (puo *PackagesUpdateOne) AddStackIDs(ids ...int) *PackagesUpdateOne {
+	puo.mutation.AddStackIDs(ids...)
 	return puo
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (puo *PackagesUpdateOne) SetCreatedAt(t time.Time) *PackagesUpdateOne {
-	puo.mutation.SetCreatedAt(t)
+// AddStacks adds the "stacks" edges to the Stacks entity.
+func (puo *PackagesUpdateOne) AddStacks(s ...*Stacks) *PackagesUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddStackIDs(ids...)
+}
+
+// Mutation returns the PackagesMutation object of the builder.
+func (puo *PackagesUpdateOne) Mutation() *PackagesMutation {
+	return puo.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Projects entity.
+func (puo *PackagesUpdateOne) ClearProjects() *PackagesUpdateOne {
+	puo.mutation.ClearProjects()
 	return puo
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (puo *PackagesUpdateOne) SetNillableCreatedAt(t *time.Time) *PackagesUpdateOne {
-	if t != nil {
-		puo.SetCreatedAt(*t)
+// RemoveProjectIDs removes the "projects" edge to Projects entities by IDs.
+func (puo *PackagesUpdateOne) RemoveProjectIDs(ids ...int) *PackagesUpdateOne {
+	puo.mutation.RemoveProjectIDs(ids...)
+	return puo
+}
+
+// RemoveProjects removes "projects" edges to Projects entities.
+func (puo *PackagesUpdateOne) RemoveProjects(p ...*Projects) *PackagesUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return puo.RemoveProjectIDs(ids...)
+}
+
+// ClearStacks clears all "stacks" edges to the Stacks entity.
+func (puo *PackagesUpdateOne) ClearStacks() *PackagesUpdateOne {
+	puo.mutation.ClearStacks()
 	return puo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (puo *PackagesUpdateOne) SetUpdatedAt(t time.Time) *PackagesUpdateOne {
-	puo.mutation.SetUpdatedAt(t)
+// RemoveStackIDs removes the "stacks" edge to Stacks entities by IDs.
+func (puo *PackagesUpdateOne) RemoveStackIDs(ids ...int) *PackagesUpdateOne {
+	puo.mutation.RemoveStackIDs(ids...)
 	return puo
 }
 
-// Mutation returns the PackagesMutation object of the builder.
-func (puo *PackagesUpdateOne) Mutation() *PackagesMutation {
-	return puo.mutation
+// RemoveStacks removes "stacks" edges to Stacks entities.
+func (puo *PackagesUpdateOne) RemoveStacks(s ...*Stacks) *PackagesUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveStackIDs(ids...)
 }
 
 // Where appends a list predicates to the PackagesUpdate builder.
@@ -336,7 +615,9 @@ func (puo *PackagesUpdateOne) Select(field string, fields ...string) *PackagesUp
 
 // Save executes the query and returns the updated Packages entity.
 func (puo *PackagesUpdateOne) Save(ctx context.Context) (*Packages, error) {
-	puo.defaults()
+	if err := puo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
 }
 
@@ -363,15 +644,24 @@ func (puo *PackagesUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (puo *PackagesUpdateOne) defaults() {
+func (puo *PackagesUpdateOne) defaults() error {
 	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		if packages.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized packages.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := packages.UpdateDefaultUpdatedAt()
 		puo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (puo *PackagesUpdateOne) check() error {
+	if v, ok := puo.mutation.Version(); ok {
+		if err := packages.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Packages.version": %w`, err)}
+		}
+	}
 	if v, ok := puo.mutation.Name(); ok {
 		if err := packages.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Packages.name": %w`, err)}
@@ -414,6 +704,21 @@ func (puo *PackagesUpdateOne) sqlSave(ctx context.Context) (_node *Packages, err
 			}
 		}
 	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(packages.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(packages.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(packages.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(packages.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(packages.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.SetField(packages.FieldName, field.TypeString, value)
 	}
@@ -429,14 +734,95 @@ func (puo *PackagesUpdateOne) sqlSave(ctx context.Context) (_node *Packages, err
 	if puo.mutation.DescriptionCleared() {
 		_spec.ClearField(packages.FieldDescription, field.TypeString)
 	}
-	if value, ok := puo.mutation.Stacks(); ok {
-		_spec.SetField(packages.FieldStacks, field.TypeString, value)
-	}
-	if value, ok := puo.mutation.CreatedAt(); ok {
-		_spec.SetField(packages.FieldCreatedAt, field.TypeTime, value)
-	}
-	if value, ok := puo.mutation.UpdatedAt(); ok {
-		_spec.SetField(packages.FieldUpdatedAt, field.TypeTime, value)
+	if puo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.ProjectsTable,
+			Columns: packages.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !puo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.ProjectsTable,
+			Columns: packages.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.ProjectsTable,
+			Columns: packages.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.StacksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.StacksTable,
+			Columns: packages.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedStacksIDs(); len(nodes) > 0 && !puo.mutation.StacksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.StacksTable,
+			Columns: packages.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.StacksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   packages.StacksTable,
+			Columns: packages.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &Packages{config: puo.config}
 	_spec.Assign = _node.assignValues
@@ -6,11 +6,23 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditEvents is the predicate function for auditevents builders.
+type AuditEvents func(*sql.Selector)
+
 // Clients is the predicate function for clients builders.
 type Clients func(*sql.Selector)
 
 // Packages is the predicate function for packages builders.
 type Packages func(*sql.Selector)
 
+// ProjectRevisions is the predicate function for projectrevisions builders.
+type ProjectRevisions func(*sql.Selector)
+
 // Projects is the predicate function for projects builders.
 type Projects func(*sql.Selector)
+
+// Stacks is the predicate function for stacks builders.
+type Stacks func(*sql.Selector)
+
+// Users is the predicate function for users builders.
+type Users func(*sql.Selector)
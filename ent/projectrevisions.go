@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"project-manager/ent/projectrevisions"
+	"project-manager/ent/projects"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProjectRevisions is the model entity for the ProjectRevisions schema.
+type ProjectRevisions struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// The project the snapshot belongs to
+	ProjectID int `json:"project_id,omitempty"`
+	// The project version the snapshot was taken at
+	Revision int `json:"revision,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ImageUrl holds the value of the "imageUrl" field.
+	ImageUrl string `json:"imageUrl,omitempty"`
+	// Link holds the value of the "link" field.
+	Link string `json:"link,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// The names of the project's stacks
+	Stacks []string `json:"stacks,omitempty"`
+	// The ID of the project's client
+	ClientID *int `json:"client_id,omitempty"`
+	// The IDs of the project's packages
+	PackageIds []int `json:"package_ids,omitempty"`
+	// The time the revision was saved
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectRevisionsQuery when eager-loading is set.
+	Edges        ProjectRevisionsEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProjectRevisionsEdges holds the relations/edges for other nodes in the graph.
+type ProjectRevisionsEdges struct {
+	// The project the snapshot belongs to
+	Project *Projects `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectRevisionsEdges) ProjectOrErr() (*Projects, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: projects.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProjectRevisions) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case projectrevisions.FieldStacks, projectrevisions.FieldPackageIds:
+			values[i] = new([]byte)
+		case projectrevisions.FieldID, projectrevisions.FieldProjectID, projectrevisions.FieldRevision, projectrevisions.FieldClientID:
+			values[i] = new(sql.NullInt64)
+		case projectrevisions.FieldName, projectrevisions.FieldImageUrl, projectrevisions.FieldLink, projectrevisions.FieldDescription:
+			values[i] = new(sql.NullString)
+		case projectrevisions.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProjectRevisions fields.
+func (pr *ProjectRevisions) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case projectrevisions.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case projectrevisions.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				pr.ProjectID = int(value.Int64)
+			}
+		case projectrevisions.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				pr.Revision = int(value.Int64)
+			}
+		case projectrevisions.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = value.String
+			}
+		case projectrevisions.FieldImageUrl:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field imageUrl", values[i])
+			} else if value.Valid {
+				pr.ImageUrl = value.String
+			}
+		case projectrevisions.FieldLink:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field link", values[i])
+			} else if value.Valid {
+				pr.Link = value.String
+			}
+		case projectrevisions.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pr.Description = value.String
+			}
+		case projectrevisions.FieldStacks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field stacks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Stacks); err != nil {
+					return fmt.Errorf("unmarshal field stacks: %w", err)
+				}
+			}
+		case projectrevisions.FieldClientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				pr.ClientID = new(int)
+				*pr.ClientID = int(value.Int64)
+			}
+		case projectrevisions.FieldPackageIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field package_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.PackageIds); err != nil {
+					return fmt.Errorf("unmarshal field package_ids: %w", err)
+				}
+			}
+		case projectrevisions.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProjectRevisions.
+// This includes values selected through modifiers, order, etc.
+func (pr *ProjectRevisions) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the ProjectRevisions entity.
+func (pr *ProjectRevisions) QueryProject() *ProjectsQuery {
+	return NewProjectRevisionsClient(pr.config).QueryProject(pr)
+}
+
+// Update returns a builder for updating this ProjectRevisions.
+// Note that you need to call ProjectRevisions.Unwrap() before calling this method if this ProjectRevisions
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *ProjectRevisions) Update() *ProjectRevisionsUpdateOne {
+	return NewProjectRevisionsClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the ProjectRevisions entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *ProjectRevisions) Unwrap() *ProjectRevisions {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProjectRevisions is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *ProjectRevisions) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProjectRevisions(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("imageUrl=")
+	builder.WriteString(pr.ImageUrl)
+	builder.WriteString(", ")
+	builder.WriteString("link=")
+	builder.WriteString(pr.Link)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pr.Description)
+	builder.WriteString(", ")
+	builder.WriteString("stacks=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Stacks))
+	builder.WriteString(", ")
+	if v := pr.ClientID; v != nil {
+		builder.WriteString("client_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("package_ids=")
+	builder.WriteString(fmt.Sprintf("%v", pr.PackageIds))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProjectRevisionsSlice is a parsable slice of ProjectRevisions.
+type ProjectRevisionsSlice []*ProjectRevisions
@@ -0,0 +1,594 @@
+// Code generated by ent, DO NOT EDIT.
+
+package projectrevisions
+
+import (
+	"project-manager/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLTE(FieldID, id))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldProjectID, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldRevision, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldName, v))
+}
+
+// ImageUrl applies equality check predicate on the "imageUrl" field. It's identical to ImageUrlEQ.
+func ImageUrl(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldImageUrl, v))
+}
+
+// Link applies equality check predicate on the "link" field. It's identical to LinkEQ.
+func Link(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldLink, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldDescription, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldClientID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLTE(FieldRevision, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldContainsFold(FieldName, v))
+}
+
+// ImageUrlEQ applies the EQ predicate on the "imageUrl" field.
+func ImageUrlEQ(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldImageUrl, v))
+}
+
+// ImageUrlNEQ applies the NEQ predicate on the "imageUrl" field.
+func ImageUrlNEQ(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNEQ(FieldImageUrl, v))
+}
+
+// ImageUrlIn applies the In predicate on the "imageUrl" field.
+func ImageUrlIn(vs ...string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIn(FieldImageUrl, vs...))
+}
+
+// ImageUrlNotIn applies the NotIn predicate on the "imageUrl" field.
+func ImageUrlNotIn(vs ...string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotIn(FieldImageUrl, vs...))
+}
+
+// ImageUrlGT applies the GT predicate on the "imageUrl" field.
+func ImageUrlGT(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGT(FieldImageUrl, v))
+}
+
+// ImageUrlGTE applies the GTE predicate on the "imageUrl" field.
+func ImageUrlGTE(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGTE(FieldImageUrl, v))
+}
+
+// ImageUrlLT applies the LT predicate on the "imageUrl" field.
+func ImageUrlLT(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLT(FieldImageUrl, v))
+}
+
+// ImageUrlLTE applies the LTE predicate on the "imageUrl" field.
+func ImageUrlLTE(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLTE(FieldImageUrl, v))
+}
+
+// ImageUrlContains applies the Contains predicate on the "imageUrl" field.
+func ImageUrlContains(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldContains(FieldImageUrl, v))
+}
+
+// ImageUrlHasPrefix applies the HasPrefix predicate on the "imageUrl" field.
+func ImageUrlHasPrefix(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldHasPrefix(FieldImageUrl, v))
+}
+
+// ImageUrlHasSuffix applies the HasSuffix predicate on the "imageUrl" field.
+func ImageUrlHasSuffix(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldHasSuffix(FieldImageUrl, v))
+}
+
+// ImageUrlIsNil applies the IsNil predicate on the "imageUrl" field.
+func ImageUrlIsNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIsNull(FieldImageUrl))
+}
+
+// ImageUrlNotNil applies the NotNil predicate on the "imageUrl" field.
+func ImageUrlNotNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotNull(FieldImageUrl))
+}
+
+// ImageUrlEqualFold applies the EqualFold predicate on the "imageUrl" field.
+func ImageUrlEqualFold(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEqualFold(FieldImageUrl, v))
+}
+
+// ImageUrlContainsFold applies the ContainsFold predicate on the "imageUrl" field.
+func ImageUrlContainsFold(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldContainsFold(FieldImageUrl, v))
+}
+
+// LinkEQ applies the EQ predicate on the "link" field.
+func LinkEQ(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldLink, v))
+}
+
+// LinkNEQ applies the NEQ predicate on the "link" field.
+func LinkNEQ(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNEQ(FieldLink, v))
+}
+
+// LinkIn applies the In predicate on the "link" field.
+func LinkIn(vs ...string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIn(FieldLink, vs...))
+}
+
+// LinkNotIn applies the NotIn predicate on the "link" field.
+func LinkNotIn(vs ...string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotIn(FieldLink, vs...))
+}
+
+// LinkGT applies the GT predicate on the "link" field.
+func LinkGT(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGT(FieldLink, v))
+}
+
+// LinkGTE applies the GTE predicate on the "link" field.
+func LinkGTE(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGTE(FieldLink, v))
+}
+
+// LinkLT applies the LT predicate on the "link" field.
+func LinkLT(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLT(FieldLink, v))
+}
+
+// LinkLTE applies the LTE predicate on the "link" field.
+func LinkLTE(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLTE(FieldLink, v))
+}
+
+// LinkContains applies the Contains predicate on the "link" field.
+func LinkContains(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldContains(FieldLink, v))
+}
+
+// LinkHasPrefix applies the HasPrefix predicate on the "link" field.
+func LinkHasPrefix(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldHasPrefix(FieldLink, v))
+}
+
+// LinkHasSuffix applies the HasSuffix predicate on the "link" field.
+func LinkHasSuffix(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldHasSuffix(FieldLink, v))
+}
+
+// LinkIsNil applies the IsNil predicate on the "link" field.
+func LinkIsNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIsNull(FieldLink))
+}
+
+// LinkNotNil applies the NotNil predicate on the "link" field.
+func LinkNotNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotNull(FieldLink))
+}
+
+// LinkEqualFold applies the EqualFold predicate on the "link" field.
+func LinkEqualFold(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEqualFold(FieldLink, v))
+}
+
+// LinkContainsFold applies the ContainsFold predicate on the "link" field.
+func LinkContainsFold(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldContainsFold(FieldLink, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// StacksIsNil applies the IsNil predicate on the "stacks" field.
+func StacksIsNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIsNull(FieldStacks))
+}
+
+// StacksNotNil applies the NotNil predicate on the "stacks" field.
+func StacksNotNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotNull(FieldStacks))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v int) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDIsNil applies the IsNil predicate on the "client_id" field.
+func ClientIDIsNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIsNull(FieldClientID))
+}
+
+// ClientIDNotNil applies the NotNil predicate on the "client_id" field.
+func ClientIDNotNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotNull(FieldClientID))
+}
+
+// PackageIdsIsNil applies the IsNil predicate on the "package_ids" field.
+func PackageIdsIsNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIsNull(FieldPackageIds))
+}
+
+// PackageIdsNotNil applies the NotNil predicate on the "package_ids" field.
+func PackageIdsNotNil() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotNull(FieldPackageIds))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Projects) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProjectRevisions) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProjectRevisions) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProjectRevisions) predicate.ProjectRevisions {
+	return predicate.ProjectRevisions(sql.NotPredicates(p))
+}
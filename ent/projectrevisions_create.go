@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager/ent/projectrevisions"
+	"project-manager/ent/projects"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectRevisionsCreate is the builder for creating a ProjectRevisions entity.
+type ProjectRevisionsCreate struct {
+	config
+	mutation *ProjectRevisionsMutation
+	hooks    []Hook
+}
+
+// SetProjectID sets the "project_id" field.
+func (prc *ProjectRevisionsCreate) SetProjectID(i int) *ProjectRevisionsCreate {
+	prc.mutation.SetProjectID(i)
+	return prc
+}
+
+// SetRevision sets the "revision" field.
+func (prc *ProjectRevisionsCreate) SetRevision(i int) *ProjectRevisionsCreate {
+	prc.mutation.SetRevision(i)
+	return prc
+}
+
+// SetName sets the "name" field.
+func (prc *ProjectRevisionsCreate) SetName(s string) *ProjectRevisionsCreate {
+	prc.mutation.SetName(s)
+	return prc
+}
+
+// SetImageUrl sets the "imageUrl" field.
+func (prc *ProjectRevisionsCreate) SetImageUrl(s string) *ProjectRevisionsCreate {
+	prc.mutation.SetImageUrl(s)
+	return prc
+}
+
+// SetNillableImageUrl sets the "imageUrl" field if the given value is not nil.
+func (prc *ProjectRevisionsCreate) SetNillableImageUrl(s *string) *ProjectRevisionsCreate {
+	if s != nil {
+		prc.SetImageUrl(*s)
+	}
+	return prc
+}
+
+// SetLink sets the "link" field.
+func (prc *ProjectRevisionsCreate) SetLink(s string) *ProjectRevisionsCreate {
+	prc.mutation.SetLink(s)
+	return prc
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (prc *ProjectRevisionsCreate) SetNillableLink(s *string) *ProjectRevisionsCreate {
+	if s != nil {
+		prc.SetLink(*s)
+	}
+	return prc
+}
+
+// SetDescription sets the "description" field.
+func (prc *ProjectRevisionsCreate) SetDescription(s string) *ProjectRevisionsCreate {
+	prc.mutation.SetDescription(s)
+	return prc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (prc *ProjectRevisionsCreate) SetNillableDescription(s *string) *ProjectRevisionsCreate {
+	if s != nil {
+		prc.SetDescription(*s)
+	}
+	return prc
+}
+
+// SetStacks sets the "stacks" field.
+func (prc *ProjectRevisionsCreate) SetStacks(s []string) *ProjectRevisionsCreate {
+	prc.mutation.SetStacks(s)
+	return prc
+}
+
+// SetClientID sets the "client_id" field.
+func (prc *ProjectRevisionsCreate) SetClientID(i int) *ProjectRevisionsCreate {
+	prc.mutation.SetClientID(i)
+	return prc
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (prc *ProjectRevisionsCreate) SetNillableClientID(i *int) *ProjectRevisionsCreate {
+	if i != nil {
+		prc.SetClientID(*i)
+	}
+	return prc
+}
+
+// SetPackageIds sets the "package_ids" field.
+func (prc *ProjectRevisionsCreate) SetPackageIds(i []int) *ProjectRevisionsCreate {
+	prc.mutation.SetPackageIds(i)
+	return prc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *ProjectRevisionsCreate) SetCreatedAt(t time.Time) *ProjectRevisionsCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *ProjectRevisionsCreate) SetNillableCreatedAt(t *time.Time) *ProjectRevisionsCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetProject sets the "project" edge to the Projects entity.
+func (prc *ProjectRevisionsCreate) SetProject(p *Projects) *ProjectRevisionsCreate {
+	return prc.SetProjectID(p.ID)
+}
+
+// Mutation returns the ProjectRevisionsMutation object of the builder.
+func (prc *ProjectRevisionsCreate) Mutation() *ProjectRevisionsMutation {
+	return prc.mutation
+}
+
+// Save creates the ProjectRevisions in the database.
+func (prc *ProjectRevisionsCreate) Save(ctx context.Context) (*ProjectRevisions, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *ProjectRevisionsCreate) SaveX(ctx context.Context) *ProjectRevisions {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *ProjectRevisionsCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *ProjectRevisionsCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *ProjectRevisionsCreate) defaults() {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := projectrevisions.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *ProjectRevisionsCreate) check() error {
+	if _, ok := prc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "ProjectRevisions.project_id"`)}
+	}
+	if _, ok := prc.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "ProjectRevisions.revision"`)}
+	}
+	if v, ok := prc.mutation.Revision(); ok {
+		if err := projectrevisions.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "ProjectRevisions.revision": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProjectRevisions.name"`)}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProjectRevisions.created_at"`)}
+	}
+	if len(prc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "ProjectRevisions.project"`)}
+	}
+	return nil
+}
+
+func (prc *ProjectRevisionsCreate) sqlSave(ctx context.Context) (*ProjectRevisions, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *ProjectRevisionsCreate) createSpec() (*ProjectRevisions, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProjectRevisions{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(projectrevisions.Table, sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt))
+	)
+	if value, ok := prc.mutation.Revision(); ok {
+		_spec.SetField(projectrevisions.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := prc.mutation.Name(); ok {
+		_spec.SetField(projectrevisions.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := prc.mutation.ImageUrl(); ok {
+		_spec.SetField(projectrevisions.FieldImageUrl, field.TypeString, value)
+		_node.ImageUrl = value
+	}
+	if value, ok := prc.mutation.Link(); ok {
+		_spec.SetField(projectrevisions.FieldLink, field.TypeString, value)
+		_node.Link = value
+	}
+	if value, ok := prc.mutation.Description(); ok {
+		_spec.SetField(projectrevisions.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := prc.mutation.Stacks(); ok {
+		_spec.SetField(projectrevisions.FieldStacks, field.TypeJSON, value)
+		_node.Stacks = value
+	}
+	if value, ok := prc.mutation.ClientID(); ok {
+		_spec.SetField(projectrevisions.FieldClientID, field.TypeInt, value)
+		_node.ClientID = &value
+	}
+	if value, ok := prc.mutation.PackageIds(); ok {
+		_spec.SetField(projectrevisions.FieldPackageIds, field.TypeJSON, value)
+		_node.PackageIds = value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(projectrevisions.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := prc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectrevisions.ProjectTable,
+			Columns: []string{projectrevisions.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProjectRevisionsCreateBulk is the builder for creating many ProjectRevisions entities in bulk.
+type ProjectRevisionsCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectRevisionsCreate
+}
+
+// Save creates the ProjectRevisions entities in the database.
+func (prcb *ProjectRevisionsCreateBulk) Save(ctx context.Context) ([]*ProjectRevisions, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*ProjectRevisions, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectRevisionsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *ProjectRevisionsCreateBulk) SaveX(ctx context.Context) []*ProjectRevisions {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *ProjectRevisionsCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *ProjectRevisionsCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"project-manager/ent/predicate"
+	"project-manager/ent/projectrevisions"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectRevisionsDelete is the builder for deleting a ProjectRevisions entity.
+type ProjectRevisionsDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProjectRevisionsMutation
+}
+
+// Where appends a list predicates to the ProjectRevisionsDelete builder.
+func (prd *ProjectRevisionsDelete) Where(ps ...predicate.ProjectRevisions) *ProjectRevisionsDelete {
+	prd.mutation.Where(ps...)
+	return prd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (prd *ProjectRevisionsDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, prd.sqlExec, prd.mutation, prd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prd *ProjectRevisionsDelete) ExecX(ctx context.Context) int {
+	n, err := prd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (prd *ProjectRevisionsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(projectrevisions.Table, sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt))
+	if ps := prd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, prd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	prd.mutation.done = true
+	return affected, err
+}
+
+// ProjectRevisionsDeleteOne is the builder for deleting a single ProjectRevisions entity.
+type ProjectRevisionsDeleteOne struct {
+	prd *ProjectRevisionsDelete
+}
+
+// Where appends a list predicates to the ProjectRevisionsDelete builder.
+func (prdo *ProjectRevisionsDeleteOne) Where(ps ...predicate.ProjectRevisions) *ProjectRevisionsDeleteOne {
+	prdo.prd.mutation.Where(ps...)
+	return prdo
+}
+
+// Exec executes the deletion query.
+func (prdo *ProjectRevisionsDeleteOne) Exec(ctx context.Context) error {
+	n, err := prdo.prd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{projectrevisions.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prdo *ProjectRevisionsDeleteOne) ExecX(ctx context.Context) {
+	if err := prdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
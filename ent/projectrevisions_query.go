@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"project-manager/ent/predicate"
+	"project-manager/ent/projectrevisions"
+	"project-manager/ent/projects"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectRevisionsQuery is the builder for querying ProjectRevisions entities.
+type ProjectRevisionsQuery struct {
+	config
+	ctx         *QueryContext
+	order       []projectrevisions.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ProjectRevisions
+	withProject *ProjectsQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProjectRevisionsQuery builder.
+func (prq *ProjectRevisionsQuery) Where(ps ...predicate.ProjectRevisions) *ProjectRevisionsQuery {
+	prq.predicates = append(prq.predicates, ps...)
+	return prq
+}
+
+// Limit the number of records to be returned by this query.
+func (prq *ProjectRevisionsQuery) Limit(limit int) *ProjectRevisionsQuery {
+	prq.ctx.Limit = &limit
+	return prq
+}
+
+// Offset to start from.
+func (prq *ProjectRevisionsQuery) Offset(offset int) *ProjectRevisionsQuery {
+	prq.ctx.Offset = &offset
+	return prq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (prq *ProjectRevisionsQuery) Unique(unique bool) *ProjectRevisionsQuery {
+	prq.ctx.Unique = &unique
+	return prq
+}
+
+// Order specifies how the records should be ordered.
+func (prq *ProjectRevisionsQuery) Order(o ...projectrevisions.OrderOption) *ProjectRevisionsQuery {
+	prq.order = append(prq.order, o...)
+	return prq
+}
+
+// QueryProject chains the current query on the "project" edge.
+func (prq *ProjectRevisionsQuery) QueryProject() *ProjectsQuery {
+	query := (&ProjectsClient{config: prq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projectrevisions.Table, projectrevisions.FieldID, selector),
+			sqlgraph.To(projects.Table, projects.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, projectrevisions.ProjectTable, projectrevisions.ProjectColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProjectRevisions entity from the query.
+// Returns a *NotFoundError when no ProjectRevisions was found.
+func (prq *ProjectRevisionsQuery) First(ctx context.Context) (*ProjectRevisions, error) {
+	nodes, err := prq.Limit(1).All(setContextOp(ctx, prq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{projectrevisions.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (prq *ProjectRevisionsQuery) FirstX(ctx context.Context) *ProjectRevisions {
+	node, err := prq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProjectRevisions ID from the query.
+// Returns a *NotFoundError when no ProjectRevisions ID was found.
+func (prq *ProjectRevisionsQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = prq.Limit(1).IDs(setContextOp(ctx, prq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{projectrevisions.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (prq *ProjectRevisionsQuery) FirstIDX(ctx context.Context) int {
+	id, err := prq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProjectRevisions entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProjectRevisions entity is found.
+// Returns a *NotFoundError when no ProjectRevisions entities are found.
+func (prq *ProjectRevisionsQuery) Only(ctx context.Context) (*ProjectRevisions, error) {
+	nodes, err := prq.Limit(2).All(setContextOp(ctx, prq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{projectrevisions.Label}
+	default:
+		return nil, &NotSingularError{projectrevisions.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (prq *ProjectRevisionsQuery) OnlyX(ctx context.Context) *ProjectRevisions {
+	node, err := prq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProjectRevisions ID in the query.
+// Returns a *NotSingularError when more than one ProjectRevisions ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (prq *ProjectRevisionsQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = prq.Limit(2).IDs(setContextOp(ctx, prq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{projectrevisions.Label}
+	default:
+		err = &NotSingularError{projectrevisions.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (prq *ProjectRevisionsQuery) OnlyIDX(ctx context.Context) int {
+	id, err := prq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProjectRevisionsSlice.
+func (prq *ProjectRevisionsQuery) All(ctx context.Context) ([]*ProjectRevisions, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryAll)
+	if err := prq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProjectRevisions, *ProjectRevisionsQuery]()
+	return withInterceptors[[]*ProjectRevisions](ctx, prq, qr, prq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (prq *ProjectRevisionsQuery) AllX(ctx context.Context) []*ProjectRevisions {
+	nodes, err := prq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProjectRevisions IDs.
+func (prq *ProjectRevisionsQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if prq.ctx.Unique == nil && prq.path != nil {
+		prq.Unique(true)
+	}
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryIDs)
+	if err = prq.Select(projectrevisions.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (prq *ProjectRevisionsQuery) IDsX(ctx context.Context) []int {
+	ids, err := prq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (prq *ProjectRevisionsQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryCount)
+	if err := prq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, prq, querierCount[*ProjectRevisionsQuery](), prq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (prq *ProjectRevisionsQuery) CountX(ctx context.Context) int {
+	count, err := prq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (prq *ProjectRevisionsQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryExist)
+	switch _, err := prq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (prq *ProjectRevisionsQuery) ExistX(ctx context.Context) bool {
+	exist, err := prq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProjectRevisionsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (prq *ProjectRevisionsQuery) Clone() *ProjectRevisionsQuery {
+	if prq == nil {
+		return nil
+	}
+	return &ProjectRevisionsQuery{
+		config:      prq.config,
+		ctx:         prq.ctx.Clone(),
+		order:       append([]projectrevisions.OrderOption{}, prq.order...),
+		inters:      append([]Interceptor{}, prq.inters...),
+		predicates:  append([]predicate.ProjectRevisions{}, prq.predicates...),
+		withProject: prq.withProject.Clone(),
+		// clone intermediate query.
+		sql:  prq.sql.Clone(),
+		path: prq.path,
+	}
+}
+
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *ProjectRevisionsQuery) WithProject(opts ...func(*ProjectsQuery)) *ProjectRevisionsQuery {
+	query := (&ProjectsClient{config: prq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withProject = query
+	return prq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProjectID int `json:"project_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProjectRevisions.Query().
+//		GroupBy(projectrevisions.FieldProjectID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (prq *ProjectRevisionsQuery) GroupBy(field string, fields ...string) *ProjectRevisionsGroupBy {
+	prq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProjectRevisionsGroupBy{build: prq}
+	grbuild.flds = &prq.ctx.Fields
+	grbuild.label = projectrevisions.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProjectID int `json:"project_id,omitempty"`
+//	}
+//
+//	client.ProjectRevisions.Query().
+//		Select(projectrevisions.FieldProjectID).
+//		Scan(ctx, &v)
+func (prq *ProjectRevisionsQuery) Select(fields ...string) *ProjectRevisionsSelect {
+	prq.ctx.Fields = append(prq.ctx.Fields, fields...)
+	sbuild := &ProjectRevisionsSelect{ProjectRevisionsQuery: prq}
+	sbuild.label = projectrevisions.Label
+	sbuild.flds, sbuild.scan = &prq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProjectRevisionsSelect configured with the given aggregations.
+func (prq *ProjectRevisionsQuery) Aggregate(fns ...AggregateFunc) *ProjectRevisionsSelect {
+	return prq.Select().Aggregate(fns...)
+}
+
+func (prq *ProjectRevisionsQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range prq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, prq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range prq.ctx.Fields {
+		if !projectrevisions.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if prq.path != nil {
+		prev, err := prq.path(ctx)
+		if err != nil {
+			return err
+		}
+		prq.sql = prev
+	}
+	return nil
+}
+
+func (prq *ProjectRevisionsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProjectRevisions, error) {
+	var (
+		nodes       = []*ProjectRevisions{}
+		_spec       = prq.querySpec()
+		loadedTypes = [1]bool{
+			prq.withProject != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProjectRevisions).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProjectRevisions{config: prq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, prq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := prq.withProject; query != nil {
+		if err := prq.loadProject(ctx, query, nodes, nil,
+			func(n *ProjectRevisions, e *Projects) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (prq *ProjectRevisionsQuery) loadProject(ctx context.Context, query *ProjectsQuery, nodes []*ProjectRevisions, init func(*ProjectRevisions), assign func(*ProjectRevisions, *Projects)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ProjectRevisions)
+	for i := range nodes {
+		fk := nodes[i].ProjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(projects.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (prq *ProjectRevisionsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := prq.querySpec()
+	_spec.Node.Columns = prq.ctx.Fields
+	if len(prq.ctx.Fields) > 0 {
+		_spec.Unique = prq.ctx.Unique != nil && *prq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, prq.driver, _spec)
+}
+
+func (prq *ProjectRevisionsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(projectrevisions.Table, projectrevisions.Columns, sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt))
+	_spec.From = prq.sql
+	if unique := prq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if prq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := prq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, projectrevisions.FieldID)
+		for i := range fields {
+			if fields[i] != projectrevisions.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if prq.withProject != nil {
+			_spec.Node.AddColumnOnce(projectrevisions.FieldProjectID)
+		}
+	}
+	if ps := prq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := prq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (prq *ProjectRevisionsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(prq.driver.Dialect())
+	t1 := builder.Table(projectrevisions.Table)
+	columns := prq.ctx.Fields
+	if len(columns) == 0 {
+		columns = projectrevisions.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if prq.sql != nil {
+		selector = prq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if prq.ctx.Unique != nil && *prq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range prq.predicates {
+		p(selector)
+	}
+	for _, p := range prq.order {
+		p(selector)
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProjectRevisionsGroupBy is the group-by builder for ProjectRevisions entities.
+type ProjectRevisionsGroupBy struct {
+	selector
+	build *ProjectRevisionsQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (prgb *ProjectRevisionsGroupBy) Aggregate(fns ...AggregateFunc) *ProjectRevisionsGroupBy {
+	prgb.fns = append(prgb.fns, fns...)
+	return prgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prgb *ProjectRevisionsGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prgb.build.ctx, ent.OpQueryGroupBy)
+	if err := prgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProjectRevisionsQuery, *ProjectRevisionsGroupBy](ctx, prgb.build, prgb, prgb.build.inters, v)
+}
+
+func (prgb *ProjectRevisionsGroupBy) sqlScan(ctx context.Context, root *ProjectRevisionsQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(prgb.fns))
+	for _, fn := range prgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*prgb.flds)+len(prgb.fns))
+		for _, f := range *prgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*prgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProjectRevisionsSelect is the builder for selecting fields of ProjectRevisions entities.
+type ProjectRevisionsSelect struct {
+	*ProjectRevisionsQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (prs *ProjectRevisionsSelect) Aggregate(fns ...AggregateFunc) *ProjectRevisionsSelect {
+	prs.fns = append(prs.fns, fns...)
+	return prs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prs *ProjectRevisionsSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prs.ctx, ent.OpQuerySelect)
+	if err := prs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProjectRevisionsQuery, *ProjectRevisionsSelect](ctx, prs.ProjectRevisionsQuery, prs, prs.inters, v)
+}
+
+func (prs *ProjectRevisionsSelect) sqlScan(ctx context.Context, root *ProjectRevisionsQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(prs.fns))
+	for _, fn := range prs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*prs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager/ent/predicate"
+	"project-manager/ent/projectrevisions"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectRevisionsUpdate is the builder for updating ProjectRevisions entities.
+type ProjectRevisionsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProjectRevisionsMutation
+}
+
+// Where appends a list predicates to the ProjectRevisionsUpdate builder.
+func (pru *ProjectRevisionsUpdate) Where(ps ...predicate.ProjectRevisions) *ProjectRevisionsUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// Mutation returns the ProjectRevisionsMutation object of the builder.
+func (pru *ProjectRevisionsUpdate) Mutation() *ProjectRevisionsMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *ProjectRevisionsUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *ProjectRevisionsUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *ProjectRevisionsUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *ProjectRevisionsUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *ProjectRevisionsUpdate) check() error {
+	if pru.mutation.ProjectCleared() && len(pru.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectRevisions.project"`)
+	}
+	return nil
+}
+
+func (pru *ProjectRevisionsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projectrevisions.Table, projectrevisions.Columns, sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pru.mutation.ImageUrlCleared() {
+		_spec.ClearField(projectrevisions.FieldImageUrl, field.TypeString)
+	}
+	if pru.mutation.LinkCleared() {
+		_spec.ClearField(projectrevisions.FieldLink, field.TypeString)
+	}
+	if pru.mutation.DescriptionCleared() {
+		_spec.ClearField(projectrevisions.FieldDescription, field.TypeString)
+	}
+	if pru.mutation.StacksCleared() {
+		_spec.ClearField(projectrevisions.FieldStacks, field.TypeJSON)
+	}
+	if pru.mutation.ClientIDCleared() {
+		_spec.ClearField(projectrevisions.FieldClientID, field.TypeInt)
+	}
+	if pru.mutation.PackageIdsCleared() {
+		_spec.ClearField(projectrevisions.FieldPackageIds, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectrevisions.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// ProjectRevisionsUpdateOne is the builder for updating a single ProjectRevisions entity.
+type ProjectRevisionsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectRevisionsMutation
+}
+
+// Mutation returns the ProjectRevisionsMutation object of the builder.
+func (pruo *ProjectRevisionsUpdateOne) Mutation() *ProjectRevisionsMutation {
+	return pruo.mutation
+}
+
+// Where appends a list predicates to the ProjectRevisionsUpdate builder.
+func (pruo *ProjectRevisionsUpdateOne) Where(ps ...predicate.ProjectRevisions) *ProjectRevisionsUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *ProjectRevisionsUpdateOne) Select(field string, fields ...string) *ProjectRevisionsUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated ProjectRevisions entity.
+func (pruo *ProjectRevisionsUpdateOne) Save(ctx context.Context) (*ProjectRevisions, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *ProjectRevisionsUpdateOne) SaveX(ctx context.Context) *ProjectRevisions {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *ProjectRevisionsUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *ProjectRevisionsUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *ProjectRevisionsUpdateOne) check() error {
+	if pruo.mutation.ProjectCleared() && len(pruo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectRevisions.project"`)
+	}
+	return nil
+}
+
+func (pruo *ProjectRevisionsUpdateOne) sqlSave(ctx context.Context) (_node *ProjectRevisions, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projectrevisions.Table, projectrevisions.Columns, sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProjectRevisions.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, projectrevisions.FieldID)
+		for _, f := range fields {
+			if !projectrevisions.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != projectrevisions.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pruo.mutation.ImageUrlCleared() {
+		_spec.ClearField(projectrevisions.FieldImageUrl, field.TypeString)
+	}
+	if pruo.mutation.LinkCleared() {
+		_spec.ClearField(projectrevisions.FieldLink, field.TypeString)
+	}
+	if pruo.mutation.DescriptionCleared() {
+		_spec.ClearField(projectrevisions.FieldDescription, field.TypeString)
+	}
+	if pruo.mutation.StacksCleared() {
+		_spec.ClearField(projectrevisions.FieldStacks, field.TypeJSON)
+	}
+	if pruo.mutation.ClientIDCleared() {
+		_spec.ClearField(projectrevisions.FieldClientID, field.TypeInt)
+	}
+	if pruo.mutation.PackageIdsCleared() {
+		_spec.ClearField(projectrevisions.FieldPackageIds, field.TypeJSON)
+	}
+	_node = &ProjectRevisions{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectrevisions.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
@@ -4,8 +4,10 @@ package ent
 
 import (
 	"fmt"
+	"project-manager/ent/clients"
 	"project-manager/ent/projects"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -16,6 +18,14 @@ type Projects struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// The time the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The time the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The revision counter compared against If-Match on writes
+	Version int `json:"version,omitempty"`
+	// When the row was moved to the trash; NULL while it is live
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// ImageUrl holds the value of the "imageUrl" field.
@@ -24,9 +34,64 @@ type Projects struct {
 	Link string `json:"link,omitempty"`
 	// A brief description of the package
 	Description string `json:"description,omitempty"`
-	// Stacks holds the value of the "stacks" field.
-	Stacks       string `json:"stacks,omitempty"`
-	selectValues sql.SelectValues
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectsQuery when eager-loading is set.
+	Edges            ProjectsEdges `json:"edges"`
+	clients_projects *int
+	selectValues     sql.SelectValues
+}
+
+// ProjectsEdges holds the relations/edges for other nodes in the graph.
+type ProjectsEdges struct {
+	// The client the project was built for
+	Client *Clients `json:"client,omitempty"`
+	// The packages used by the project
+	Packages []*Packages `json:"packages,omitempty"`
+	// The technologies the project is built with
+	Stacks []*Stacks `json:"stacks,omitempty"`
+	// The saved versions of the project
+	Revisions []*ProjectRevisions `json:"revisions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ClientOrErr returns the Client value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectsEdges) ClientOrErr() (*Clients, error) {
+	if e.Client != nil {
+		return e.Client, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: clients.Label}
+	}
+	return nil, &NotLoadedError{edge: "client"}
+}
+
+// PackagesOrErr returns the Packages value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectsEdges) PackagesOrErr() ([]*Packages, error) {
+	if e.loadedTypes[1] {
+		return e.Packages, nil
+	}
+	return nil, &NotLoadedError{edge: "packages"}
+}
+
+// StacksOrErr returns the Stacks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectsEdges) StacksOrErr() ([]*Stacks, error) {
+	if e.loadedTypes[2] {
+		return e.Stacks, nil
+	}
+	return nil, &NotLoadedError{edge: "stacks"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectsEdges) RevisionsOrErr() ([]*ProjectRevisions, error) {
+	if e.loadedTypes[3] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -34,10 +99,14 @@ func (*Projects) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case projects.FieldID:
+		case projects.FieldID, projects.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case projects.FieldName, projects.FieldImageUrl, projects.FieldLink, projects.FieldDescription, projects.FieldStacks:
+		case projects.FieldName, projects.FieldImageUrl, projects.FieldLink, projects.FieldDescription:
 			values[i] = new(sql.NullString)
+		case projects.FieldCreatedAt, projects.FieldUpdatedAt, projects.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case projects.ForeignKeys[0]: // clients_projects
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -59,6 +128,31 @@ func (pr *Projects) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			pr.ID = int(value.Int64)
+		case projects.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case projects.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case projects.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pr.Version = int(value.Int64)
+			}
+		case projects.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pr.DeletedAt = new(time.Time)
+				*pr.DeletedAt = value.Time
+			}
 		case projects.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -83,11 +177,12 @@ func (pr *Projects) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.Description = value.String
 			}
-		case projects.FieldStacks:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field stacks", values[i])
+		case projects.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field clients_projects", value)
 			} else if value.Valid {
-				pr.Stacks = value.String
+				pr.clients_projects = new(int)
+				*pr.clients_projects = int(value.Int64)
 			}
 		default:
 			pr.selectValues.Set(columns[i], values[i])
@@ -102,6 +197,26 @@ func (pr *Projects) Value(name string) (ent.Value, error) {
 	return pr.selectValues.Get(name)
 }
 
+// QueryClient queries the "client" edge of the Projects entity.
+func (pr *Projects) QueryClient() *ClientsQuery {
+	return NewProjectsClient(pr.config).QueryClient(pr)
+}
+
+// QueryPackages queries the "packages" edge of the Projects entity.
+func (pr *Projects) QueryPackages() *PackagesQuery {
+	return NewProjectsClient(pr.config).QueryPackages(pr)
+}
+
+// QueryStacks queries the "stacks" edge of the Projects entity.
+func (pr *Projects) QueryStacks() *StacksQuery {
+	return NewProjectsClient(pr.config).QueryStacks(pr)
+}
+
+// QueryRevisions queries the "revisions" edge of the Projects entity.
+func (pr *Projects) QueryRevisions() *ProjectRevisionsQuery {
+	return NewProjectsClient(pr.config).QueryRevisions(pr)
+}
+
 // Update returns a builder for updating this Projects.
 // Note that you need to call Projects.Unwrap() before calling this method if this Projects
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -125,6 +240,20 @@ func (pr *Projects) String() string {
 	var builder strings.Builder
 	builder.WriteString("Projects(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Version))
+	builder.WriteString(", ")
+	if v := pr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(pr.Name)
 	builder.WriteString(", ")
@@ -136,9 +265,6 @@ func (pr *Projects) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(pr.Description)
-	builder.WriteString(", ")
-	builder.WriteString("stacks=")
-	builder.WriteString(pr.Stacks)
 	builder.WriteByte(')')
 	return builder.String()
 }
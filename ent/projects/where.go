@@ -4,8 +4,10 @@ package projects
 
 import (
 	"project-manager/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -53,6 +55,26 @@ func IDLTE(id int) predicate.Projects {
 	return predicate.Projects(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Projects {
+	return predicate.Projects(sql.FieldEQ(FieldVersion, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Projects {
 	return predicate.Projects(sql.FieldEQ(FieldName, v))
@@ -73,9 +95,174 @@ func Description(v string) predicate.Projects {
 	return predicate.Projects(sql.FieldEQ(FieldDescription, v))
 }
 
-// Stacks applies equality check predicate on the "stacks" field. It's identical to StacksEQ.
-func Stacks(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldEQ(FieldStacks, v))
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Projects {
+	return predicate.Projects(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Projects {
+	return predicate.Projects(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Projects {
+	return predicate.Projects(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Projects {
+	return predicate.Projects(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Projects {
+	return predicate.Projects(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Projects {
+	return predicate.Projects(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Projects {
+	return predicate.Projects(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Projects {
+	return predicate.Projects(sql.FieldLTE(FieldVersion, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Projects {
+	return predicate.Projects(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Projects {
+	return predicate.Projects(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Projects {
+	return predicate.Projects(sql.FieldNotNull(FieldDeletedAt))
 }
 
 // NameEQ applies the EQ predicate on the "name" field.
@@ -368,69 +555,96 @@ func DescriptionContainsFold(v string) predicate.Projects {
 	return predicate.Projects(sql.FieldContainsFold(FieldDescription, v))
 }
 
-// StacksEQ applies the EQ predicate on the "stacks" field.
-func StacksEQ(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldEQ(FieldStacks, v))
-}
-
-// StacksNEQ applies the NEQ predicate on the "stacks" field.
-func StacksNEQ(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldNEQ(FieldStacks, v))
-}
-
-// StacksIn applies the In predicate on the "stacks" field.
-func StacksIn(vs ...string) predicate.Projects {
-	return predicate.Projects(sql.FieldIn(FieldStacks, vs...))
-}
-
-// StacksNotIn applies the NotIn predicate on the "stacks" field.
-func StacksNotIn(vs ...string) predicate.Projects {
-	return predicate.Projects(sql.FieldNotIn(FieldStacks, vs...))
-}
-
-// StacksGT applies the GT predicate on the "stacks" field.
-func StacksGT(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldGT(FieldStacks, v))
-}
-
-// StacksGTE applies the GTE predicate on the "stacks" field.
-func StacksGTE(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldGTE(FieldStacks, v))
-}
-
-// StacksLT applies the LT predicate on the "stacks" field.
-func StacksLT(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldLT(FieldStacks, v))
-}
-
-// StacksLTE applies the LTE predicate on the "stacks" field.
-func StacksLTE(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldLTE(FieldStacks, v))
-}
-
-// StacksContains applies the Contains predicate on the "stacks" field.
-func StacksContains(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldContains(FieldStacks, v))
-}
-
-// StacksHasPrefix applies the HasPrefix predicate on the "stacks" field.
-func StacksHasPrefix(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldHasPrefix(FieldStacks, v))
-}
-
-// StacksHasSuffix applies the HasSuffix predicate on the "stacks" field.
-func StacksHasSuffix(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldHasSuffix(FieldStacks, v))
-}
-
-// StacksEqualFold applies the EqualFold predicate on the "stacks" field.
-func StacksEqualFold(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldEqualFold(FieldStacks, v))
-}
-
-// StacksContainsFold applies the ContainsFold predicate on the "stacks" field.
-func StacksContainsFold(v string) predicate.Projects {
-	return predicate.Projects(sql.FieldContainsFold(FieldStacks, v))
+// HasClient applies the HasEdge predicate on the "client" edge.
+func HasClient() predicate.Projects {
+	return predicate.Projects(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ClientTable, ClientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClientWith applies the HasEdge predicate on the "client" edge with a given conditions (other predicates).
+func HasClientWith(preds ...predicate.Clients) predicate.Projects {
+	return predicate.Projects(func(s *sql.Selector) {
+		step := newClientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPackages applies the HasEdge predicate on the "packages" edge.
+func HasPackages() predicate.Projects {
+	return predicate.Projects(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, PackagesTable, PackagesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPackagesWith applies the HasEdge predicate on the "packages" edge with a given conditions (other predicates).
+func HasPackagesWith(preds ...predicate.Packages) predicate.Projects {
+	return predicate.Projects(func(s *sql.Selector) {
+		step := newPackagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStacks applies the HasEdge predicate on the "stacks" edge.
+func HasStacks() predicate.Projects {
+	return predicate.Projects(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, StacksTable, StacksPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStacksWith applies the HasEdge predicate on the "stacks" edge with a given conditions (other predicates).
+func HasStacksWith(preds ...predicate.Stacks) predicate.Projects {
+	return predicate.Projects(func(s *sql.Selector) {
+		step := newStacksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Projects {
+	return predicate.Projects(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.ProjectRevisions) predicate.Projects {
+	return predicate.Projects(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
 }
 
 // And groups predicates with the AND operator between them.
@@ -6,7 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
+	"project-manager/ent/projectrevisions"
 	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -19,6 +24,62 @@ type ProjectsCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (pc *ProjectsCreate) SetCreatedAt(t time.Time) *ProjectsCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *ProjectsCreate) SetNillableCreatedAt(t *time.Time) *ProjectsCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *ProjectsCreate) SetUpdatedAt(t time.Time) *ProjectsCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *ProjectsCreate) SetNillableUpdatedAt(t *time.Time) *ProjectsCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetVersion sets the "version" field.
+func (pc *ProjectsCreate) SetVersion(i int) *ProjectsCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *ProjectsCreate) SetNillableVersion(i *int) *ProjectsCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *ProjectsCreate) SetDeletedAt(t time.Time) *ProjectsCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *ProjectsCreate) SetNillableDeletedAt(t *time.Time) *ProjectsCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
 // SetName sets the "name" field.
 func (pc *ProjectsCreate) SetName(s string) *ProjectsCreate {
 	pc.mutation.SetName(s)
@@ -67,20 +128,70 @@ func (pc *ProjectsCreate) SetNillableDescription(s *string) *ProjectsCreate {
 	return pc
 }
 
-// SetStacks sets the "stacks" field.
-func (pc *ProjectsCreate) SetStacks(s string) *ProjectsCreate {
-	pc.mutation.SetStacks(s)
+// SetClientID sets the "client" edge to the Clients entity by ID.
+func (pc *ProjectsCreate) SetClientID(id int) *ProjectsCreate {
+	pc.mutation.SetClientID(id)
 	return pc
 }
 
-// SetNillableStacks sets the "stacks" field if the given value is not nil.
-func (pc *ProjectsCreate) SetNillableStacks(s *string) *ProjectsCreate {
-	if s != nil {
-		pc.SetStacks(*s)
+// SetNillableClientID sets the "client" edge to the Clients entity by ID if the given value is not nil.
+func (pc *ProjectsCreate) SetNillableClientID(id *int) *ProjectsCreate {
+	if id != nil {
+		pc = pc.SetClientID(*id)
 	}
 	return pc
 }
 
+// SetClient sets the "client" edge to the Clients entity.
+func (pc *ProjectsCreate) SetClient(c *Clients) *ProjectsCreate {
+	return pc.SetClientID(c.ID)
+}
+
+// AddPackageIDs adds the "packages" edge to the Packages entity by IDs.
+func (pc *ProjectsCreate) AddPackageIDs(ids ...int) *ProjectsCreate {
+	pc.mutation.AddPackageIDs(ids...)
+	return pc
+}
+
+// AddPackages adds the "packages" edges to the Packages entity.
+func (pc *ProjectsCreate) AddPackages(p ...*Packages) *ProjectsCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddPackageIDs(ids...)
+}
+
+// AddStackIDs adds the "stacks" edge to the Stacks entity by IDs.
+func (pc *ProjectsCreate) AddStackIDs(ids ...int) *ProjectsCreate {
+	pc.mutation.AddStackIDs(ids...)
+	return pc
+}
+
+// AddStacks adds the "stacks" edges to the Stacks entity.
+func (pc *ProjectsCreate) AddStacks(s ...*Stacks) *ProjectsCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddStackIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ProjectRevisions entity by IDs.
+func (pc *ProjectsCreate) AddRevisionIDs(ids ...int) *ProjectsCreate {
+	pc.mutation.AddRevisionIDs(ids...)
+	return pc
+}
+
+// AddRevisions adds the "revisions" edges to the ProjectRevisions entity.
+func (pc *ProjectsCreate) AddRevisions(p ...*ProjectRevisions) *ProjectsCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the ProjectsMutation object of the builder.
 func (pc *ProjectsCreate) Mutation() *ProjectsMutation {
 	return pc.mutation
@@ -88,7 +199,9 @@ func (pc *ProjectsCreate) Mutation() *ProjectsMutation {
 
 // Save creates the Projects in the database.
 func (pc *ProjectsCreate) Save(ctx context.Context) (*Projects, error) {
-	pc.defaults()
+	if err := pc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
 }
 
@@ -115,15 +228,44 @@ func (pc *ProjectsCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pc *ProjectsCreate) defaults() {
-	if _, ok := pc.mutation.Stacks(); !ok {
-		v := projects.DefaultStacks
-		pc.mutation.SetStacks(v)
+func (pc *ProjectsCreate) defaults() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		if projects.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized projects.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := projects.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		if projects.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized projects.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := projects.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := projects.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *ProjectsCreate) check() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Projects.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Projects.updated_at"`)}
+	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Projects.version"`)}
+	}
+	if v, ok := pc.mutation.Version(); ok {
+		if err := projects.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Projects.version": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Projects.name"`)}
 	}
@@ -137,9 +279,6 @@ func (pc *ProjectsCreate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Projects.description": %w`, err)}
 		}
 	}
-	if _, ok := pc.mutation.Stacks(); !ok {
-		return &ValidationError{Name: "stacks", err: errors.New(`ent: missing required field "Projects.stacks"`)}
-	}
 	return nil
 }
 
@@ -166,6 +305,22 @@ func (pc *ProjectsCreate) createSpec() (*Projects, *sqlgraph.CreateSpec) {
 		_node = &Projects{config: pc.config}
 		_spec = sqlgraph.NewCreateSpec(projects.Table, sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt))
 	)
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(projects.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(projects.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(projects.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.SetField(projects.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := pc.mutation.Name(); ok {
 		_spec.SetField(projects.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -182,9 +337,70 @@ func (pc *ProjectsCreate) createSpec() (*Projects, *sqlgraph.CreateSpec) {
 		_spec.SetField(projects.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
-	if value, ok := pc.mutation.Stacks(); ok {
-		_spec.SetField(projects.FieldStacks, field.TypeString, value)
-		_node.Stacks = value
+	if nodes := pc.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projects.ClientTable,
+			Columns: []string{projects.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clients.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.clients_projects = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.PackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   projects.PackagesTable,
+			Columns: projects.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.StacksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   projects.StacksTable,
+			Columns: projects.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projects.RevisionsTable,
+			Columns: []string{projects.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
@@ -4,10 +4,15 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
 	"project-manager/ent/predicate"
+	"project-manager/ent/projectrevisions"
 	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -18,10 +23,15 @@ import (
 // ProjectsQuery is the builder for querying Projects entities.
 type ProjectsQuery struct {
 	config
-	ctx        *QueryContext
-	order      []projects.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Projects
+	ctx           *QueryContext
+	order         []projects.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Projects
+	withClient    *ClientsQuery
+	withPackages  *PackagesQuery
+	withStacks    *StacksQuery
+	withRevisions *ProjectRevisionsQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +68,94 @@ func (pq *ProjectsQuery) Order(o ...projects.OrderOption) *ProjectsQuery {
 	return pq
 }
 
+// QueryClient chains the current query on the "client" edge.
+func (pq *ProjectsQuery) QueryClient() *ClientsQuery {
+	query := (&ClientsClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projects.Table, projects.FieldID, selector),
+			sqlgraph.To(clients.Table, clients.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, projects.ClientTable, projects.ClientColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPackages chains the current query on the "packages" edge.
+func (pq *ProjectsQuery) QueryPackages() *PackagesQuery {
+	query := (&PackagesClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projects.Table, projects.FieldID, selector),
+			sqlgraph.To(packages.Table, packages.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, projects.PackagesTable, projects.PackagesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStacks chains the current query on the "stacks" edge.
+func (pq *ProjectsQuery) QueryStacks() *StacksQuery {
+	query := (&StacksClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projects.Table, projects.FieldID, selector),
+			sqlgraph.To(stacks.Table, stacks.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, projects.StacksTable, projects.StacksPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (pq *ProjectsQuery) QueryRevisions() *ProjectRevisionsQuery {
+	query := (&ProjectRevisionsClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projects.Table, projects.FieldID, selector),
+			sqlgraph.To(projectrevisions.Table, projectrevisions.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, projects.RevisionsTable, projects.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Projects entity from the query.
 // Returns a *NotFoundError when no Projects was found.
 func (pq *ProjectsQuery) First(ctx context.Context) (*Projects, error) {
@@ -245,29 +343,77 @@ func (pq *ProjectsQuery) Clone() *ProjectsQuery {
 		return nil
 	}
 	return &ProjectsQuery{
-		config:     pq.config,
-		ctx:        pq.ctx.Clone(),
-		order:      append([]projects.OrderOption{}, pq.order...),
-		inters:     append([]Interceptor{}, pq.inters...),
-		predicates: append([]predicate.Projects{}, pq.predicates...),
+		config:        pq.config,
+		ctx:           pq.ctx.Clone(),
+		order:         append([]projects.OrderOption{}, pq.order...),
+		inters:        append([]Interceptor{}, pq.inters...),
+		predicates:    append([]predicate.Projects{}, pq.predicates...),
+		withClient:    pq.withClient.Clone(),
+		withPackages:  pq.withPackages.Clone(),
+		withStacks:    pq.withStacks.Clone(),
+		withRevisions: pq.withRevisions.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
 	}
 }
 
+// WithClient tells the query-builder to eager-load the nodes that are connected to
+// the "client" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectsQuery) WithClient(opts ...func(*ClientsQuery)) *ProjectsQuery {
+	query := (&ClientsClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withClient = query
+	return pq
+}
+
+// WithPackages tells the query-builder to eager-load the nodes that are connected to
+// the "packages" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectsQuery) WithPackages(opts ...func(*PackagesQuery)) *ProjectsQuery {
+	query := (&PackagesClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withPackages = query
+	return pq
+}
+
+// WithStacks tells the query-builder to eager-load the nodes that are connected to
+// the "stacks" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectsQuery) WithStacks(opts ...func(*StacksQuery)) *ProjectsQuery {
+	query := (&StacksClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withStacks = query
+	return pq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectsQuery) WithRevisions(opts ...func(*ProjectRevisionsQuery)) *ProjectsQuery {
+	query := (&ProjectRevisionsClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withRevisions = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Projects.Query().
-//		GroupBy(projects.FieldName).
+//		GroupBy(projects.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pq *ProjectsQuery) GroupBy(field string, fields ...string) *ProjectsGroupBy {
@@ -285,11 +431,11 @@ func (pq *ProjectsQuery) GroupBy(field string, fields ...string) *ProjectsGroupB
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Projects.Query().
-//		Select(projects.FieldName).
+//		Select(projects.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (pq *ProjectsQuery) Select(fields ...string) *ProjectsSelect {
 	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
@@ -332,15 +478,29 @@ func (pq *ProjectsQuery) prepareQuery(ctx context.Context) error {
 
 func (pq *ProjectsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Projects, error) {
 	var (
-		nodes = []*Projects{}
-		_spec = pq.querySpec()
+		nodes       = []*Projects{}
+		withFKs     = pq.withFKs
+		_spec       = pq.querySpec()
+		loadedTypes = [4]bool{
+			pq.withClient != nil,
+			pq.withPackages != nil,
+			pq.withStacks != nil,
+			pq.withRevisions != nil,
+		}
 	)
+	if pq.withClient != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, projects.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Projects).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Projects{config: pq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +512,221 @@ func (pq *ProjectsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pro
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := pq.withClient; query != nil {
+		if err := pq.loadClient(ctx, query, nodes, nil,
+			func(n *Projects, e *Clients) { n.Edges.Client = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withPackages; query != nil {
+		if err := pq.loadPackages(ctx, query, nodes,
+			func(n *Projects) { n.Edges.Packages = []*Packages{} },
+			func(n *Projects, e *Packages) { n.Edges.Packages = append(n.Edges.Packages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withStacks; query != nil {
+		if err := pq.loadStacks(ctx, query, nodes,
+			func(n *Projects) { n.Edges.Stacks = []*Stacks{} },
+			func(n *Projects, e *Stacks) { n.Edges.Stacks = append(n.Edges.Stacks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withRevisions; query != nil {
+		if err := pq.loadRevisions(ctx, query, nodes,
+			func(n *Projects) { n.Edges.Revisions = []*ProjectRevisions{} },
+			func(n *Projects, e *ProjectRevisions) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (pq *ProjectsQuery) loadClient(ctx context.Context, query *ClientsQuery, nodes []*Projects, init func(*Projects), assign func(*Projects, *Clients)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Projects)
+	for i := range nodes {
+		if nodes[i].clients_projects == nil {
+			continue
+		}
+		fk := *nodes[i].clients_projects
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(clients.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "clients_projects" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pq *ProjectsQuery) loadPackages(ctx context.Context, query *PackagesQuery, nodes []*Projects, init func(*Projects), assign func(*Projects, *Packages)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Projects)
+	nids := make(map[int]map[*Projects]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(projects.PackagesTable)
+		s.Join(joinT).On(s.C(packages.FieldID), joinT.C(projects.PackagesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(projects.PackagesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(projects.PackagesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Projects]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Packages](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "packages" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *ProjectsQuery) loadStacks(ctx context.Context, query *StacksQuery, nodes []*Projects, init func(*Projects), assign func(*Projects, *Stacks)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Projects)
+	nids := make(map[int]map[*Projects]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(projects.StacksTable)
+		s.Join(joinT).On(s.C(stacks.FieldID), joinT.C(projects.StacksPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(projects.StacksPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(projects.StacksPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Projects]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Stacks](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "stacks" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *ProjectsQuery) loadRevisions(ctx context.Context, query *ProjectRevisionsQuery, nodes []*Projects, init func(*Projects), assign func(*Projects, *ProjectRevisions)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Projects)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(projectrevisions.FieldProjectID)
+	}
+	query.Where(predicate.ProjectRevisions(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(projects.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (pq *ProjectsQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
 	_spec.Node.Columns = pq.ctx.Fields
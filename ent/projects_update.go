@@ -6,8 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
 	"project-manager/ent/predicate"
+	"project-manager/ent/projectrevisions"
 	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -27,6 +32,53 @@ func (pu *ProjectsUpdate) Where(ps ...predicate.Projects) *ProjectsUpdate {
 	return pu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *ProjectsUpdate) SetUpdatedAt(t time.Time) *ProjectsUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetVersion sets the "version" field.
+func (pu *ProjectsUpdate) SetVersion(i int) *ProjectsUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *ProjectsUpdate) SetNillableVersion(i *int) *ProjectsUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *ProjectsUpdate) AddVersion(i int) *ProjectsUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *ProjectsUpdate) SetDeletedAt(t time.Time) *ProjectsUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *ProjectsUpdate) SetNillableDeletedAt(t *time.Time) *ProjectsUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *ProjectsUpdate) ClearDeletedAt() *ProjectsUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
 // SetName sets the "name" field.
 func (pu *ProjectsUpdate) SetName(s string) *ProjectsUpdate {
 	pu.mutation.SetName(s)
@@ -101,27 +153,149 @@ func (pu *ProjectsUpdate) ClearDescription() *ProjectsUpdate {
 	return pu
 }
 
-// SetStacks sets the "stacks" field.
-func (pu *ProjectsUpdate) SetStacks(s string) *ProjectsUpdate {
-	pu.mutation.SetStacks(s)
+// SetClientID sets the "client" edge to the Clients entity by ID.
+func (pu *ProjectsUpdate) SetClientID(id int) *ProjectsUpdate {
+	pu.mutation.SetClientID(id)
 	return pu
 }
 
-// SetNillableStacks sets the "stacks" field if the given value is not nil.
-func (pu *ProjectsUpdate) SetNillableStacks(s *string) *ProjectsUpdate {
-	if s != nil {
-		pu.SetStacks(*s)
+// SetNillableClientID sets the "client" edge to the Clients entity by ID if the given value is not nil.
+func (pu *ProjectsUpdate) SetNillableClientID(id *int) *ProjectsUpdate {
+	if id != nil {
+		pu = pu.SetClientID(*id)
+	}
+	return pu
+}
+
+// SetClient sets the "client" edge to the Clients entity.
+func (pu *ProjectsUpdate) SetClient(c *Clients) *ProjectsUpdate {
+	return pu.SetClientID(c.ID)
+}
+
+// AddPackageIDs adds the "packages" edge to the Packages entity by IDs.
+func (pu *ProjectsUpdate) AddPackageIDs(ids ...int) *ProjectsUpdate {
+	pu.mutation.AddPackageIDs(ids...)
+	return pu
+}
+
+// AddPackages adds the "packages" edges to the Packages entity.
+func (pu *ProjectsUpdate) AddPackages(p ...*Packages) *ProjectsUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return pu.AddPackageIDs(ids...)
+}
+
+// AddStackIDs adds the "stacks" edge to the Stacks entity by IDs.
+func (pu *ProjectsUpdate) AddStackIDs(ids ...int) *ProjectsUpdate {
+	pu.mutation.AddStackIDs(ids...)
 	return pu
 }
 
+// AddStacks adds the "stacks" edges to the Stacks entity.
+func (pu *ProjectsUpdate) AddStacks(s ...*Stacks) *ProjectsUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddStackIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ProjectRevisions entity by IDs.
+func (pu *ProjectsUpdate) AddRevisionIDs(ids ...int) *ProjectsUpdate {
+	pu.mutation.AddRevisionIDs(ids...)
+	return pu
+}
+
+// AddRevisions adds the "revisions" edges to the ProjectRevisions entity.
+func (pu *ProjectsUpdate) AddRevisions(p ...*ProjectRevisions) *ProjectsUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the ProjectsMutation object of the builder.
 func (pu *ProjectsUpdate) Mutation() *ProjectsMutation {
 	return pu.mutation
 }
 
+// ClearClient clears the "client" edge to the Clients entity.
+func (pu *ProjectsUpdate) ClearClient() *ProjectsUpdate {
+	pu.mutation.ClearClient()
+	return pu
+}
+
+// ClearPackages clears all "packages" edges to the Packages entity.
+func (pu *ProjectsUpdate) ClearPackages() *ProjectsUpdate {
+	pu.mutation.ClearPackages()
+	return pu
+}
+
+// RemovePackageIDs removes the "packages" edge to Packages entities by IDs.
+func (pu *ProjectsUpdate) RemovePackageIDs(ids ...int) *ProjectsUpdate {
+	pu.mutation.RemovePackageIDs(ids...)
+	return pu
+}
+
+// RemovePackages removes "packages" edges to Packages entities.
+func (pu *ProjectsUpdate) RemovePackages(p ...*Packages) *ProjectsUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemovePackageIDs(ids...)
+}
+
+// ClearStacks clears all "stacks" edges to the Stacks entity.
+func (pu *ProjectsUpdate) ClearStacks() *ProjectsUpdate {
+	pu.mutation.ClearStacks()
+	return pu
+}
+
+// RemoveStackIDs removes the "stacks" edge to Stacks entities by IDs.
+func (pu *ProjectsUpdate) RemoveStackIDs(ids ...int) *ProjectsUpdate {
+	pu.mutation.RemoveStackIDs(ids...)
+	return pu
+}
+
+// RemoveStacks removes "stacks" edges to Stacks entities.
+func (pu *ProjectsUpdate) RemoveStacks(s ...*Stacks) *ProjectsUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveStackIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the ProjectRevisions entity.
+func (pu *ProjectsUpdate) ClearRevisions() *ProjectsUpdate {
+	pu.mutation.ClearRevisions()
+	return pu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ProjectRevisions entities by IDs.
+func (pu *ProjectsUpdate) RemoveRevisionIDs(ids ...int) *ProjectsUpdate {
+	pu.mutation.RemoveRevisionIDs(ids...)
+	return pu
+}
+
+// RemoveRevisions removes "revisions" edges to ProjectRevisions entities.
+func (pu *ProjectsUpdate) RemoveRevisions(p ...*ProjectRevisions) *ProjectsUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveRevisionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProjectsUpdate) Save(ctx context.Context) (int, error) {
+	if err := pu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
 }
 
@@ -147,8 +321,25 @@ func (pu *ProjectsUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (pu *ProjectsUpdate) defaults() error {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		if projects.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized projects.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := projects.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (pu *ProjectsUpdate) check() error {
+	if v, ok := pu.mutation.Version(); ok {
+		if err := projects.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Projects.version": %w`, err)}
+		}
+	}
 	if v, ok := pu.mutation.Name(); ok {
 		if err := projects.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Projects.name": %w`, err)}
@@ -174,6 +365,21 @@ func (pu *ProjectsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(projects.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(projects.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(projects.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(projects.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(projects.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.SetField(projects.FieldName, field.TypeString, value)
 	}
@@ -195,8 +401,169 @@ func (pu *ProjectsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.DescriptionCleared() {
 		_spec.ClearField(projects.FieldDescription, field.TypeString)
 	}
-	if value, ok := pu.mutation.Stacks(); ok {
-		_spec.SetField(projects.FieldStacks, field.TypeString, value)
+	if pu.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projects.ClientTable,
+			Columns: []string{projects.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clients.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projects.ClientTable,
+			Columns: []string{projects.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clients.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   projects.PackagesTable,
+			Columns: projects.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPackagesIDs(); len(nodes) > 0 && !pu.mutation.PackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   projects.PackagesTable,
+			Columns: projects.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   projects.PackagesTable,
+			Columns: projects.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.StacksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   projects.StacksTable,
+			Columns: projects.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedStacksIDs(); len(nodes) > 0 && !pu.mutation.StacksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   projects.StacksTable,
+			Columns: projects.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.StacksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   projects.StacksTable,
+			Columns: projects.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projects.RevisionsTable,
+			Columns: []string{projects.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !pu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projects.RevisionsTable,
+			Columns: []string{projects.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projects.RevisionsTable,
+			Columns: []string{projects.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -218,6 +585,53 @@ type ProjectsUpdateOne struct {
 	mutation *ProjectsMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *ProjectsUpdateOne) SetUpdatedAt(t time.Time) *ProjectsUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetVersion sets the "version" field.
+func (puo *ProjectsUpdateOne) SetVersion(i int) *ProjectsUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *ProjectsUpdateOne) SetNillableVersion(i *int) *ProjectsUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *ProjectsUpdateOne) AddVersion(i int) *ProjectsUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *ProjectsUpdateOne) SetDeletedAt(t time.Time) *ProjectsUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *ProjectsUpdateOne) SetNillableDeletedAt(t *time.Time) *ProjectsUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *ProjectsUpdateOne) ClearDeletedAt() *ProjectsUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
 // SetName sets the "name" field.
 func (puo *ProjectsUpdateOne) SetName(s string) *ProjectsUpdateOne {
 	puo.mutation.SetName(s)
@@ -292,25 +706,144 @@ func (puo *ProjectsUpdateOne) ClearDescription() *ProjectsUpdateOne {
 	return puo
 }
 
-// SetStacks sets the "stacks" field.
-func (puo *ProjectsUpdateOne) SetStacks(s string) *ProjectsUpdateOne {
-	puo.mutation.SetStacks(s)
+// SetClientID sets the "client" edge to the Clients entity by ID.
+func (puo *ProjectsUpdateOne) SetClientID(id int) *ProjectsUpdateOne {
+	puo.mutation.SetClientID(id)
 	return puo
 }
 
-// SetNillableStacks sets the "stacks" field if the given value is not nil.
-func (puo *ProjectsUpdateOne) SetNillableStacks(s *string) *ProjectsUpdateOne {
-	if s != nil {
-		puo.SetStacks(*s)
+// SetNillableClientID sets the "client" edge to the Clients entity by ID if the given value is not nil.
+func (puo *ProjectsUpdateOne) SetNillableClientID(id *int) *ProjectsUpdateOne {
+	if id != nil {
+		puo = puo.SetClientID(*id)
+	}
+	return puo
+}
+
+// SetClient sets the "client" edge to the Clients entity.
+func (puo *ProjectsUpdateOne) SetClient(c *Clients) *ProjectsUpdateOne {
+	return puo.SetClientID(c.ID)
+}
+
+// AddPackageIDs adds the "packages" edge to the Packages entity by IDs.
+func (puo *ProjectsUpdateOne) AddPackageIDs(ids ...int) *ProjectsUpdateOne {
+	puo.mutation.AddPackageIDs(ids...)
+	return puo
+}
+
+// AddPackages adds the "packages" edges to the Packages entity.
+func (puo *ProjectsUpdateOne) AddPackages(p ...*Packages) *ProjectsUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
+	return puo.AddPackageIDs(ids...)
+}
+
+// AddStackIDs adds the "stacks" edge to the Stacks entity by IDs.
+func (puo *ProjectsUpdateOne) AddStackIDs(ids ...int) *ProjectsUpdateOne {
+	puo.mutation.AddStackIDs(ids...)
 	return puo
 }
 
+// AddStacks adds the "stacks" edges to the Stacks entity.
+func (puo *ProjectsUpdateOne) AddStacks(s ...*Stacks) *ProjectsUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddStackIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ProjectRevisions entity by IDs.
+func (puo *ProjectsUpdateOne) AddRevisionIDs(ids ...int) *ProjectsUpdateOne {
+	puo.mutation.AddRevisionIDs(ids...)
+	return puo
+}
+
+// AddRevisions adds the "revisions" edges to the ProjectRevisions entity.
+func (puo *ProjectsUpdateOne) AddRevisions(p ...*ProjectRevisions) *ProjectsUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the ProjectsMutation object of the builder.
 func (puo *ProjectsUpdateOne) Mutation() *ProjectsMutation {
 	return puo.mutation
 }
 
+// ClearClient clears the "client" edge to the Clients entity.
+func (puo *ProjectsUpdateOne) ClearClient() *ProjectsUpdateOne {
+	puo.mutation.ClearClient()
+	return puo
+}
+
+// ClearPackages clears all "packages" edges to the Packages entity.
+func (puo *ProjectsUpdateOne) ClearPackages() *ProjectsUpdateOne {
+	puo.mutation.ClearPackages()
+	return puo
+}
+
+// RemovePackageIDs removes the "packages" edge to Packages entities by IDs.
+func (puo *ProjectsUpdateOne) RemovePackageIDs(ids ...int) *ProjectsUpdateOne {
+	puo.mutation.RemovePackageIDs(ids...)
+	return puo
+}
+
+// RemovePackages removes "packages" edges to Packages entities.
+func (puo *ProjectsUpdateOne) RemovePackages(p ...*Packages) *ProjectsUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemovePackageIDs(ids...)
+}
+
+// ClearStacks clears all "stacks" edges to the Stacks entity.
+func (puo *ProjectsUpdateOne) ClearStacks() *ProjectsUpdateOne {
+	puo.mutation.ClearStacks()
+	return puo
+}
+
+// RemoveStackIDs removes the "stacks" edge to Stacks entities by IDs.
+func (puo *ProjectsUpdateOne) RemoveStackIDs(ids ...int) *ProjectsUpdateOne {
+	puo.mutation.RemoveStackIDs(ids...)
+	return puo
+}
+
+// RemoveStacks removes "stacks" edges to Stacks entities.
+func (puo *ProjectsUpdateOne) RemoveStacks(s ...*Stacks) *ProjectsUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveStackIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the ProjectRevisions entity.
+func (puo *ProjectsUpdateOne) ClearRevisions() *ProjectsUpdateOne {
+	puo.mutation.ClearRevisions()
+	return puo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ProjectRevisions entities by IDs.
+func (puo *ProjectsUpdateOne) RemoveRevisionIDs(ids ...int) *ProjectsUpdateOne {
+	puo.mutation.RemoveRevisionIDs(ids...)
+	return puo
+}
+
+// RemoveRevisions removes "revisions" edges to ProjectRevisions entities.
+func (puo *ProjectsUpdateOne) RemoveRevisions(p ...*ProjectRevisions) *ProjectsUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveRevisionIDs(ids...)
+}
+
 // Where appends a list predicates to the ProjectsUpdate builder.
 func (puo *ProjectsUpdateOne) Where(ps ...predicate.Projects) *ProjectsUpdateOne {
 	puo.mutation.Where(ps...)
@@ -326,6 +859,9 @@ func (puo *ProjectsUpdateOne) Select(field string, fields ...string) *ProjectsUp
 
 // Save executes the query and returns the updated Projects entity.
 func (puo *ProjectsUpdateOne) Save(ctx context.Context) (*Projects, error) {
+	if err := puo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
 }
 
@@ -351,8 +887,25 @@ func (puo *ProjectsUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (puo *ProjectsUpdateOne) defaults() error {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		if projects.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized projects.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := projects.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (puo *ProjectsUpdateOne) check() error {
+	if v, ok := puo.mutation.Version(); ok {
+		if err := projects.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Projects.version": %w`, err)}
+		}
+	}
 	if v, ok := puo.mutation.Name(); ok {
 		if err := projects.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Projects.name": %w`, err)}
@@ -395,6 +948,21 @@ func (puo *ProjectsUpdateOne) sqlSave(ctx context.Context) (_node *Projects, err
 			}
 		}
 	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(projects.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(projects.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(projects.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(projects.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(projects.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.SetField(projects.FieldName, field.TypeString, value)
 	}
@@ -416,8 +984,169 @@ func (puo *ProjectsUpdateOne) sqlSave(ctx context.Context) (_node *Projects, err
 	if puo.mutation.DescriptionCleared() {
 		_spec.ClearField(projects.FieldDescription, field.TypeString)
 	}
-	if value, ok := puo.mutation.Stacks(); ok {
-		_spec.SetField(projects.FieldStacks, field.TypeString, value)
+	if puo.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projects.ClientTable,
+			Columns: []string{projects.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clients.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projects.ClientTable,
+			Columns: []string{projects.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clients.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.PackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   projects.PackagesTable,
+			Columns: projects.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedPackagesIDs(); len(nodes) > 0 && !puo.mutation.PackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   projects.PackagesTable,
+			Columns: projects.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.PackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   projects.PackagesTable,
+			Columns: projects.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.StacksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   projects.StacksTable,
+			Columns: projects.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedStacksIDs(); len(nodes) > 0 && !puo.mutation.StacksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   projects.StacksTable,
+			Columns: projects.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.StacksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   projects.StacksTable,
+			Columns: projects.StacksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projects.RevisionsTable,
+			Columns: []string{projects.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !puo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projects.RevisionsTable,
+			Columns: []string{projects.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projects.RevisionsTable,
+			Columns: []string{projects.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevisions.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &Projects{config: puo.config}
 	_spec.Assign = _node.assignValues
@@ -2,7 +2,271 @@
 
 package runtime
 
-// The schema-stitching logic is generated in project-manager/ent/runtime.go
+import (
+	"project-manager/ent/auditevents"
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
+	"project-manager/ent/projectrevisions"
+	"project-manager/ent/projects"
+	"project-manager/ent/schema"
+	"project-manager/ent/stacks"
+	"project-manager/ent/users"
+	"time"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	auditeventsFields := schema.AuditEvents{}.Fields()
+	_ = auditeventsFields
+	// auditeventsDescEntityType is the schema descriptor for entity_type field.
+	auditeventsDescEntityType := auditeventsFields[0].Descriptor()
+	// auditevents.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditevents.EntityTypeValidator = auditeventsDescEntityType.Validators[0].(func(string) error)
+	// auditeventsDescCreatedAt is the schema descriptor for created_at field.
+	auditeventsDescCreatedAt := auditeventsFields[5].Descriptor()
+	// auditevents.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevents.DefaultCreatedAt = auditeventsDescCreatedAt.Default.(func() time.Time)
+	clientsMixin := schema.Clients{}.Mixin()
+	clientsMixinHooks2 := clientsMixin[2].Hooks()
+	clients.Hooks[0] = clientsMixinHooks2[0]
+	clients.Hooks[1] = clientsMixinHooks2[1]
+	clientsMixinInters2 := clientsMixin[2].Interceptors()
+	clients.Interceptors[0] = clientsMixinInters2[0]
+	clientsMixinFields0 := clientsMixin[0].Fields()
+	_ = clientsMixinFields0
+	clientsMixinFields1 := clientsMixin[1].Fields()
+	_ = clientsMixinFields1
+	clientsFields := schema.Clients{}.Fields()
+	_ = clientsFields
+	// clientsDescCreatedAt is the schema descriptor for created_at field.
+	clientsDescCreatedAt := clientsMixinFields0[0].Descriptor()
+	// clients.DefaultCreatedAt holds the default value on creation for the created_at field.
+	clients.DefaultCreatedAt = clientsDescCreatedAt.Default.(func() time.Time)
+	// clientsDescUpdatedAt is the schema descriptor for updated_at field.
+	clientsDescUpdatedAt := clientsMixinFields0[1].Descriptor()
+	// clients.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	clients.DefaultUpdatedAt = clientsDescUpdatedAt.Default.(func() time.Time)
+	// clients.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	clients.UpdateDefaultUpdatedAt = clientsDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// clientsDescVersion is the schema descriptor for version field.
+	clientsDescVersion := clientsMixinFields1[0].Descriptor()
+	// clients.DefaultVersion holds the default value on creation for the version field.
+	clients.DefaultVersion = clientsDescVersion.Default.(int)
+	// clients.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	clients.VersionValidator = clientsDescVersion.Validators[0].(func(int) error)
+	// clientsDescName is the schema descriptor for name field.
+	clientsDescName := clientsFields[0].Descriptor()
+	// clients.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	clients.NameValidator = func() func(string) error {
+		validators := clientsDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	packagesMixin := schema.Packages{}.Mixin()
+	packagesMixinHooks2 := packagesMixin[2].Hooks()
+	packages.Hooks[0] = packagesMixinHooks2[0]
+	packages.Hooks[1] = packagesMixinHooks2[1]
+	packagesMixinInters2 := packagesMixin[2].Interceptors()
+	packages.Interceptors[0] = packagesMixinInters2[0]
+	packagesMixinFields0 := packagesMixin[0].Fields()
+	_ = packagesMixinFields0
+	packagesMixinFields1 := packagesMixin[1].Fields()
+	_ = packagesMixinFields1
+	packagesFields := schema.Packages{}.Fields()
+	_ = packagesFields
+	// packagesDescCreatedAt is the schema descriptor for created_at field.
+	packagesDescCreatedAt := packagesMixinFields0[0].Descriptor()
+	// packages.DefaultCreatedAt holds the default value on creation for the created_at field.
+	packages.DefaultCreatedAt = packagesDescCreatedAt.Default.(func() time.Time)
+	// packagesDescUpdatedAt is the schema descriptor for updated_at field.
+	packagesDescUpdatedAt := packagesMixinFields0[1].Descriptor()
+	// packages.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	packages.DefaultUpdatedAt = packagesDescUpdatedAt.Default.(func() time.Time)
+	// packages.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	packages.UpdateDefaultUpdatedAt = packagesDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// packagesDescVersion is the schema descriptor for version field.
+	packagesDescVersion := packagesMixinFields1[0].Descriptor()
+	// packages.DefaultVersion holds the default value on creation for the version field.
+	packages.DefaultVersion = packagesDescVersion.Default.(int)
+	// packages.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	packages.VersionValidator = packagesDescVersion.Validators[0].(func(int) error)
+	// packagesDescName is the schema descriptor for name field.
+	packagesDescName := packagesFields[0].Descriptor()
+	// packages.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	packages.NameValidator = func() func(string) error {
+		validators := packagesDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// packagesDescDescription is the schema descriptor for description field.
+	packagesDescDescription := packagesFields[2].Descriptor()
+	// packages.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	packages.DescriptionValidator = packagesDescDescription.Validators[0].(func(string) error)
+	projectrevisionsFields := schema.ProjectRevisions{}.Fields()
+	_ = projectrevisionsFields
+	// projectrevisionsDescRevision is the schema descriptor for revision field.
+	projectrevisionsDescRevision := projectrevisionsFields[1].Descriptor()
+	// projectrevisions.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	projectrevisions.RevisionValidator = projectrevisionsDescRevision.Validators[0].(func(int) error)
+	// projectrevisionsDescCreatedAt is the schema descriptor for created_at field.
+	projectrevisionsDescCreatedAt := projectrevisionsFields[9].Descriptor()
+	// projectrevisions.DefaultCreatedAt holds the default value on creation for the created_at field.
+	projectrevisions.DefaultCreatedAt = projectrevisionsDescCreatedAt.Default.(func() time.Time)
+	projectsMixin := schema.Projects{}.Mixin()
+	projectsMixinHooks2 := projectsMixin[2].Hooks()
+	projects.Hooks[0] = projectsMixinHooks2[0]
+	projects.Hooks[1] = projectsMixinHooks2[1]
+	projectsMixinInters2 := projectsMixin[2].Interceptors()
+	projects.Interceptors[0] = projectsMixinInters2[0]
+	projectsMixinFields0 := projectsMixin[0].Fields()
+	_ = projectsMixinFields0
+	projectsMixinFields1 := projectsMixin[1].Fields()
+	_ = projectsMixinFields1
+	projectsFields := schema.Projects{}.Fields()
+	_ = projectsFields
+	// projectsDescCreatedAt is the schema descriptor for created_at field.
+	projectsDescCreatedAt := projectsMixinFields0[0].Descriptor()
+	// projects.DefaultCreatedAt holds the default value on creation for the created_at field.
+	projects.DefaultCreatedAt = projectsDescCreatedAt.Default.(func() time.Time)
+	// projectsDescUpdatedAt is the schema descriptor for updated_at field.
+	projectsDescUpdatedAt := projectsMixinFields0[1].Descriptor()
+	// projects.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	projects.DefaultUpdatedAt = projectsDescUpdatedAt.Default.(func() time.Time)
+	// projects.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	projects.UpdateDefaultUpdatedAt = projectsDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// projectsDescVersion is the schema descriptor for version field.
+	projectsDescVersion := projectsMixinFields1[0].Descriptor()
+	// projects.DefaultVersion holds the default value on creation for the version field.
+	projects.DefaultVersion = projectsDescVersion.Default.(int)
+	// projects.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	projects.VersionValidator = projectsDescVersion.Validators[0].(func(int) error)
+	// projectsDescName is the schema descriptor for name field.
+	projectsDescName := projectsFields[0].Descriptor()
+	// projects.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	projects.NameValidator = projectsDescName.Validators[0].(func(string) error)
+	// projectsDescDescription is the schema descriptor for description field.
+	projectsDescDescription := projectsFields[3].Descriptor()
+	// projects.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	projects.DescriptionValidator = projectsDescDescription.Validators[0].(func(string) error)
+	stacksMixin := schema.Stacks{}.Mixin()
+	stacksMixinFields0 := stacksMixin[0].Fields()
+	_ = stacksMixinFields0
+	stacksFields := schema.Stacks{}.Fields()
+	_ = stacksFields
+	// stacksDescCreatedAt is the schema descriptor for created_at field.
+	stacksDescCreatedAt := stacksMixinFields0[0].Descriptor()
+	// stacks.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stacks.DefaultCreatedAt = stacksDescCreatedAt.Default.(func() time.Time)
+	// stacksDescUpdatedAt is the schema descriptor for updated_at field.
+	stacksDescUpdatedAt := stacksMixinFields0[1].Descriptor()
+	// stacks.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	stacks.DefaultUpdatedAt = stacksDescUpdatedAt.Default.(func() time.Time)
+	// stacks.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	stacks.UpdateDefaultUpdatedAt = stacksDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// stacksDescName is the schema descriptor for name field.
+	stacksDescName := stacksFields[0].Descriptor()
+	// stacks.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	stacks.NameValidator = func() func(string) error {
+		validators := stacksDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// stacksDescSlug is the schema descriptor for slug field.
+	stacksDescSlug := stacksFields[1].Descriptor()
+	// stacks.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	stacks.SlugValidator = func() func(string) error {
+		validators := stacksDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// stacksDescCategory is the schema descriptor for category field.
+	stacksDescCategory := stacksFields[2].Descriptor()
+	// stacks.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	stacks.CategoryValidator = stacksDescCategory.Validators[0].(func(string) error)
+	usersMixin := schema.Users{}.Mixin()
+	usersMixinFields0 := usersMixin[0].Fields()
+	_ = usersMixinFields0
+	usersFields := schema.Users{}.Fields()
+	_ = usersFields
+	// usersDescCreatedAt is the schema descriptor for created_at field.
+	usersDescCreatedAt := usersMixinFields0[0].Descriptor()
+	// users.DefaultCreatedAt holds the default value on creation for the created_at field.
+	users.DefaultCreatedAt = usersDescCreatedAt.Default.(func() time.Time)
+	// usersDescUpdatedAt is the schema descriptor for updated_at field.
+	usersDescUpdatedAt := usersMixinFields0[1].Descriptor()
+	// users.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	users.DefaultUpdatedAt = usersDescUpdatedAt.Default.(func() time.Time)
+	// users.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	users.UpdateDefaultUpdatedAt = usersDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usersDescEmail is the schema descriptor for email field.
+	usersDescEmail := usersFields[0].Descriptor()
+	// users.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	users.EmailValidator = func() func(string) error {
+		validators := usersDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// usersDescName is the schema descriptor for name field.
+	usersDescName := usersFields[1].Descriptor()
+	// users.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	users.NameValidator = usersDescName.Validators[0].(func(string) error)
+	// usersDescPasswordHash is the schema descriptor for password_hash field.
+	usersDescPasswordHash := usersFields[2].Descriptor()
+	// users.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	users.PasswordHashValidator = usersDescPasswordHash.Validators[0].(func(string) error)
+}
 
 const (
 	Version = "v0.14.1"                                         // Version of ent codegen.
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"project-manager/internal/models"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvents holds the schema definition for the AuditEvents entity.
+// Rows are written by the audit hook and never updated.
+type AuditEvents struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvents.
+func (AuditEvents) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entity_type").
+			NotEmpty().
+			Immutable().
+			Comment("The kind of entity that changed: project, package or client"),
+		field.Int("entity_id").
+			Immutable().
+			Comment("The ID of the entity that changed"),
+		field.Enum("operation").
+			Values("create", "update", "delete", "restore").
+			Immutable().
+			Comment("What happened to the entity"),
+		field.Int("actor_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The user who made the change; NULL for system jobs such as purge"),
+		field.JSON("changes", map[string]models.FieldChange{}).
+			Optional().
+			Immutable().
+			Comment("The before and after value of every field that changed"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time the change was made"),
+	}
+}
+
+// Indexes of the AuditEvents.
+func (AuditEvents) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("actor_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -1,10 +1,11 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Clients holds the schema definition for the Clients entity.
@@ -17,19 +18,38 @@ func (Clients) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").
 			NotEmpty().
-			Unique().
 			MaxLen(100).
 			Comment("The name of the package"),
 		field.String("link").
 			Optional().
 			Comment("The link to the package"),
 		field.String("imageUrl").Optional().Comment("The image URL of the client"),
-		field.Time("created_at").
-			Default(time.Now).
-			Comment("The time the package was created"),
-		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now).
-			Comment("The time the package was last updated"),
+	}
+}
+
+// Indexes of the Clients.
+func (Clients) Indexes() []ent.Index {
+	return []ent.Index{
+		// Names are unique among live rows only, so that a trashed name can be reused
+		index.Fields("name").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+	}
+}
+
+// Mixin of the Clients.
+func (Clients) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		VersionMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
+// Edges of the Clients.
+func (Clients) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("projects", Projects.Type).
+			Comment("The projects built for the client"),
 	}
 }
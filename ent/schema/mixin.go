@@ -0,0 +1,151 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gen "project-manager/ent"
+	"project-manager/ent/hook"
+	"project-manager/ent/intercept"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TimeMixin adds created_at and updated_at, maintained by ent on every write
+type TimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TimeMixin.
+func (TimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time the row was created"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("The time the row was last updated"),
+	}
+}
+
+// VersionMixin adds a version counter used for optimistic concurrency.
+// Every write bumps it, and it is exposed to clients as the ETag.
+type VersionMixin struct {
+	mixin.Schema
+}
+
+// Fields of the VersionMixin.
+func (VersionMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").
+			Default(1).
+			Positive().
+			Comment("The revision counter compared against If-Match on writes"),
+	}
+}
+
+type softDeleteKey struct{}
+
+// SkipSoftDelete returns a context on which soft-deleted rows are visible to
+// queries and deletes remove rows for good. Trash, restore and purge use it.
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+func skipSoftDelete(ctx context.Context) bool {
+	skip, _ := ctx.Value(softDeleteKey{}).(bool)
+	return skip
+}
+
+// SoftDeleteMixin turns deletes into setting deleted_at, and hides rows with
+// deleted_at set from queries and updates
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("When the row was moved to the trash; NULL while it is live"),
+	}
+}
+
+// Indexes of the SoftDeleteMixin.
+func (SoftDeleteMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
+	}
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (d SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if !skipSoftDelete(ctx) {
+				d.whereLive(q)
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the SoftDeleteMixin.
+func (d SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(d.softDelete, ent.OpDelete|ent.OpDeleteOne),
+		hook.On(d.liveOnly, ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// softDelete rewrites a delete into an update that stamps deleted_at
+func (d SoftDeleteMixin) softDelete(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if skipSoftDelete(ctx) {
+			return next.Mutate(ctx, m)
+		}
+		mx, ok := m.(interface {
+			SetOp(ent.Op)
+			Client() *gen.Client
+			SetDeletedAt(time.Time)
+			WhereP(...func(*sql.Selector))
+		})
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		d.whereLive(mx)
+		mx.SetOp(ent.OpUpdate)
+		mx.SetDeletedAt(time.Now())
+		return mx.Client().Mutate(ctx, m)
+	})
+}
+
+// liveOnly keeps updates from touching rows in the trash
+func (d SoftDeleteMixin) liveOnly(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if skipSoftDelete(ctx) {
+			return next.Mutate(ctx, m)
+		}
+		mx, ok := m.(interface {
+			WhereP(...func(*sql.Selector))
+		})
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		d.whereLive(mx)
+		return next.Mutate(ctx, m)
+	})
+}
+
+func (d SoftDeleteMixin) whereLive(w interface{ WhereP(...func(*sql.Selector)) }) {
+	w.WhereP(sql.FieldIsNull(d.Fields()[0].Descriptor().Name))
+}
@@ -1,10 +1,11 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Packages holds the schema definition for the Packages entity.
@@ -17,7 +18,6 @@ func (Packages) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").
 			NotEmpty().
-			Unique().
 			MaxLen(100).
 			Comment("The name of the package"),
 		field.String("link").
@@ -27,13 +27,36 @@ func (Packages) Fields() []ent.Field {
 			Optional().
 			MaxLen(1000).
 			Comment("A brief description of the package"),
-		field.String("stacks").Default("[]"),
-		field.Time("created_at").
-			Default(time.Now).
-			Comment("The time the package was created"),
-		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now).
-			Comment("The time the package was last updated"),
+	}
+}
+
+// Indexes of the Packages.
+func (Packages) Indexes() []ent.Index {
+	return []ent.Index{
+		// Names are unique among live rows only, so that a trashed name can be reused
+		index.Fields("name").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+	}
+}
+
+// Mixin of the Packages.
+func (Packages) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		VersionMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
+// Edges of the Packages.
+func (Packages) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("projects", Projects.Type).
+			Ref("packages").
+			Comment("The projects that use the package"),
+		edge.From("stacks", Stacks.Type).
+			Ref("packages").
+			Comment("The technologies the package is built with"),
 	}
 }
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProjectRevisions holds the schema definition for the ProjectRevisions entity.
+// Each row is a snapshot of a project as saved at one version.
+type ProjectRevisions struct {
+	ent.Schema
+}
+
+// Fields of the ProjectRevisions.
+func (ProjectRevisions) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			Immutable().
+			Comment("The project the snapshot belongs to"),
+		field.Int("revision").
+			Positive().
+			Immutable().
+			Comment("The project version the snapshot was taken at"),
+		field.String("name").
+			Immutable(),
+		field.String("imageUrl").
+			Optional().
+			Immutable(),
+		field.String("link").
+			Optional().
+			Immutable(),
+		field.String("description").
+			Optional().
+			Immutable(),
+		field.JSON("stacks", []string{}).
+			Optional().
+			Immutable().
+			Comment("The names of the project's stacks"),
+		field.Int("client_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The ID of the project's client"),
+		field.JSON("package_ids", []int{}).
+			Optional().
+			Immutable().
+			Comment("The IDs of the project's packages"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time the revision was saved"),
+	}
+}
+
+// Edges of the ProjectRevisions.
+func (ProjectRevisions) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Projects.Type).
+			Ref("revisions").
+			Field("project_id").
+			Unique().
+			Required().
+			Immutable().
+			Comment("The project the snapshot belongs to"),
+	}
+}
+
+// Indexes of the ProjectRevisions.
+func (ProjectRevisions) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "revision").
+			Unique(),
+	}
+}
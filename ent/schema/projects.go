@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -18,13 +20,32 @@ func (Projects) Fields() []ent.Field {
 			Optional().
 			MaxLen(1000).
 			Comment("A brief description of the package"),
-		field.String("stacks").Default("[]"),
-		// field.Time("created_at").
-		// 	Default(time.Now).
-		// 	Comment("The time the package was created"),
-		// field.Time("updated_at").
-		// 	Default(time.Now).
-		// 	UpdateDefault(time.Now).
-		// 	Comment("The time the package was last updated"),
+	}
+}
+
+// Mixin of the Projects.
+func (Projects) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		VersionMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
+// Edges of the Projects.
+func (Projects) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("client", Clients.Type).
+			Ref("projects").
+			Unique().
+			Comment("The client the project was built for"),
+		edge.To("packages", Packages.Type).
+			Comment("The packages used by the project"),
+		edge.From("stacks", Stacks.Type).
+			Ref("projects").
+			Comment("The technologies the project is built with"),
+		edge.To("revisions", ProjectRevisions.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("The saved versions of the project"),
 	}
 }
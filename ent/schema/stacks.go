@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Stacks holds the schema definition for the Stacks entity.
+type Stacks struct {
+	ent.Schema
+}
+
+// Mixin of the Stacks.
+func (Stacks) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Stacks.
+func (Stacks) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100).
+			Comment("The display name of the technology"),
+		field.String("slug").
+			NotEmpty().
+			Unique().
+			MaxLen(100).
+			Comment("The URL-safe identifier of the technology"),
+		field.String("category").
+			Optional().
+			MaxLen(50).
+			Comment("The kind of technology, e.g. language, framework or database"),
+		field.String("iconUrl").
+			Optional().
+			Comment("The icon URL of the technology"),
+	}
+}
+
+// Edges of the Stacks.
+func (Stacks) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("projects", Projects.Type).
+			Comment("The projects built with the technology"),
+		edge.To("packages", Packages.Type).
+			Comment("The packages built with the technology"),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Users holds the schema definition for the Users entity.
+type Users struct {
+	ent.Schema
+}
+
+// Mixin of the Users.
+func (Users) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Users.
+func (Users) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			NotEmpty().
+			Unique().
+			MaxLen(254).
+			Comment("The login email of the user"),
+		field.String("name").
+			Optional().
+			MaxLen(100).
+			Comment("The display name of the user"),
+		field.String("password_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("The bcrypt hash of the user's password"),
+		field.Enum("role").
+			Values("admin", "editor", "viewer").
+			Default("viewer").
+			Comment("The role that decides which routes the user may call"),
+	}
+}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager/ent/stacks"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Stacks is the model entity for the Stacks schema.
+type Stacks struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// The time the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The time the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The display name of the technology
+	Name string `json:"name,omitempty"`
+	// The URL-safe identifier of the technology
+	Slug string `json:"slug,omitempty"`
+	// The kind of technology, e.g. language, framework or database
+	Category string `json:"category,omitempty"`
+	// The icon URL of the technology
+	IconUrl string `json:"iconUrl,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StacksQuery when eager-loading is set.
+	Edges        StacksEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StacksEdges holds the relations/edges for other nodes in the graph.
+type StacksEdges struct {
+	// The projects built with the technology
+	Projects []*Projects `json:"projects,omitempty"`
+	// The packages built with the technology
+	Packages []*Packages `json:"packages,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectsOrErr returns the Projects value or an error if the edge
+// was not loaded in eager-loading.
+func (e StacksEdges) ProjectsOrErr() ([]*Projects, error) {
+	if e.loadedTypes[0] {
+		return e.Projects, nil
+	}
+	return nil, &NotLoadedError{edge: "projects"}
+}
+
+// PackagesOrErr returns the Packages value or an error if the edge
+// was not loaded in eager-loading.
+func (e StacksEdges) PackagesOrErr() ([]*Packages, error) {
+	if e.loadedTypes[1] {
+		return e.Packages, nil
+	}
+	return nil, &NotLoadedError{edge: "packages"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Stacks) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stacks.FieldID:
+			values[i] = new(sql.NullInt64)
+		case stacks.FieldName, stacks.FieldSlug, stacks.FieldCategory, stacks.FieldIconUrl:
+			values[i] = new(sql.NullString)
+		case stacks.FieldCreatedAt, stacks.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Stacks fields.
+func (s *Stacks) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stacks.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case stacks.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case stacks.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case stacks.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case stacks.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				s.Slug = value.String
+			}
+		case stacks.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				s.Category = value.String
+			}
+		case stacks.FieldIconUrl:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field iconUrl", values[i])
+			} else if value.Valid {
+				s.IconUrl = value.String
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Stacks.
+// This includes values selected through modifiers, order, etc.
+func (s *Stacks) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryProjects queries the "projects" edge of the Stacks entity.
+func (s *Stacks) QueryProjects() *ProjectsQuery {
+	return NewStacksClient(s.config).QueryProjects(s)
+}
+
+// QueryPackages queries the "packages" edge of the Stacks entity.
+func (s *Stacks) QueryPackages() *PackagesQuery {
+	return NewStacksClient(s.config).QueryPackages(s)
+}
+
+// Update returns a builder for updating this Stacks.
+// Note that you need to call Stacks.Unwrap() before calling this method if this Stacks
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Stacks) Update() *StacksUpdateOne {
+	return NewStacksClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Stacks entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Stacks) Unwrap() *Stacks {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Stacks is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Stacks) String() string {
+	var builder strings.Builder
+	builder.WriteString("Stacks(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(s.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(s.Category)
+	builder.WriteString(", ")
+	builder.WriteString("iconUrl=")
+	builder.WriteString(s.IconUrl)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StacksSlice is a parsable slice of Stacks.
+type StacksSlice []*Stacks
@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stacks
+
+import (
+	"project-manager/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Stacks {
+	return predicate.Stacks(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldName, v))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldSlug, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldCategory, v))
+}
+
+// IconUrl applies equality check predicate on the "iconUrl" field. It's identical to IconUrlEQ.
+func IconUrl(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldIconUrl, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldContainsFold(FieldName, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.Stacks {
+	return predicate.Stacks(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.Stacks {
+	return predicate.Stacks(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// IconUrlEQ applies the EQ predicate on the "iconUrl" field.
+func IconUrlEQ(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEQ(FieldIconUrl, v))
+}
+
+// IconUrlNEQ applies the NEQ predicate on the "iconUrl" field.
+func IconUrlNEQ(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNEQ(FieldIconUrl, v))
+}
+
+// IconUrlIn applies the In predicate on the "iconUrl" field.
+func IconUrlIn(vs ...string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldIn(FieldIconUrl, vs...))
+}
+
+// IconUrlNotIn applies the NotIn predicate on the "iconUrl" field.
+func IconUrlNotIn(vs ...string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldNotIn(FieldIconUrl, vs...))
+}
+
+// IconUrlGT applies the GT predicate on the "iconUrl" field.
+func IconUrlGT(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGT(FieldIconUrl, v))
+}
+
+// IconUrlGTE applies the GTE predicate on the "iconUrl" field.
+func IconUrlGTE(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldGTE(FieldIconUrl, v))
+}
+
+// IconUrlLT applies the LT predicate on the "iconUrl" field.
+func IconUrlLT(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLT(FieldIconUrl, v))
+}
+
+// IconUrlLTE applies the LTE predicate on the "iconUrl" field.
+func IconUrlLTE(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldLTE(FieldIconUrl, v))
+}
+
+// IconUrlContains applies the Contains predicate on the "iconUrl" field.
+func IconUrlContains(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldContains(FieldIconUrl, v))
+}
+
+// IconUrlHasPrefix applies the HasPrefix predicate on the "iconUrl" field.
+func IconUrlHasPrefix(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldHasPrefix(FieldIconUrl, v))
+}
+
+// IconUrlHasSuffix applies the HasSuffix predicate on the "iconUrl" field.
+func IconUrlHasSuffix(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldHasSuffix(FieldIconUrl, v))
+}
+
+// IconUrlIsNil applies the IsNil predicate on the "iconUrl" field.
+func IconUrlIsNil() predicate.Stacks {
+	return predicate.Stacks(sql.FieldIsNull(FieldIconUrl))
+}
+
+// IconUrlNotNil applies the NotNil predicate on the "iconUrl" field.
+func IconUrlNotNil() predicate.Stacks {
+	return predicate.Stacks(sql.FieldNotNull(FieldIconUrl))
+}
+
+// IconUrlEqualFold applies the EqualFold predicate on the "iconUrl" field.
+func IconUrlEqualFold(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldEqualFold(FieldIconUrl, v))
+}
+
+// IconUrlContainsFold applies the ContainsFold predicate on the "iconUrl" field.
+func IconUrlContainsFold(v string) predicate.Stacks {
+	return predicate.Stacks(sql.FieldContainsFold(FieldIconUrl, v))
+}
+
+// HasProjects applies the HasEdge predicate on the "projects" edge.
+func HasProjects() predicate.Stacks {
+	return predicate.Stacks(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ProjectsTable, ProjectsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectsWith applies the HasEdge predicate on the "projects" edge with a given conditions (other predicates).
+func HasProjectsWith(preds ...predicate.Projects) predicate.Stacks {
+	return predicate.Stacks(func(s *sql.Selector) {
+		step := newProjectsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPackages applies the HasEdge predicate on the "packages" edge.
+func HasPackages() predicate.Stacks {
+	return predicate.Stacks(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, PackagesTable, PackagesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPackagesWith applies the HasEdge predicate on the "packages" edge with a given conditions (other predicates).
+func HasPackagesWith(preds ...predicate.Packages) predicate.Stacks {
+	return predicate.Stacks(func(s *sql.Selector) {
+		step := newPackagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Stacks) predicate.Stacks {
+	return predicate.Stacks(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Stacks) predicate.Stacks {
+	return predicate.Stacks(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Stacks) predicate.Stacks {
+	return predicate.Stacks(sql.NotPredicates(p))
+}
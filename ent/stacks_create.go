@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager/ent/packages"
+	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StacksCreate is the builder for creating a Stacks entity.
+type StacksCreate struct {
+	config
+	mutation *StacksMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *StacksCreate) SetCreatedAt(t time.Time) *StacksCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *StacksCreate) SetNillableCreatedAt(t *time.Time) *StacksCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *StacksCreate) SetUpdatedAt(t time.Time) *StacksCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *StacksCreate) SetNillableUpdatedAt(t *time.Time) *StacksCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *StacksCreate) SetName(s string) *StacksCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetSlug sets the "slug" field.
+func (sc *StacksCreate) SetSlug(s string) *StacksCreate {
+	sc.mutation.SetSlug(s)
+	return sc
+}
+
+// SetCategory sets the "category" field.
+func (sc *StacksCreate) SetCategory(s string) *StacksCreate {
+	sc.mutation.SetCategory(s)
+	return sc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (sc *StacksCreate) SetNillableCategory(s *string) *StacksCreate {
+	if s != nil {
+		sc.SetCategory(*s)
+	}
+	return sc
+}
+
+// SetIconUrl sets the "iconUrl" field.
+func (sc *StacksCreate) SetIconUrl(s string) *StacksCreate {
+	sc.mutation.SetIconUrl(s)
+	return sc
+}
+
+// SetNillableIconUrl sets the "iconUrl" field if the given value is not nil.
+func (sc *StacksCreate) SetNillableIconUrl(s *string) *StacksCreate {
+	if s != nil {
+		sc.SetIconUrl(*s)
+	}
+	return sc
+}
+
+// AddProjectIDs adds the "projects" edge to the Projects entity by IDs.
+func (sc *StacksCreate) AddProjectIDs(ids ...int) *StacksCreate {
+	sc.mutation.AddProjectIDs(ids...)
+	return sc
+}
+
+// AddProjects adds the "projects" edges to the Projects entity.
+func (sc *StacksCreate) AddProjects(p ...*Projects) *StacksCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return sc.AddProjectIDs(ids...)
+}
+
+// AddPackageIDs adds the "packages" edge to the Packages entity by IDs.
+func (sc *StacksCreate) AddPackageIDs(ids ...int) *StacksCreate {
+	sc.mutation.AddPackageIDs(ids...)
+	return sc
+}
+
+// AddPackages adds the "packages" edges to the Packages entity.
+func (sc *StacksCreate) AddPackages(p ...*Packages) *StacksCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return sc.AddPackageIDs(ids...)
+}
+
+// Mutation returns the StacksMutation object of the builder.
+func (sc *StacksCreate) Mutation() *StacksMutation {
+	return sc.mutation
+}
+
+// Save creates the Stacks in the database.
+func (sc *StacksCreate) Save(ctx context.Context) (*Stacks, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *StacksCreate) SaveX(ctx context.Context) *Stacks {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *StacksCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *StacksCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *StacksCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := stacks.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := stacks.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *StacksCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Stacks.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Stacks.updated_at"`)}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Stacks.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := stacks.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Stacks.name": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "Stacks.slug"`)}
+	}
+	if v, ok := sc.mutation.Slug(); ok {
+		if err := stacks.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Stacks.slug": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.Category(); ok {
+		if err := stacks.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Stacks.category": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sc *StacksCreate) sqlSave(ctx context.Context) (*Stacks, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *StacksCreate) createSpec() (*Stacks, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Stacks{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(stacks.Table, sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(stacks.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(stacks.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(stacks.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.Slug(); ok {
+		_spec.SetField(stacks.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := sc.mutation.Category(); ok {
+		_spec.SetField(stacks.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := sc.mutation.IconUrl(); ok {
+		_spec.SetField(stacks.FieldIconUrl, field.TypeString, value)
+		_node.IconUrl = value
+	}
+	if nodes := sc.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.ProjectsTable,
+			Columns: stacks.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.PackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.PackagesTable,
+			Columns: stacks.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StacksCreateBulk is the builder for creating many Stacks entities in bulk.
+type StacksCreateBulk struct {
+	config
+	err      error
+	builders []*StacksCreate
+}
+
+// Save creates the Stacks entities in the database.
+func (scb *StacksCreateBulk) Save(ctx context.Context) ([]*Stacks, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Stacks, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StacksMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *StacksCreateBulk) SaveX(ctx context.Context) []*Stacks {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *StacksCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *StacksCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,756 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager/ent/packages"
+	"project-manager/ent/predicate"
+	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StacksUpdate is the builder for updating Stacks entities.
+type StacksUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StacksMutation
+}
+
+// Where appends a list predicates to the StacksUpdate builder.
+func (su *StacksUpdate) Where(ps ...predicate.Stacks) *StacksUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *StacksUpdate) SetUpdatedAt(t time.Time) *StacksUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *StacksUpdate) SetName(s string) *StacksUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *StacksUpdate) SetNillableName(s *string) *StacksUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetSlug sets the "slug" field.
+func (su *StacksUpdate) SetSlug(s string) *StacksUpdate {
+	su.mutation.SetSlug(s)
+	return su
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (su *StacksUpdate) SetNillableSlug(s *string) *StacksUpdate {
+	if s != nil {
+		su.SetSlug(*s)
+	}
+	return su
+}
+
+// SetCategory sets the "category" field.
+func (su *StacksUpdate) SetCategory(s string) *StacksUpdate {
+	su.mutation.SetCategory(s)
+	return su
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (su *StacksUpdate) SetNillableCategory(s *string) *StacksUpdate {
+	if s != nil {
+		su.SetCategory(*s)
+	}
+	return su
+}
+
+// ClearCategory clears the value of the "category" field.
+func (su *StacksUpdate) ClearCategory() *StacksUpdate {
+	su.mutation.ClearCategory()
+	return su
+}
+
+// SetIconUrl sets the "iconUrl" field.
+func (su *StacksUpdate) SetIconUrl(s string) *StacksUpdate {
+	su.mutation.SetIconUrl(s)
+	return su
+}
+
+// SetNillableIconUrl sets the "iconUrl" field if the given value is not nil.
+func (su *StacksUpdate) SetNillableIconUrl(s *string) *StacksUpdate {
+	if s != nil {
+		su.SetIconUrl(*s)
+	}
+	return su
+}
+
+// ClearIconUrl clears the value of the "iconUrl" field.
+func (su *StacksUpdate) ClearIconUrl() *StacksUpdate {
+	su.mutation.ClearIconUrl()
+	return su
+}
+
+// AddProjectIDs adds the "projects" edge to the Projects entity by IDs.
+func (su *StacksUpdate) AddProjectIDs(ids ...int) *StacksUpdate {
+	su.mutation.AddProjectIDs(ids...)
+	return su
+}
+
+// AddProjects adds the "projects" edges to the Projects entity.
+func (su *StacksUpdate) AddProjects(p ...*Projects) *StacksUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return su.AddProjectIDs(ids...)
+}
+
+// AddPackageIDs adds the "packages" edge to the Packages entity by IDs.
+func (su *StacksUpdate) AddPackageIDs(ids ...int) *StacksUpdate {
+	su.mutation.AddPackageIDs(ids...)
+	return su
+}
+
+// AddPackages adds the "packages" edges to the Packages entity.
+func (su *StacksUpdate) AddPackages(p ...*Packages) *StacksUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return su.AddPackageIDs(ids...)
+}
+
+// Mutation returns the StacksMutation object of the builder.
+func (su *StacksUpdate) Mutation() *StacksMutation {
+	return su.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Projects entity.
+func (su *StacksUpdate) ClearProjects() *StacksUpdate {
+	su.mutation.ClearProjects()
+	return su
+}
+
+// RemoveProjectIDs removes the "projects" edge to Projects entities by IDs.
+func (su *StacksUpdate) RemoveProjectIDs(ids ...int) *StacksUpdate {
+	su.mutation.RemoveProjectIDs(ids...)
+	return su
+}
+
+// RemoveProjects removes "projects" edges to Projects entities.
+func (su *StacksUpdate) RemoveProjects(p ...*Projects) *StacksUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return su.RemoveProjectIDs(ids...)
+}
+
+// ClearPackages clears all "packages" edges to the Packages entity.
+func (su *StacksUpdate) ClearPackages() *StacksUpdate {
+	su.mutation.ClearPackages()
+	return su
+}
+
+// RemovePackageIDs removes the "packages" edge to Packages entities by IDs.
+func (su *StacksUpdate) RemovePackageIDs(ids ...int) *StacksUpdate {
+	su.mutation.RemovePackageIDs(ids...)
+	return su
+}
+
+// RemovePackages removes "packages" edges to Packages entities.
+func (su *StacksUpdate) RemovePackages(p ...*Packages) *StacksUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return su.RemovePackageIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *StacksUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *StacksUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *StacksUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *StacksUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *StacksUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := stacks.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *StacksUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := stacks.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Stacks.name": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Slug(); ok {
+		if err := stacks.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Stacks.slug": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Category(); ok {
+		if err := stacks.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Stacks.category": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *StacksUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stacks.Table, stacks.Columns, sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(stacks.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(stacks.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Slug(); ok {
+		_spec.SetField(stacks.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Category(); ok {
+		_spec.SetField(stacks.FieldCategory, field.TypeString, value)
+	}
+	if su.mutation.CategoryCleared() {
+		_spec.ClearField(stacks.FieldCategory, field.TypeString)
+	}
+	if value, ok := su.mutation.IconUrl(); ok {
+		_spec.SetField(stacks.FieldIconUrl, field.TypeString, value)
+	}
+	if su.mutation.IconUrlCleared() {
+		_spec.ClearField(stacks.FieldIconUrl, field.TypeString)
+	}
+	if su.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.ProjectsTable,
+			Columns: stacks.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !su.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.ProjectsTable,
+			Columns: stacks.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.ProjectsTable,
+			Columns: stacks.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.PackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.PackagesTable,
+			Columns: stacks.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedPackagesIDs(); len(nodes) > 0 && !su.mutation.PackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.PackagesTable,
+			Columns: stacks.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.PackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.PackagesTable,
+			Columns: stacks.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stacks.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// StacksUpdateOne is the builder for updating a single Stacks entity.
+type StacksUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StacksMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *StacksUpdateOne) SetUpdatedAt(t time.Time) *StacksUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *StacksUpdateOne) SetName(s string) *StacksUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *StacksUpdateOne) SetNillableName(s *string) *StacksUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetSlug sets the "slug" field.
+func (suo *StacksUpdateOne) SetSlug(s string) *StacksUpdateOne {
+	suo.mutation.SetSlug(s)
+	return suo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (suo *StacksUpdateOne) SetNillableSlug(s *string) *StacksUpdateOne {
+	if s != nil {
+		suo.SetSlug(*s)
+	}
+	return suo
+}
+
+// SetCategory sets the "category" field.
+func (suo *StacksUpdateOne) SetCategory(s string) *StacksUpdateOne {
+	suo.mutation.SetCategory(s)
+	return suo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (suo *StacksUpdateOne) SetNillableCategory(s *string) *StacksUpdateOne {
+	if s != nil {
+		suo.SetCategory(*s)
+	}
+	return suo
+}
+
+// ClearCategory clears the value of the "category" field.
+func (suo *StacksUpdateOne) ClearCategory() *StacksUpdateOne {
+	suo.mutation.ClearCategory()
+	return suo
+}
+
+// SetIconUrl sets the "iconUrl" field.
+func (suo *StacksUpdateOne) SetIconUrl(s string) *StacksUpdateOne {
+	suo.mutation.SetIconUrl(s)
+	return suo
+}
+
+// SetNillableIconUrl sets the "iconUrl" field if the given value is not nil.
+func (suo *StacksUpdateOne) SetNillableIconUrl(s *string) *StacksUpdateOne {
+	if s != nil {
+		suo.SetIconUrl(*s)
+	}
+	return suo
+}
+
+// ClearIconUrl clears the value of the "iconUrl" field.
+func (suo *StacksUpdateOne) ClearIconUrl() *StacksUpdateOne {
+	suo.mutation.ClearIconUrl()
+	return suo
+}
+
+// AddProjectIDs adds the "projects" edge to the Projects entity by IDs.
+func (suo *StacksUpdateOne) AddProjectIDs(ids ...int) *StacksUpdateOne {
+	suo.mutation.AddProjectIDs(ids...)
+	return suo
+}
+
+// AddProjects adds the "projects" edges to the Projects entity.
+func (suo *StacksUpdateOne) AddProjects(p ...*Projects) *StacksUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return suo.AddProjectIDs(ids...)
+}
+
+// AddPackageIDs adds the "packages" edge to the Packages entity by IDs.
+func (suo *StacksUpdateOne) AddPackageIDs(ids ...int) *StacksUpdateOne {
+	suo.mutation.AddPackageIDs(ids...)
+	return suo
+}
+
+// AddPackages adds the "packages" edges to the Packages entity.
+func (suo *StacksUpdateOne) AddPackages(p ...*Packages) *StacksUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return suo.AddPackageIDs(ids...)
+}
+
+// Mutation returns the StacksMutation object of the builder.
+func (suo *StacksUpdateOne) Mutation() *StacksMutation {
+	return suo.mutation
+}
+
+// ClearProjects clears all "projects" edges to the Projects entity.
+func (suo *StacksUpdateOne) ClearProjects() *StacksUpdateOne {
+	suo.mutation.ClearProjects()
+	return suo
+}
+
+// RemoveProjectIDs removes the "projects" edge to Projects entities by IDs.
+func (suo *StacksUpdateOne) RemoveProjectIDs(ids ...int) *StacksUpdateOne {
+	suo.mutation.RemoveProjectIDs(ids...)
+	return suo
+}
+
+// RemoveProjects removes "projects" edges to Projects entities.
+func (suo *StacksUpdateOne) RemoveProjects(p ...*Projects) *StacksUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return suo.RemoveProjectIDs(ids...)
+}
+
+// ClearPackages clears all "packages" edges to the Packages entity.
+func (suo *StacksUpdateOne) ClearPackages() *StacksUpdateOne {
+	suo.mutation.ClearPackages()
+	return suo
+}
+
+// RemovePackageIDs removes the "packages" edge to Packages entities by IDs.
+func (suo *StacksUpdateOne) RemovePackageIDs(ids ...int) *StacksUpdateOne {
+	suo.mutation.RemovePackageIDs(ids...)
+	return suo
+}
+
+// RemovePackages removes "packages" edges to Packages entities.
+func (suo *StacksUpdateOne) RemovePackages(p ...*Packages) *StacksUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return suo.RemovePackageIDs(ids...)
+}
+
+// Where appends a list predicates to the StacksUpdate builder.
+func (suo *StacksUpdateOne) Where(ps ...predicate.Stacks) *StacksUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *StacksUpdateOne) Select(field string, fields ...string) *StacksUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Stacks entity.
+func (suo *StacksUpdateOne) Save(ctx context.Context) (*Stacks, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *StacksUpdateOne) SaveX(ctx context.Context) *Stacks {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *StacksUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *StacksUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *StacksUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := stacks.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *StacksUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := stacks.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Stacks.name": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Slug(); ok {
+		if err := stacks.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Stacks.slug": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Category(); ok {
+		if err := stacks.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Stacks.category": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *StacksUpdateOne) sqlSave(ctx context.Context) (_node *Stacks, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stacks.Table, stacks.Columns, sqlgraph.NewFieldSpec(stacks.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Stacks.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stacks.FieldID)
+		for _, f := range fields {
+			if !stacks.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stacks.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(stacks.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(stacks.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Slug(); ok {
+		_spec.SetField(stacks.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Category(); ok {
+		_spec.SetField(stacks.FieldCategory, field.TypeString, value)
+	}
+	if suo.mutation.CategoryCleared() {
+		_spec.ClearField(stacks.FieldCategory, field.TypeString)
+	}
+	if value, ok := suo.mutation.IconUrl(); ok {
+		_spec.SetField(stacks.FieldIconUrl, field.TypeString, value)
+	}
+	if suo.mutation.IconUrlCleared() {
+		_spec.ClearField(stacks.FieldIconUrl, field.TypeString)
+	}
+	if suo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.ProjectsTable,
+			Columns: stacks.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !suo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.ProjectsTable,
+			Columns: stacks.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.ProjectsTable,
+			Columns: stacks.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projects.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.PackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.PackagesTable,
+			Columns: stacks.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedPackagesIDs(); len(nodes) > 0 && !suo.mutation.PackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.PackagesTable,
+			Columns: stacks.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.PackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   stacks.PackagesTable,
+			Columns: stacks.PackagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packages.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Stacks{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stacks.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -12,12 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditEvents is the client for interacting with the AuditEvents builders.
+	AuditEvents *AuditEventsClient
 	// Clients is the client for interacting with the Clients builders.
 	Clients *ClientsClient
 	// Packages is the client for interacting with the Packages builders.
 	Packages *PackagesClient
+	// ProjectRevisions is the client for interacting with the ProjectRevisions builders.
+	ProjectRevisions *ProjectRevisionsClient
 	// Projects is the client for interacting with the Projects builders.
 	Projects *ProjectsClient
+	// Stacks is the client for interacting with the Stacks builders.
+	Stacks *StacksClient
+	// Users is the client for interacting with the Users builders.
+	Users *UsersClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +157,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditEvents = NewAuditEventsClient(tx.config)
 	tx.Clients = NewClientsClient(tx.config)
 	tx.Packages = NewPackagesClient(tx.config)
+	tx.ProjectRevisions = NewProjectRevisionsClient(tx.config)
 	tx.Projects = NewProjectsClient(tx.config)
+	tx.Stacks = NewStacksClient(tx.config)
+	tx.Users = NewUsersClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +173,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Clients.QueryXXX(), the query will be executed
+// applies a query, for example: AuditEvents.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
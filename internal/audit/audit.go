@@ -0,0 +1,203 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"project-manager/ent"
+	"project-manager/ent/auditevents"
+	"project-manager/ent/clients"
+	"project-manager/ent/hook"
+	"project-manager/ent/packages"
+	"project-manager/ent/projects"
+	"project-manager/ent/schema"
+	"project-manager/internal/models"
+)
+
+// entityTypes maps the audited ent types to the names stored in entity_type
+var entityTypes = map[string]string{
+	ent.TypeProjects: "project",
+	ent.TypePackages: "package",
+	ent.TypeClients:  "client",
+}
+
+type actorKey struct{}
+
+type activeKey struct{}
+
+// WithActor returns a context whose writes are attributed to userID. Writes
+// must run on this context, usually the request's, for the actor to be recorded.
+func WithActor(parent context.Context, userID int) context.Context {
+	return context.WithValue(parent, actorKey{}, userID)
+}
+
+func actorFrom(ctx context.Context) *int {
+	if id, ok := ctx.Value(actorKey{}).(int); ok {
+		return &id
+	}
+	return nil
+}
+
+// Hook records an AuditEvents row for every create, update and delete of
+// projects, packages and clients. Register it with client.Use. A failed insert
+// fails the write, which the services run in a transaction so that it is
+// rolled back with its event.
+func Hook() ent.Hook {
+	return hook.If(record, func(_ context.Context, m ent.Mutation) bool {
+		_, ok := entityTypes[m.Type()]
+		return ok
+	})
+}
+
+// mutation is the part of the generated mutations the hook relies on
+type mutation interface {
+	ent.Mutation
+	ID() (int, bool)
+	IDs(context.Context) ([]int, error)
+	Client() *ent.Client
+}
+
+func record(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		// Only the outermost write is recorded; a soft delete re-enters the
+		// hooks as an update of deleted_at
+		if ctx.Value(activeKey{}) != nil {
+			return next.Mutate(ctx, m)
+		}
+		ctx = context.WithValue(ctx, activeKey{}, true)
+
+		mx, ok := m.(mutation)
+		if !ok {
+			return nil, fmt.Errorf("audit: unexpected mutation type %T", m)
+		}
+		// Read the operation first: soft delete turns the mutation into an update
+		op := operation(m)
+
+		var ids []int
+		if op != auditevents.OperationCreate {
+			var err error
+			if ids, err = mx.IDs(ctx); err != nil {
+				return nil, err
+			}
+		}
+		before, err := snapshot(ctx, mx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		if op == auditevents.OperationCreate {
+			id, _ := mx.ID()
+			ids = []int{id}
+		}
+		if err := write(ctx, mx, op, ids, before); err != nil {
+			return nil, fmt.Errorf("audit: %w", err)
+		}
+		return v, nil
+	})
+}
+
+// write inserts one event for every row in ids that changed since before
+func write(ctx context.Context, mx mutation, op auditevents.Operation, ids []int, before map[int]map[string]any) error {
+	after, err := snapshot(ctx, mx, ids)
+	if err != nil {
+		return err
+	}
+
+	var events []*ent.AuditEventsCreate
+	for _, id := range ids {
+		changes := diff(before[id], after[id])
+		if len(changes) == 0 && op == auditevents.OperationUpdate {
+			continue
+		}
+		events = append(events, mx.Client().AuditEvents.Create().
+			SetEntityType(entityTypes[mx.Type()]).
+			SetEntityID(id).
+			SetOperation(op).
+			SetNillableActorID(actorFrom(ctx)).
+			SetChanges(changes))
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	return mx.Client().AuditEvents.CreateBulk(events...).Exec(ctx)
+}
+
+func operation(m ent.Mutation) auditevents.Operation {
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		return auditevents.OperationCreate
+	case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		return auditevents.OperationDelete
+	case m.FieldCleared("deleted_at"):
+		return auditevents.OperationRestore
+	}
+	return auditevents.OperationUpdate
+}
+
+// snapshot loads the rows with the given IDs, trash included, as maps of
+// their JSON field names to values
+func snapshot(ctx context.Context, m mutation, ids []int) (map[int]map[string]any, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	ctx = schema.SkipSoftDelete(ctx)
+
+	var rows any
+	var err error
+	switch m.Type() {
+	case ent.TypeProjects:
+		rows, err = m.Client().Projects.Query().Where(projects.IDIn(ids...)).All(ctx)
+	case ent.TypePackages:
+		rows, err = m.Client().Packages.Query().Where(packages.IDIn(ids...)).All(ctx)
+	case ent.TypeClients:
+		rows, err = m.Client().Clients.Query().Where(clients.IDIn(ids...)).All(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// A JSON round trip gives every entity type the same shape and makes
+	// values compare the way they will be stored
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return nil, err
+	}
+	var list []map[string]any
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]map[string]any, len(list))
+	for _, row := range list {
+		id, _ := row["id"].(float64)
+		delete(row, "id")
+		delete(row, "edges")
+		// Every write moves updated_at; the event's own created_at records it
+		delete(row, "updated_at")
+		result[int(id)] = row
+	}
+	return result, nil
+}
+
+// diff returns the fields whose value differs between before and after
+func diff(before, after map[string]any) map[string]models.FieldChange {
+	changes := map[string]models.FieldChange{}
+	for name, value := range before {
+		if !reflect.DeepEqual(value, after[name]) {
+			changes[name] = models.FieldChange{Before: value, After: after[name]}
+		}
+	}
+	for name, value := range after {
+		if _, seen := before[name]; !seen {
+			changes[name] = models.FieldChange{After: value}
+		}
+	}
+	return changes
+}
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"project-manager/ent"
+	"project-manager/ent/users"
+	"project-manager/internal/config"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// AccessTokenTTL is how long an access token is accepted by the middleware
+	AccessTokenTTL = 15 * time.Minute
+	// RefreshTokenTTL is how long a refresh token can be exchanged for a new pair
+	RefreshTokenTTL = 7 * 24 * time.Hour
+
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid or expired token")
+
+	signingKey []byte
+)
+
+// Claims is the payload carried by both access and refresh tokens
+type Claims struct {
+	Role      users.Role `json:"role"`
+	TokenType string     `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+// UserID returns the ID of the user the token was issued for
+func (c *Claims) UserID() (int, error) {
+	return strconv.Atoi(c.Subject)
+}
+
+// InitAuth sets the JWT signing key
+func InitAuth(cfg config.Auth) error {
+	if cfg.JWTSecret == "" {
+		return fmt.Errorf("JWT secret is not configured")
+	}
+	signingKey = []byte(cfg.JWTSecret)
+	return nil
+}
+
+// HashPassword returns the bcrypt hash of a plain-text password
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword reports whether password matches the stored bcrypt hash
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// dummyHash is a bcrypt hash at the cost HashPassword uses, of a password
+// nobody has
+const dummyHash = "$2a$10$i.KGYeJ8ryjnpgLy/5zd/O8oWalepSlGBOl2rZfRCohWG.HYpcoYe"
+
+// CheckNoPassword costs what CheckPassword does and always fails. Logins for
+// unknown emails call it, so that response times do not tell which exist.
+func CheckNoPassword(password string) bool {
+	CheckPassword(dummyHash, password)
+	return false
+}
+
+// IssueTokens creates a signed access/refresh token pair for a user
+func IssueTokens(userID int, role users.Role) (accessToken, refreshToken string, err error) {
+	accessToken, err = signToken(userID, role, TokenTypeAccess, AccessTokenTTL)
+	if err != nil {
+		return "", "", err
+	}
+	refreshToken, err = signToken(userID, role, TokenTypeRefresh, RefreshTokenTTL)
+	if err != nil {
+		return "", "", err
+	}
+	return accessToken, refreshToken, nil
+}
+
+// ParseToken verifies a signed token and checks that it is of the expected type
+func ParseToken(tokenString, tokenType string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return signingKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	if claims.TokenType != tokenType {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+func signToken(userID int, role users.Role, tokenType string, ttl time.Duration) (string, error) {
+	if len(signingKey) == 0 {
+		return "", fmt.Errorf("auth not initialized")
+	}
+	now := time.Now()
+	claims := Claims{
+		Role:      role,
+		TokenType: tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.Itoa(userID),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(signingKey)
+}
+
+// SeedAdmin creates the bootstrap admin account from cfg.AdminEmail and
+// cfg.AdminPassword when both are set and no user with that email exists yet
+func SeedAdmin(client *ent.Client, cfg config.Auth) error {
+	email, password := cfg.AdminEmail, cfg.AdminPassword
+	if email == "" || password == "" {
+		return nil
+	}
+
+	exists, err := client.Users.Query().Where(users.Email(email)).Exist(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed checking admin user: %v", err)
+	}
+	if exists {
+		return nil
+	}
+
+	hash, err := HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("failed hashing admin password: %v", err)
+	}
+
+	_, err = client.Users.Create().
+		SetEmail(email).
+		SetPasswordHash(hash).
+		SetRole(users.RoleAdmin).
+		Save(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed creating admin user: %v", err)
+	}
+	return nil
+}
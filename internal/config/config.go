@@ -0,0 +1,330 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds every setting the server and the maintenance commands need.
+// Load fills it from, in increasing precedence: defaults, a YAML file, the
+// environment and command-line flags.
+type Config struct {
+	Server   Server   `yaml:"server"`
+	CORS     CORS     `yaml:"cors"`
+	Database Database `yaml:"database"`
+	Auth     Auth     `yaml:"auth"`
+	Trash    Trash    `yaml:"trash"`
+	Features Features `yaml:"features"`
+	Log      Log      `yaml:"log"`
+}
+
+// Server configures the HTTP listener
+type Server struct {
+	Addr              string        `yaml:"addr"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	RequestTimeout    time.Duration `yaml:"request_timeout"` // Deadline for handlers and their queries, 0 for none
+	// RouteTimeouts overrides RequestTimeout by route template, e.g. "/api/search": 20s
+	RouteTimeouts   map[string]time.Duration `yaml:"route_timeouts"`
+	MaxBodyBytes    int                      `yaml:"max_body_bytes"`   // Largest request body accepted, 0 for no limit
+	ShutdownDelay   time.Duration            `yaml:"shutdown_delay"`   // Time readiness fails before the listener closes
+	ShutdownTimeout time.Duration            `yaml:"shutdown_timeout"` // Time allowed to drain requests and release resources
+}
+
+// CORS lists the browser origins allowed to call the API
+type CORS struct {
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+// Database configures the PostgreSQL connection pool
+type Database struct {
+	URL             string        `yaml:"url"`
+	DevURL          string        `yaml:"dev_url"` // Empty database used by "migrate diff"
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	AutoMigrate     bool          `yaml:"auto_migrate"`
+}
+
+// Auth holds the token signing key and the bootstrap admin account
+type Auth struct {
+	JWTSecret     string `yaml:"jwt_secret"`
+	AdminEmail    string `yaml:"admin_email"`
+	AdminPassword string `yaml:"admin_password"`
+}
+
+// Trash configures how long soft-deleted rows are kept
+type Trash struct {
+	Retention time.Duration `yaml:"retention"`
+}
+
+// Features switches optional parts of the server on or off
+type Features struct {
+	Swagger        bool `yaml:"swagger"`         // Serve /openapi.json and the API docs under /swagger/
+	PostgresSearch bool `yaml:"postgres_search"` // Use PostgreSQL full-text search when available
+	Metrics        bool `yaml:"metrics"`         // Serve Prometheus metrics under /metrics
+	// Log responses that do not match the OpenAPI document (development only)
+	ValidateResponses bool `yaml:"validate_responses"`
+}
+
+// Log configures the structured logger
+type Log struct {
+	Level  string `yaml:"level"`  // debug, info, warn or error
+	Format string `yaml:"format"` // json or text
+}
+
+// Default returns the settings used when nothing overrides them
+func Default() Config {
+	return Config{
+		Server: Server{
+			Addr:              ":8080",
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       15 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			RequestTimeout:    10 * time.Second,
+			MaxBodyBytes:      1 << 20,
+			ShutdownTimeout:   20 * time.Second,
+		},
+		CORS: CORS{
+			AllowedOrigins: []string{
+				"http://localhost:3000",
+				"https://project-manager-server-side-production.up.railway.app",
+				"https://onahsunday.vercel.app",
+			},
+		},
+		Database: Database{
+			MaxOpenConns:    25,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 30 * time.Minute,
+		},
+		Trash: Trash{
+			Retention: 30 * 24 * time.Hour,
+		},
+		Features: Features{
+			Swagger:        true,
+			PostgresSearch: true,
+			Metrics:        true,
+		},
+		Log: Log{
+			Level:  "info",
+			Format: "json",
+		},
+	}
+}
+
+// Load registers the configuration flags on flags, parses args and builds
+// the configuration. The YAML file is named by -config or CONFIG_FILE.
+// Commands register their own flags on the set before calling Load.
+func Load(flags *flag.FlagSet, args []string) (*Config, error) {
+	cfg := Default()
+	table := settings(&cfg)
+
+	file := flags.String("config", os.Getenv("CONFIG_FILE"), "YAML configuration file")
+	type pending struct {
+		setting setting
+		value   string
+	}
+	var set []pending
+	for _, s := range table {
+		if s.flag == "" {
+			continue
+		}
+		s := s
+		record := func(v string) error {
+			set = append(set, pending{s, v})
+			return nil
+		}
+		if s.bool {
+			flags.BoolFunc(s.flag, s.usage, record)
+		} else {
+			flags.Func(s.flag, s.usage, record)
+		}
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *file != "" {
+		if err := cfg.loadFile(*file); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range table {
+		v, ok := os.LookupEnv(s.env)
+		if !ok || v == "" {
+			continue
+		}
+		if err := s.set(v); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", s.env, v, err)
+		}
+	}
+	for _, p := range set {
+		if err := p.setting.set(p.value); err != nil {
+			return nil, fmt.Errorf("invalid -%s %q: %v", p.setting.flag, p.value, err)
+		}
+	}
+	return &cfg, nil
+}
+
+// loadFile overlays the settings found in a YAML file. Unknown keys are
+// rejected so that typos do not go unnoticed.
+func (c *Config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed opening config file: %v", err)
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed reading config file %s: %v", path, err)
+	}
+	return nil
+}
+
+// ValidateServer checks the settings the HTTP server needs on top of Validate
+func (c *Config) ValidateServer() error {
+	var errs []error
+	if err := c.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, _, err := net.SplitHostPort(c.Server.Addr); err != nil {
+		errs = append(errs, fmt.Errorf("server.addr %q is not a host:port address", c.Server.Addr))
+	}
+	for name, d := range map[string]time.Duration{
+		"server.read_header_timeout": c.Server.ReadHeaderTimeout,
+		"server.read_timeout":        c.Server.ReadTimeout,
+		"server.write_timeout":       c.Server.WriteTimeout,
+		"server.idle_timeout":        c.Server.IdleTimeout,
+		"server.request_timeout":     c.Server.RequestTimeout,
+		"server.shutdown_delay":      c.Server.ShutdownDelay,
+		"server.shutdown_timeout":    c.Server.ShutdownTimeout,
+	} {
+		if d < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative", name))
+		}
+	}
+	if c.Server.MaxBodyBytes < 0 {
+		errs = append(errs, errors.New("server.max_body_bytes must not be negative"))
+	}
+	for route, d := range c.Server.RouteTimeouts {
+		if d < 0 {
+			errs = append(errs, fmt.Errorf("server.route_timeouts: %s must not be negative", route))
+		}
+	}
+	for _, origin := range c.CORS.AllowedOrigins {
+		if u, err := url.Parse(origin); origin != "*" && (err != nil || u.Scheme == "" || u.Host == "") {
+			errs = append(errs, fmt.Errorf("cors.allowed_origins: %q is not an origin like https://example.com", origin))
+		}
+	}
+	if c.Auth.JWTSecret == "" {
+		errs = append(errs, errors.New("auth.jwt_secret is required (JWT_SECRET)"))
+	}
+	if (c.Auth.AdminEmail == "") != (c.Auth.AdminPassword == "") {
+		errs = append(errs, errors.New("auth.admin_email and auth.admin_password must be set together"))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the settings every command needs, which are those of the
+// database and the trash
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Database.URL == "" {
+		errs = append(errs, errors.New("database.url is required (DATABASE_URL)"))
+	}
+	if c.Database.MaxOpenConns < 0 || c.Database.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("database pool sizes must not be negative"))
+	}
+	if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		errs = append(errs, errors.New("database.max_idle_conns must not exceed database.max_open_conns"))
+	}
+	if c.Database.ConnMaxLifetime < 0 {
+		errs = append(errs, errors.New("database.conn_max_lifetime must not be negative"))
+	}
+	if c.Trash.Retention <= 0 {
+		errs = append(errs, errors.New("trash.retention must be positive"))
+	}
+	switch strings.ToLower(c.Log.Level) {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("log.level %q must be debug, info, warn or error", c.Log.Level))
+	}
+	if c.Log.Format != "json" && c.Log.Format != "text" {
+		errs = append(errs, fmt.Errorf("log.format %q must be json or text", c.Log.Format))
+	}
+	return errors.Join(errs...)
+}
+
+// String renders the configuration as YAML with secrets redacted
+func (c Config) String() string {
+	out, err := yaml.Marshal(c.redacted())
+	if err != nil {
+		return fmt.Sprintf("<config: %v>", err)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// LogValue logs the configuration as nested attributes named like the YAML
+// keys, with secrets redacted
+func (c Config) LogValue() slog.Value {
+	out, err := yaml.Marshal(c.redacted())
+	if err != nil {
+		return slog.StringValue(fmt.Sprintf("<config: %v>", err))
+	}
+	var tree map[string]any
+	if err := yaml.Unmarshal(out, &tree); err != nil {
+		return slog.StringValue(fmt.Sprintf("<config: %v>", err))
+	}
+	return slog.AnyValue(tree)
+}
+
+func (c Config) redacted() Config {
+	c.Database.URL = RedactURL(c.Database.URL)
+	c.Database.DevURL = RedactURL(c.Database.DevURL)
+	c.Auth.JWTSecret = redact(c.Auth.JWTSecret)
+	c.Auth.AdminPassword = redact(c.Auth.AdminPassword)
+	return c
+}
+
+// RedactURL hides the password of a PostgreSQL connection string, in either
+// URL or key=value form
+func RedactURL(raw string) string {
+	if !strings.Contains(raw, "://") {
+		return dsnPassword.ReplaceAllString(raw, "${1}xxxxx")
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redact(raw)
+	}
+	if q := u.Query(); q.Has("password") {
+		q.Set("password", "xxxxx")
+		u.RawQuery = q.Encode()
+	}
+	return u.Redacted()
+}
+
+// dsnPassword matches the password of a key=value connection string
+var dsnPassword = regexp.MustCompile(`(password\s*=\s*)('(?:[^'\\]|\\.)*'|\S+)`)
+
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return "xxxxx"
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// setting binds one configuration value to its environment variable and flag
+type setting struct {
+	env   string
+	flag  string // Empty when the value has no flag
+	usage string
+	set   func(string) error
+	bool  bool // The flag may be given without a value
+}
+
+// settings lists everything that can be set from the environment or flags.
+// Later entries win when two set the same value, so PORT, which hosting
+// platforms inject, yields to an explicit LISTEN_ADDR. Secrets, database URLs
+// included since they carry a password, have no flag so that they never show
+// up in process listings; set them in the environment or the config file.
+func settings(c *Config) []setting {
+	return []setting{
+		{env: "PORT", set: func(v string) error { c.Server.Addr = ":" + v; return nil }},
+		{env: "LISTEN_ADDR", flag: "addr", usage: "address the HTTP server listens on", set: stringVar(&c.Server.Addr)},
+		{env: "READ_HEADER_TIMEOUT", flag: "read-header-timeout", usage: "time allowed to read request headers", set: durationVar(&c.Server.ReadHeaderTimeout)},
+		{env: "READ_TIMEOUT", flag: "read-timeout", usage: "time allowed to read a whole request", set: durationVar(&c.Server.ReadTimeout)},
+		{env: "WRITE_TIMEOUT", flag: "write-timeout", usage: "time allowed to write a response", set: durationVar(&c.Server.WriteTimeout)},
+		{env: "IDLE_TIMEOUT", flag: "idle-timeout", usage: "how long idle keep-alive connections stay open", set: durationVar(&c.Server.IdleTimeout)},
+		{env: "REQUEST_TIMEOUT", flag: "request-timeout", usage: "deadline for handlers and their database queries", set: durationVar(&c.Server.RequestTimeout)},
+		{env: "MAX_BODY_BYTES", flag: "max-body-bytes", usage: "largest request body accepted in bytes, 0 for no limit", set: intVar(&c.Server.MaxBodyBytes)},
+		{env: "SHUTDOWN_DELAY", flag: "shutdown-delay", usage: "time readiness fails before the server stops accepting connections", set: durationVar(&c.Server.ShutdownDelay)},
+		{env: "SHUTDOWN_TIMEOUT", flag: "shutdown-timeout", usage: "time allowed to drain requests on SIGTERM", set: durationVar(&c.Server.ShutdownTimeout)},
+		{env: "CORS_ALLOWED_ORIGINS", flag: "cors-origins", usage: "comma-separated origins allowed by CORS", set: listVar(&c.CORS.AllowedOrigins)},
+		{env: "DATABASE_URL", set: stringVar(&c.Database.URL)},
+		{env: "DEV_DATABASE_URL", set: stringVar(&c.Database.DevURL)},
+		{env: "DB_MAX_OPEN_CONNS", flag: "db-max-open-conns", usage: "maximum open database connections, 0 for no limit", set: intVar(&c.Database.MaxOpenConns)},
+		{env: "DB_MAX_IDLE_CONNS", flag: "db-max-idle-conns", usage: "maximum idle database connections", set: intVar(&c.Database.MaxIdleConns)},
+		{env: "DB_CONN_MAX_LIFETIME", flag: "db-conn-max-lifetime", usage: "how long a database connection is reused", set: durationVar(&c.Database.ConnMaxLifetime)},
+		{env: "AUTO_MIGRATE", flag: "auto-migrate", usage: "create the schema from ent/schema on start (development only)", set: boolVar(&c.Database.AutoMigrate), bool: true},
+		{env: "JWT_SECRET", set: stringVar(&c.Auth.JWTSecret)},
+		{env: "ADMIN_EMAIL", set: stringVar(&c.Auth.AdminEmail)},
+		{env: "ADMIN_PASSWORD", set: stringVar(&c.Auth.AdminPassword)},
+		{env: "TRASH_RETENTION", flag: "trash-retention", usage: "how long deleted items stay in the trash", set: durationVar(&c.Trash.Retention)},
+		{env: "FEATURE_SWAGGER", flag: "swagger", usage: "serve the API documentation", set: boolVar(&c.Features.Swagger), bool: true},
+		{env: "FEATURE_METRICS", flag: "metrics", usage: "serve Prometheus metrics under /metrics", set: boolVar(&c.Features.Metrics), bool: true},
+		{env: "FEATURE_VALIDATE_RESPONSES", flag: "validate-responses", usage: "log responses that do not match the OpenAPI document (development only)", set: boolVar(&c.Features.ValidateResponses), bool: true},
+		{env: "FEATURE_POSTGRES_SEARCH", flag: "postgres-search", usage: "use PostgreSQL full-text search when available", set: boolVar(&c.Features.PostgresSearch), bool: true},
+		{env: "LOG_LEVEL", flag: "log-level", usage: "minimum log level: debug, info, warn or error", set: stringVar(&c.Log.Level)},
+		{env: "LOG_FORMAT", flag: "log-format", usage: "log output format: json or text", set: stringVar(&c.Log.Format)},
+	}
+}
+
+func stringVar(p *string) func(string) error {
+	return func(v string) error {
+		*p = v
+		return nil
+	}
+}
+
+func intVar(p *int) func(string) error {
+	return func(v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		*p = n
+		return nil
+	}
+}
+
+func boolVar(p *bool) func(string) error {
+	return func(v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		*p = b
+		return nil
+	}
+}
+
+func durationVar(p *time.Duration) func(string) error {
+	return func(v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*p = d
+		return nil
+	}
+}
+
+func listVar(p *[]string) func(string) error {
+	return func(v string) error {
+		var list []string
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		*p = list
+		return nil
+	}
+}
@@ -2,39 +2,91 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
-	"os"
+	"log/slog"
 
 	"project-manager/ent"
+	_ "project-manager/ent/runtime" // Registers schema defaults, hooks and interceptors
+	"project-manager/internal/audit"
+	"project-manager/internal/config"
+	"project-manager/internal/logging"
+	"project-manager/internal/metrics"
+	"project-manager/internal/revision"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
 )
 
-var Client *ent.Client
+// DB is the connection pool underneath the client returned by InitDB, for
+// the few queries ent cannot express
+var DB *sql.DB
 
-// InitDB initializes the database connection
-func InitDB() (*ent.Client, error) {
-	fmt.Println("Starting database connection")
-	// Load environment variables
-	connectionString := os.Getenv("DATABASE_URL")
-	fmt.Println("connection string: ", connectionString)
-	if connectionString == "" {
-		return nil, fmt.Errorf("database URL not found in environment variables")
+// autoMigrated is set when the schema was created from ent/schema rather
+// than by versioned migrations
+var autoMigrated bool
+
+// Connect opens the PostgreSQL connection pool described by cfg
+func Connect(cfg config.Database) (*sql.DB, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("database URL is not configured")
 	}
+	slog.Info("connecting to the database", "url", config.RedactURL(cfg.URL))
 
 	// Open connection to PostgreSQL
-	client, err := ent.Open("postgres", connectionString)
+	db, err := sql.Open("postgres", cfg.URL)
 	if err != nil {
 		return nil, fmt.Errorf("failed opening connection to postgres: %v", err)
 	}
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	return db, nil
+}
+
+// UseHooks registers the audit and revision hooks every client must run with
+func UseHooks(client *ent.Client) {
+	// Record every write to projects, packages and clients in the audit log
+	client.Use(audit.Hook())
+	// Keep a revision of every saved project version
+	client.Projects.Use(revision.Hook())
+}
+
+// InitDB initializes the database connection and returns the client. The
+// schema must already be migrated with "migrate up", unless cfg.AutoMigrate
+// asks for it to be created straight from ent/schema, which is only meant
+// for development databases.
+func InitDB(cfg config.Database) (*ent.Client, error) {
+	db, err := Connect(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// Count, time and log every statement ent runs
+	client := ent.NewClient(ent.Driver(metrics.Driver(logging.Driver(entsql.OpenDB(dialect.Postgres, db)))))
+
+	UseHooks(client)
 
-	// Run the auto migration tool
-	if err := client.Schema.Create(context.Background()); err != nil {
-		return nil, fmt.Errorf("failed creating schema resources: %v", err)
+	ctx := context.Background()
+	if cfg.AutoMigrate {
+		// Run the auto migration tool
+		err := withMigrationLock(ctx, db, func() error {
+			return client.Schema.Create(ctx)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed creating schema resources: %v", err)
+		}
+		// Auto-migration never drops columns, so move stacks stored as JSON
+		// strings into the stacks table here; versioned migrations do it in SQL
+		if err := migrateLegacyStacks(db, client); err != nil {
+			return nil, fmt.Errorf("failed migrating legacy stacks: %v", err)
+		}
+	} else if err := checkMigrated(ctx, db); err != nil {
+		return nil, err
 	}
 
-	// Set the global client
-	Client = client
+	DB = db
+	autoMigrated = cfg.AutoMigrate
 
 	return client, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,262 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	entmigrate "project-manager/ent/migrate"
+
+	atlasmigrate "ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// MigrationsDir holds the versioned migration files, relative to the module
+// root. They are generated from ent/schema by "migrate diff" and embedded in
+// the binary.
+const MigrationsDir = "internal/database/migrations"
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockKey identifies the advisory lock held while the schema changes
+const migrationLockKey = 7_305_482_115
+
+// migrationLockTimeout is how long a replica waits for another to finish migrating
+const migrationLockTimeout = 5 * time.Minute
+
+// MigrationStatus describes how the database compares to the embedded migrations
+type MigrationStatus struct {
+	Current uint   // Applied version, 0 when none
+	Dirty   bool   // A migration failed half way and needs "migrate force"
+	Latest  uint   // Newest embedded version
+	Pending []uint // Embedded versions newer than Current
+}
+
+// MigrateUp applies every pending migration
+func MigrateUp(ctx context.Context, db *sql.DB) error {
+	return withMigrator(ctx, db, func(m *migrate.Migrate) error {
+		if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return err
+		}
+		return nil
+	})
+}
+
+// MigrateDown reverts the last steps migrations
+func MigrateDown(ctx context.Context, db *sql.DB, steps int) error {
+	return withMigrator(ctx, db, func(m *migrate.Migrate) error {
+		return m.Steps(-steps)
+	})
+}
+
+// MigrateForce records version as applied without running anything. It is
+// used to clear a dirty state, or to adopt a database deployed before
+// versioned migrations: the baseline, 20261016044446, is that schema exactly,
+// so forcing it and running MigrateUp brings such a database up to date,
+// legacy stack data included.
+func MigrateForce(ctx context.Context, db *sql.DB, version int) error {
+	return withMigrator(ctx, db, func(m *migrate.Migrate) error {
+		return m.Force(version)
+	})
+}
+
+// Status reads the applied version and lists the pending migrations
+func Status(ctx context.Context, db *sql.DB) (MigrationStatus, error) {
+	var status MigrationStatus
+
+	versions, err := embeddedVersions()
+	if err != nil {
+		return status, err
+	}
+	if len(versions) > 0 {
+		status.Latest = versions[len(versions)-1]
+	}
+
+	// The table is created by the first "migrate up"
+	var tracked bool
+	if err := db.QueryRowContext(ctx, `SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&tracked); err != nil {
+		return status, fmt.Errorf("failed reading schema version: %v", err)
+	}
+	if tracked {
+		var current int64
+		err := db.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&current, &status.Dirty)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return status, fmt.Errorf("failed reading schema version: %v", err)
+		}
+		status.Current = uint(current)
+	}
+
+	for _, v := range versions {
+		if v > status.Current {
+			status.Pending = append(status.Pending, v)
+		}
+	}
+	return status, nil
+}
+
+// checkMigrated refuses to start against a database with pending or failed migrations
+func checkMigrated(ctx context.Context, db *sql.DB) error {
+	status, err := Status(ctx, db)
+	if err != nil {
+		return err
+	}
+	if status.Dirty {
+		return fmt.Errorf("migration %d failed part way; fix the schema and run \"migrate force\"", status.Current)
+	}
+	if len(status.Pending) > 0 {
+		return fmt.Errorf("database schema is at version %d but %d is required; run \"migrate up\"", status.Current, status.Latest)
+	}
+	return nil
+}
+
+// CheckSchema reports whether the schema of DB is current. It always passes
+// when the schema was auto-migrated, which keeps no version.
+func CheckSchema(ctx context.Context) error {
+	if autoMigrated {
+		return nil
+	}
+	return checkMigrated(ctx, DB)
+}
+
+// DiffMigration writes a new migration that moves the schema from the state of
+// the migration directory to the one in ent/schema. devURL must point at an
+// empty PostgreSQL database, which is used to replay the existing files.
+func DiffMigration(ctx context.Context, devURL, name string) error {
+	dir, err := sqltool.NewGolangMigrateDir(MigrationsDir)
+	if err != nil {
+		return fmt.Errorf("failed opening migration directory: %v", err)
+	}
+	return entmigrate.NamedDiff(ctx, devURL, name,
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+	)
+}
+
+// NewMigration writes an empty up/down pair for a hand-written migration, such
+// as a data backfill, and updates the directory checksum
+func NewMigration(name string) (string, error) {
+	dir, err := sqltool.NewGolangMigrateDir(MigrationsDir)
+	if err != nil {
+		return "", fmt.Errorf("failed opening migration directory: %v", err)
+	}
+
+	version := time.Now().UTC().Format("20060102150405")
+	base := version + "_" + name
+	for _, file := range []string{base + ".up.sql", base + ".down.sql"} {
+		if err := dir.WriteFile(file, nil); err != nil {
+			return "", err
+		}
+	}
+
+	sum, err := dir.Checksum()
+	if err != nil {
+		return "", err
+	}
+	if err := atlasmigrate.WriteSumFile(dir, sum); err != nil {
+		return "", err
+	}
+	return filepath.Join(MigrationsDir, base+".up.sql"), nil
+}
+
+// withMigrator runs fn with a migrator over the embedded files while holding
+// the migration lock
+func withMigrator(ctx context.Context, db *sql.DB, fn func(*migrate.Migrate) error) error {
+	return withMigrationLock(ctx, db, func() error {
+		source, err := iofs.New(migrationFiles, "migrations")
+		if err != nil {
+			return err
+		}
+		driver, err := postgres.WithInstance(db, &postgres.Config{})
+		if err != nil {
+			return err
+		}
+		m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
+		if err != nil {
+			return err
+		}
+		m.Log = migrateLogger{}
+		return fn(m)
+	})
+}
+
+// withMigrationLock holds a PostgreSQL advisory lock while fn runs, so that
+// replicas starting together never change the schema at the same time
+func withMigrationLock(ctx context.Context, db *sql.DB, fn func() error) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(migrationLockTimeout)
+	for {
+		var locked bool
+		if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, migrationLockKey).Scan(&locked); err != nil {
+			return fmt.Errorf("failed taking migration lock: %v", err)
+		}
+		if locked {
+			break
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("another instance has been migrating the schema for over %s", migrationLockTimeout)
+		}
+		slog.InfoContext(ctx, "waiting for another instance to finish migrating the schema")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockKey)
+
+	return fn()
+}
+
+// embeddedVersions lists the versions of the embedded migrations in order
+func embeddedVersions() ([]uint, error) {
+	files, err := fs.Glob(migrationFiles, "migrations/*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+	var versions []uint
+	for _, file := range files {
+		prefix, _, _ := strings.Cut(filepath.Base(file), "_")
+		v, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s has no version prefix", file)
+		}
+		versions = append(versions, uint(v))
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+// migrateLogger logs each migration as it is applied
+type migrateLogger struct{}
+
+func (migrateLogger) Printf(format string, v ...any) {
+	slog.Info(strings.TrimSpace(fmt.Sprintf(format, v...)))
+}
+
+func (migrateLogger) Verbose() bool {
+	return false
+}
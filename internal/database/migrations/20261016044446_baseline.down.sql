@@ -0,0 +1,10 @@
+-- reverse: create "projects" table
+DROP TABLE "projects";
+-- reverse: create index "packages_name_key" to table: "packages"
+DROP INDEX "packages_name_key";
+-- reverse: create "packages" table
+DROP TABLE "packages";
+-- reverse: create index "clients_name_key" to table: "clients"
+DROP INDEX "clients_name_key";
+-- reverse: create "clients" table
+DROP TABLE "clients";
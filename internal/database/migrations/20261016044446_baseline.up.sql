@@ -0,0 +1,12 @@
+-- the schema deployed before versioned migrations, as ent's Schema.Create built
+-- it; such a database is adopted with "migrate force 20261016044446"
+-- create "clients" table
+CREATE TABLE "clients" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "link" character varying NULL, "image_url" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "clients_name_key" to table: "clients"
+CREATE UNIQUE INDEX "clients_name_key" ON "clients" ("name");
+-- create "packages" table
+CREATE TABLE "packages" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "link" character varying NULL, "description" character varying NULL, "stacks" character varying NOT NULL DEFAULT '[]', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "packages_name_key" to table: "packages"
+CREATE UNIQUE INDEX "packages_name_key" ON "packages" ("name");
+-- create "projects" table
+CREATE TABLE "projects" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "image_url" character varying NULL, "link" character varying NULL, "description" character varying NULL, "stacks" character varying NOT NULL DEFAULT '[]', PRIMARY KEY ("id"));
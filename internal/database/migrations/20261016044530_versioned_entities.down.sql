@@ -0,0 +1,53 @@
+-- restore the JSON "stacks" columns from the stack edges
+ALTER TABLE "packages" ADD COLUMN "stacks" character varying NOT NULL DEFAULT '[]';
+UPDATE "packages" AS p SET "stacks" = e."names"
+FROM (
+  SELECT sp."packages_id" AS "id", json_agg(s."name" ORDER BY s."name")::text AS "names"
+  FROM "stacks_packages" AS sp JOIN "stacks" AS s ON s."id" = sp."stacks_id" GROUP BY sp."packages_id"
+) AS e
+WHERE p."id" = e."id";
+ALTER TABLE "projects" ADD COLUMN "stacks" character varying NOT NULL DEFAULT '[]';
+UPDATE "projects" AS p SET "stacks" = e."names"
+FROM (
+  SELECT sp."projects_id" AS "id", json_agg(s."name" ORDER BY s."name")::text AS "names"
+  FROM "stacks_projects" AS sp JOIN "stacks" AS s ON s."id" = sp."stacks_id" GROUP BY sp."projects_id"
+) AS e
+WHERE p."id" = e."id";
+-- reverse: create "stacks_projects" table
+DROP TABLE "stacks_projects";
+-- reverse: create "stacks_packages" table
+DROP TABLE "stacks_packages";
+-- reverse: create index "stacks_slug_key" to table: "stacks"
+DROP INDEX "stacks_slug_key";
+-- reverse: create "stacks" table
+DROP TABLE "stacks";
+-- reverse: create "projects_packages" table
+DROP TABLE "projects_packages";
+-- reverse: create index "packages_deleted_at" to table: "packages"
+DROP INDEX "packages_deleted_at";
+-- reverse: modify "packages" table
+ALTER TABLE "packages" DROP COLUMN "deleted_at", DROP COLUMN "version";
+-- reverse: create index "projectrevisions_project_id_revision" to table: "project_revisions"
+DROP INDEX "projectrevisions_project_id_revision";
+-- reverse: create "project_revisions" table
+DROP TABLE "project_revisions";
+-- reverse: create index "projects_deleted_at" to table: "projects"
+DROP INDEX "projects_deleted_at";
+-- reverse: modify "projects" table
+ALTER TABLE "projects" DROP CONSTRAINT "projects_clients_projects", DROP COLUMN "clients_projects", DROP COLUMN "deleted_at", DROP COLUMN "version";
+-- reverse: create index "clients_deleted_at" to table: "clients"
+DROP INDEX "clients_deleted_at";
+-- reverse: modify "clients" table
+ALTER TABLE "clients" DROP COLUMN "deleted_at", DROP COLUMN "version";
+-- reverse: create index "users_email_key" to table: "users"
+DROP INDEX "users_email_key";
+-- reverse: create "users" table
+DROP TABLE "users";
+-- reverse: create index "auditevents_created_at" to table: "audit_events"
+DROP INDEX "auditevents_created_at";
+-- reverse: create index "auditevents_actor_id" to table: "audit_events"
+DROP INDEX "auditevents_actor_id";
+-- reverse: create index "auditevents_entity_type_entity_id" to table: "audit_events"
+DROP INDEX "auditevents_entity_type_entity_id";
+-- reverse: create "audit_events" table
+DROP TABLE "audit_events";
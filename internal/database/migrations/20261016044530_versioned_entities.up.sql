@@ -0,0 +1,70 @@
+-- create "audit_events" table
+CREATE TABLE "audit_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "entity_type" character varying NOT NULL, "entity_id" bigint NOT NULL, "operation" character varying NOT NULL, "actor_id" bigint NULL, "changes" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditevents_entity_type_entity_id" to table: "audit_events"
+CREATE INDEX "auditevents_entity_type_entity_id" ON "audit_events" ("entity_type", "entity_id");
+-- create index "auditevents_actor_id" to table: "audit_events"
+CREATE INDEX "auditevents_actor_id" ON "audit_events" ("actor_id");
+-- create index "auditevents_created_at" to table: "audit_events"
+CREATE INDEX "auditevents_created_at" ON "audit_events" ("created_at");
+-- create "users" table
+CREATE TABLE "users" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "email" character varying NOT NULL, "name" character varying NULL, "password_hash" character varying NOT NULL, "role" character varying NOT NULL DEFAULT 'viewer', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_key" ON "users" ("email");
+-- modify "clients" table
+ALTER TABLE "clients" ADD COLUMN "version" bigint NOT NULL DEFAULT 1, ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "clients_deleted_at" to table: "clients"
+CREATE INDEX "clients_deleted_at" ON "clients" ("deleted_at");
+-- modify "projects" table
+ALTER TABLE "projects" ADD COLUMN "version" bigint NOT NULL DEFAULT 1, ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "clients_projects" bigint NULL, ADD CONSTRAINT "projects_clients_projects" FOREIGN KEY ("clients_projects") REFERENCES "clients" ("id") ON DELETE SET NULL;
+-- create index "projects_deleted_at" to table: "projects"
+CREATE INDEX "projects_deleted_at" ON "projects" ("deleted_at");
+-- create "project_revisions" table
+CREATE TABLE "project_revisions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "revision" bigint NOT NULL, "name" character varying NOT NULL, "image_url" character varying NULL, "link" character varying NULL, "description" character varying NULL, "stacks" jsonb NULL, "client_id" bigint NULL, "package_ids" jsonb NULL, "created_at" timestamptz NOT NULL, "project_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "project_revisions_projects_revisions" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON DELETE CASCADE);
+-- create index "projectrevisions_project_id_revision" to table: "project_revisions"
+CREATE UNIQUE INDEX "projectrevisions_project_id_revision" ON "project_revisions" ("project_id", "revision");
+-- modify "packages" table
+ALTER TABLE "packages" ADD COLUMN "version" bigint NOT NULL DEFAULT 1, ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "packages_deleted_at" to table: "packages"
+CREATE INDEX "packages_deleted_at" ON "packages" ("deleted_at");
+-- create "projects_packages" table
+CREATE TABLE "projects_packages" ("projects_id" bigint NOT NULL, "packages_id" bigint NOT NULL, PRIMARY KEY ("projects_id", "packages_id"), CONSTRAINT "projects_packages_projects_id" FOREIGN KEY ("projects_id") REFERENCES "projects" ("id") ON DELETE CASCADE, CONSTRAINT "projects_packages_packages_id" FOREIGN KEY ("packages_id") REFERENCES "packages" ("id") ON DELETE CASCADE);
+-- create "stacks" table
+CREATE TABLE "stacks" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "slug" character varying NOT NULL, "category" character varying NULL, "icon_url" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "stacks_slug_key" to table: "stacks"
+CREATE UNIQUE INDEX "stacks_slug_key" ON "stacks" ("slug");
+-- create "stacks_packages" table
+CREATE TABLE "stacks_packages" ("stacks_id" bigint NOT NULL, "packages_id" bigint NOT NULL, PRIMARY KEY ("stacks_id", "packages_id"), CONSTRAINT "stacks_packages_stacks_id" FOREIGN KEY ("stacks_id") REFERENCES "stacks" ("id") ON DELETE CASCADE, CONSTRAINT "stacks_packages_packages_id" FOREIGN KEY ("packages_id") REFERENCES "packages" ("id") ON DELETE CASCADE);
+-- create "stacks_projects" table
+CREATE TABLE "stacks_projects" ("stacks_id" bigint NOT NULL, "projects_id" bigint NOT NULL, PRIMARY KEY ("stacks_id", "projects_id"), CONSTRAINT "stacks_projects_stacks_id" FOREIGN KEY ("stacks_id") REFERENCES "stacks" ("id") ON DELETE CASCADE, CONSTRAINT "stacks_projects_projects_id" FOREIGN KEY ("projects_id") REFERENCES "projects" ("id") ON DELETE CASCADE);
+-- "legacy_stack_names" reads a JSON array of stack names; anything else yields
+-- no rows, as the unparsable values skipped by the application's copy did
+CREATE FUNCTION "legacy_stack_names"(raw text) RETURNS SETOF text LANGUAGE plpgsql AS $$
+BEGIN
+  RETURN QUERY SELECT jsonb_array_elements_text(raw::jsonb);
+EXCEPTION WHEN others THEN
+  RETURN;
+END $$;
+-- collect the names from the JSON "stacks" columns, slugged as internal/slug does
+CREATE TABLE "legacy_stacks" AS
+SELECT "owner", "owner_id", "name",
+  trim(BOTH '-' FROM regexp_replace(
+    replace(replace(replace(lower(trim("name")), '#', 'sharp'), '+', 'plus'), '.', ''),
+    '[^[:alnum:]]+', '-', 'g')) AS "slug"
+FROM (
+  SELECT 'project' AS "owner", p."id" AS "owner_id", s."name" FROM "projects" AS p, "legacy_stack_names"(p."stacks") AS s("name")
+  UNION ALL
+  SELECT 'package', p."id", s."name" FROM "packages" AS p, "legacy_stack_names"(p."stacks") AS s("name")
+) AS l;
+-- one stack per slug, named as it was first written
+INSERT INTO "stacks" ("name", "slug", "created_at", "updated_at")
+SELECT DISTINCT ON ("slug") "name", "slug", now(), now() FROM "legacy_stacks"
+WHERE "slug" <> '' ORDER BY "slug", "owner" DESC, "owner_id";
+INSERT INTO "stacks_projects" ("stacks_id", "projects_id")
+SELECT DISTINCT s."id", l."owner_id" FROM "legacy_stacks" AS l JOIN "stacks" AS s ON s."slug" = l."slug" WHERE l."owner" = 'project';
+INSERT INTO "stacks_packages" ("stacks_id", "packages_id")
+SELECT DISTINCT s."id", l."owner_id" FROM "legacy_stacks" AS l JOIN "stacks" AS s ON s."slug" = l."slug" WHERE l."owner" = 'package';
+DROP TABLE "legacy_stacks";
+DROP FUNCTION "legacy_stack_names"(text);
+-- drop the legacy JSON columns now that their stacks are rows
+ALTER TABLE "projects" DROP COLUMN "stacks";
+ALTER TABLE "packages" DROP COLUMN "stacks";
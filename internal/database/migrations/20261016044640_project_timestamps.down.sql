@@ -0,0 +1,2 @@
+-- reverse: add timestamps to "projects"
+ALTER TABLE "projects" DROP COLUMN "updated_at", DROP COLUMN "created_at";
@@ -0,0 +1,15 @@
+-- add timestamps to "projects", nullable until existing rows are backfilled
+ALTER TABLE "projects" ADD COLUMN "created_at" timestamptz NULL, ADD COLUMN "updated_at" timestamptz NULL;
+-- backfill from the earliest and latest audit events and revisions of each project
+UPDATE "projects" AS p SET "created_at" = h."first", "updated_at" = h."last"
+FROM (
+  SELECT "id", min("at") AS "first", max("at") AS "last" FROM (
+    SELECT "entity_id" AS "id", "created_at" AS "at" FROM "audit_events" WHERE "entity_type" = 'project'
+    UNION ALL
+    SELECT "project_id", "created_at" FROM "project_revisions"
+  ) AS e GROUP BY "id"
+) AS h
+WHERE p."id" = h."id";
+-- projects without any history are stamped with the migration time
+UPDATE "projects" SET "created_at" = now(), "updated_at" = now() WHERE "created_at" IS NULL;
+ALTER TABLE "projects" ALTER COLUMN "created_at" SET NOT NULL, ALTER COLUMN "updated_at" SET NOT NULL;
@@ -0,0 +1,8 @@
+-- reverse: create index "packages_name" to table: "packages"
+DROP INDEX "packages_name";
+-- reverse: drop index "packages_name_key" from table: "packages"
+CREATE UNIQUE INDEX "packages_name_key" ON "packages" ("name");
+-- reverse: create index "clients_name" to table: "clients"
+DROP INDEX "clients_name";
+-- reverse: drop index "clients_name_key" from table: "clients"
+CREATE UNIQUE INDEX "clients_name_key" ON "clients" ("name");
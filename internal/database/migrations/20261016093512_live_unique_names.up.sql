@@ -0,0 +1,8 @@
+-- drop index "clients_name_key" from table: "clients"
+DROP INDEX "clients_name_key";
+-- create index "clients_name" to table: "clients"
+CREATE UNIQUE INDEX "clients_name" ON "clients" ("name") WHERE (deleted_at IS NULL);
+-- drop index "packages_name_key" from table: "packages"
+DROP INDEX "packages_name_key";
+-- create index "packages_name" to table: "packages"
+CREATE UNIQUE INDEX "packages_name" ON "packages" ("name") WHERE (deleted_at IS NULL);
@@ -0,0 +1,43 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"project-manager/ent"
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
+	"project-manager/ent/projects"
+	"project-manager/ent/schema"
+)
+
+// PurgeResult counts the rows Purge removed from each table
+type PurgeResult struct {
+	Projects int
+	Packages int
+	Clients  int
+}
+
+// Purge permanently deletes projects, packages and clients that were moved to
+// the trash before cutoff. Join rows go with them through ON DELETE CASCADE.
+func Purge(ctx context.Context, client *ent.Client, cutoff time.Time) (PurgeResult, error) {
+	var result PurgeResult
+	ctx = schema.SkipSoftDelete(ctx)
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return PurgeResult{}, err
+	}
+	defer tx.Rollback()
+
+	if result.Projects, err = tx.Projects.Delete().Where(projects.DeletedAtLT(cutoff)).Exec(ctx); err != nil {
+		return PurgeResult{}, err
+	}
+	if result.Packages, err = tx.Packages.Delete().Where(packages.DeletedAtLT(cutoff)).Exec(ctx); err != nil {
+		return PurgeResult{}, err
+	}
+	if result.Clients, err = tx.Clients.Delete().Where(clients.DeletedAtLT(cutoff)).Exec(ctx); err != nil {
+		return PurgeResult{}, err
+	}
+	return result, tx.Commit()
+}
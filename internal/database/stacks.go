@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"project-manager/ent"
+	"project-manager/ent/packages"
+	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"project-manager/internal/slug"
+)
+
+// EnsureStacks returns the IDs of the stacks with the given names, creating any
+// that do not exist yet. Names are matched by slug, so "Go" and "go" are the same stack.
+func EnsureStacks(ctx context.Context, client *ent.Client, names []string) ([]int, error) {
+	ids := []int{}
+	seen := map[string]bool{}
+	for _, name := range names {
+		s := slug.Make(name)
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+
+		stack, err := client.Stacks.Query().Where(stacks.Slug(s)).Only(ctx)
+		if ent.IsNotFound(err) {
+			stack, err = client.Stacks.Create().
+				SetName(name).
+				SetSlug(s).
+				Save(ctx)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed resolving stack %q: %v", name, err)
+		}
+		ids = append(ids, stack.ID)
+	}
+	return ids, nil
+}
+
+// migrateLegacyStacks moves the JSON-encoded "stacks" column that projects and
+// packages used to carry into Stacks rows and edges, then drops the column.
+// It is a no-op once the column is gone, and safe to re-run if interrupted.
+func migrateLegacyStacks(db *sql.DB, client *ent.Client) error {
+	ctx := context.Background()
+
+	for _, table := range []string{projects.Table, packages.Table} {
+		var exists bool
+		err := db.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = $1 AND column_name = 'stacks')`,
+			table,
+		).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("failed inspecting %s table: %v", table, err)
+		}
+		if !exists {
+			continue
+		}
+
+		legacy, err := readLegacyStacks(ctx, db, table)
+		if err != nil {
+			return err
+		}
+
+		for id, names := range legacy {
+			stackIDs, err := EnsureStacks(ctx, client, names)
+			if err != nil {
+				return err
+			}
+			// Removing before adding keeps a re-run from duplicating join rows
+			switch table {
+			case projects.Table:
+				err = client.Projects.UpdateOneID(id).RemoveStackIDs(stackIDs...).AddStackIDs(stackIDs...).Exec(ctx)
+			case packages.Table:
+				err = client.Packages.UpdateOneID(id).RemoveStackIDs(stackIDs...).AddStackIDs(stackIDs...).Exec(ctx)
+			}
+			if err != nil {
+				return fmt.Errorf("failed linking stacks to %s %d: %v", table, id, err)
+			}
+		}
+
+		if _, err := db.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %q DROP COLUMN "stacks"`, table)); err != nil {
+			return fmt.Errorf("failed dropping %s.stacks: %v", table, err)
+		}
+		slog.InfoContext(ctx, "migrated legacy stacks", "table", table, "rows", len(legacy))
+	}
+
+	return nil
+}
+
+func readLegacyStacks(ctx context.Context, db *sql.DB, table string) (map[int][]string, error) {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(`SELECT "id", "stacks" FROM %q`, table))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading %s.stacks: %v", table, err)
+	}
+	defer rows.Close()
+
+	legacy := map[int][]string{}
+	for rows.Next() {
+		var (
+			id  int
+			raw sql.NullString
+		)
+		if err := rows.Scan(&id, &raw); err != nil {
+			return nil, fmt.Errorf("failed reading %s.stacks: %v", table, err)
+		}
+
+		var names []string
+		if err := json.Unmarshal([]byte(raw.String), &names); err != nil {
+			slog.WarnContext(ctx, "skipping unparsable legacy stacks", "table", table, "id", id, "stacks", raw.String)
+			continue
+		}
+		if len(names) > 0 {
+			legacy[id] = names
+		}
+	}
+	return legacy, rows.Err()
+}
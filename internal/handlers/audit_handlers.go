@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"project-manager/ent"
+	"project-manager/ent/auditevents"
+	"project-manager/internal/listing"
+	"project-manager/internal/mapper"
+	"project-manager/internal/models"
+	"project-manager/internal/problem"
+)
+
+// auditListSpec lists the fields GetAuditHandler can sort on; newest first by default
+var auditListSpec = listing.Spec[*ent.AuditEvents]{
+	IDName:      "id",
+	DefaultSort: "-id",
+	Fields: map[string]listing.Field[*ent.AuditEvents]{
+		"id":         {Column: auditevents.FieldID, Kind: listing.KindInt, Value: func(e *ent.AuditEvents) any { return e.ID }},
+		"created_at": {Column: auditevents.FieldCreatedAt, Kind: listing.KindTime, Value: func(e *ent.AuditEvents) any { return e.CreatedAt }},
+	},
+}
+
+// GetAuditHandler lists audit events. It supports the usual paging and
+// sorting, and the entity, entity_id, actor, since and until filters;
+// since and until are RFC 3339 timestamps.
+func (h *Handler) GetAuditHandler(w http.ResponseWriter, r *http.Request) {
+	params, err := listing.Parse(r, auditListSpec)
+	if err != nil {
+		problem.BadRequest(w, r, err.Error())
+		return
+	}
+
+	query := h.client.AuditEvents.Query()
+	filters := r.URL.Query()
+	if v := filters.Get("entity"); v != "" {
+		query.Where(auditevents.EntityType(v))
+	}
+	if v := filters.Get("entity_id"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			problem.BadRequest(w, r, "entity_id must be an integer")
+			return
+		}
+		query.Where(auditevents.EntityID(id))
+	}
+	if v := filters.Get("actor"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			problem.BadRequest(w, r, "actor must be a user ID")
+			return
+		}
+		query.Where(auditevents.ActorID(id))
+	}
+	if v := filters.Get("since"); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			problem.BadRequest(w, r, "since must be an RFC 3339 timestamp")
+			return
+		}
+		query.Where(auditevents.CreatedAtGTE(since))
+	}
+	if v := filters.Get("until"); v != "" {
+		until, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			problem.BadRequest(w, r, "until must be an RFC 3339 timestamp")
+			return
+		}
+		query.Where(auditevents.CreatedAtLT(until))
+	}
+
+	total, err := query.Clone().Count(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "Audit event")
+		return
+	}
+
+	if after := params.After(); after != nil {
+		query.Where(after)
+	}
+	for _, order := range params.Order() {
+		query.Order(order)
+	}
+	limit, offset := params.Window()
+
+	list, err := query.Limit(limit).Offset(offset).All(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "Audit event")
+		return
+	}
+	list = listing.Page(w, r, params, list, total)
+
+	response := []models.AuditEventResponse{}
+	for _, event := range list {
+		response = append(response, mapper.AuditEvent(event))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
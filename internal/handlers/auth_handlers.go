@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"project-manager/ent"
+	"project-manager/ent/users"
+	"project-manager/internal/auth"
+	"project-manager/internal/models"
+	"project-manager/internal/problem"
+	"project-manager/internal/validation"
+)
+
+// LoginHandler exchanges an email and password for an access/refresh token pair
+func (h *Handler) LoginHandler(w http.ResponseWriter, r *http.Request) {
+	var loginData models.LoginData
+
+	if err := json.NewDecoder(r.Body).Decode(&loginData); err != nil {
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
+		return
+	}
+
+	if errs := validation.Struct(&loginData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
+		return
+	}
+
+	user, err := h.client.Users.Query().
+		Where(users.Email(loginData.Email)).
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			auth.CheckNoPassword(loginData.Password)
+			problem.Unauthorized(w, r, "Invalid email or password")
+		} else {
+			problem.FromError(w, r, err, "User")
+		}
+		return
+	}
+
+	if !auth.CheckPassword(user.PasswordHash, loginData.Password) {
+		problem.Unauthorized(w, r, "Invalid email or password")
+		return
+	}
+
+	writeTokens(w, r, user)
+}
+
+// RefreshTokenHandler issues a new token pair from a valid refresh token
+func (h *Handler) RefreshTokenHandler(w http.ResponseWriter, r *http.Request) {
+	var refreshData models.RefreshData
+
+	if err := json.NewDecoder(r.Body).Decode(&refreshData); err != nil {
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
+		return
+	}
+
+	claims, err := auth.ParseToken(refreshData.RefreshToken, auth.TokenTypeRefresh)
+	if err != nil {
+		problem.Unauthorized(w, r, "Invalid or expired refresh token")
+		return
+	}
+
+	userID, err := claims.UserID()
+	if err != nil {
+		problem.Unauthorized(w, r, "Invalid or expired refresh token")
+		return
+	}
+
+	// Reload the user so role changes and deletions take effect on refresh
+	user, err := h.client.Users.Get(r.Context(), userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			problem.Unauthorized(w, r, "Invalid or expired refresh token")
+		} else {
+			problem.FromError(w, r, err, "User")
+		}
+		return
+	}
+
+	writeTokens(w, r, user)
+}
+
+func writeTokens(w http.ResponseWriter, r *http.Request, user *ent.Users) {
+	accessToken, refreshToken, err := auth.IssueTokens(user.ID, user.Role)
+	if err != nil {
+		problem.Internal(w, r, err)
+		return
+	}
+
+	response := models.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(auth.AccessTokenTTL.Seconds()),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(response)
+}
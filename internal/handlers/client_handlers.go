@@ -1,186 +1,215 @@
 package handlers
 
 import (
-	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
-	"project-manager/ent"
-	"project-manager/internal/database"
+	"project-manager/internal/listing"
+	"project-manager/internal/mapper"
 	"project-manager/internal/models"
+	"project-manager/internal/problem"
+	"project-manager/internal/service"
+	"project-manager/internal/validation"
 
 	"github.com/gorilla/mux"
 )
 
-func CreateClientHandler(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) CreateClientHandler(w http.ResponseWriter, r *http.Request) {
 	var clientData models.ClientData
 
 	if err := json.NewDecoder(r.Body).Decode(&clientData); err != nil {
-		http.Error(w, "Invalid JSON format: "+err.Error(), http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
 		return
 	}
 
-	// Validate required fields
-	if clientData.Name == "" {
-		http.Error(w, "CLient name is required", http.StatusBadRequest)
-		return
-	}
-	if clientData.Link == "" {
-		http.Error(w, "Link is required", http.StatusBadRequest)
-		return
-	}
-	if clientData.ImageUrl == "" {
-		http.Error(w, "Image URL is required", http.StatusBadRequest)
+	// Validate required fields and formats
+	if errs := validation.Struct(&clientData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
 		return
 	}
 
 	// Create client
-	client, err := database.Client.Clients.Create().
-		SetName(clientData.Name).
-		SetLink(clientData.Link).
-		SetImageUrl(clientData.ImageUrl).
-		Save(context.Background())
-
+	client, err := h.clients.Create(r.Context(), clientData)
 	if err != nil {
-		http.Error(w, "Error creating client: "+err.Error(), http.StatusInternalServerError)
+		problem.FromError(w, r, err, "Client")
 		return
 	}
 
-	response := models.ClientResponse{
-		ID:         client.ID,
-		ClientData: clientData,
-	}
-
+	w.Header().Set("ETag", etag(client.Version))
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(mapper.Client(client))
 }
 
-func GetClientsHandler(w http.ResponseWriter, r *http.Request) {
-	clients, err := database.Client.Clients.Query().All(context.Background())
+// GetClientsHandler lists clients. It supports limit/offset or cursor paging,
+// sort=name,-created_at and the name_contains and updated_since filters;
+// updated_since is an RFC 3339 timestamp.
+func (h *Handler) GetClientsHandler(w http.ResponseWriter, r *http.Request) {
+	params, err := listing.Parse(r, service.ClientListSpec)
 	if err != nil {
-		http.Error(w, "Error fetching clients: "+err.Error(), http.StatusInternalServerError)
+		problem.BadRequest(w, r, err.Error())
 		return
 	}
 
-	var response []models.ClientResponse
-	for _, client := range clients {
+	query := r.URL.Query()
+	filter := service.ClientFilter{NameContains: query.Get("name_contains")}
+	if v := query.Get("updated_since"); v != "" {
+		if filter.UpdatedSince, err = time.Parse(time.RFC3339, v); err != nil {
+			problem.BadRequest(w, r, "updated_since must be an RFC 3339 timestamp")
+			return
+		}
+	}
 
-		response = append(response, models.ClientResponse{
-			ID: client.ID,
-			ClientData: models.ClientData{
-				Name:     client.Name,
-				Link:     client.Link,
-				ImageUrl: client.ImageUrl,
-			},
-		})
+	list, total, err := h.clients.List(r.Context(), filter, params)
+	if err != nil {
+		problem.FromError(w, r, err, "Client")
+		return
 	}
+	list = listing.Page(w, r, params, list, total)
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(mapper.Clients(list))
 }
 
-func GetClientByIDHandler(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) GetClientByIDHandler(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
 	id, err := strconv.Atoi(params["id"])
 	if err != nil {
-		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid client ID")
 		return
 	}
 
-	client, err := database.Client.Clients.Get(context.Background(), id)
+	client, err := h.clients.Get(r.Context(), id)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			http.Error(w, "CLient not found", http.StatusNotFound)
-		} else {
-			http.Error(w, "Internal server error", http.StatusInternalServerError)
-		}
+		problem.FromError(w, r, err, "Client")
 		return
 	}
 
-	response := models.ClientResponse{
-		ID: client.ID,
-		ClientData: models.ClientData{
-			Name:     client.Name,
-			Link:     client.Link,
-			ImageUrl: client.ImageUrl,
-		},
+	if notModified(w, r, etag(client.Version)) {
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(mapper.Client(client))
 }
 
-func UpdateClientHandler(w http.ResponseWriter, r *http.Request) {
+// UpdateClientHandler replaces a client. The body is validated like a create.
+// If-Match must carry the ETag of the version being replaced.
+func (h *Handler) UpdateClientHandler(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
-	idStr := params["id"]
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid client ID")
+		return
+	}
 
-	var id int64
-	if idStr == "" {
-		http.Error(w, "Missing client ID", http.StatusBadRequest)
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
 		return
 	}
-	fmt.Sscan(idStr, &id)
 
 	var clientData models.ClientData
 	if err := json.NewDecoder(r.Body).Decode(&clientData); err != nil {
-		http.Error(w, "Invalid JSON format", http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
 		return
 	}
 
-	update := database.Client.Clients.UpdateOneID(int(id))
-	if clientData.Name != "" {
-		update.SetName(clientData.Name)
-	}
-	if clientData.Link != "" {
-		update.SetLink(clientData.Link)
+	h.replaceClient(w, r, id, version, clientData)
+}
+
+// PatchClientHandler applies a JSON Merge Patch or JSON Patch to a client.
+// If-Match is required as for PUT.
+func (h *Handler) PatchClientHandler(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid client ID")
+		return
 	}
-	if clientData.ImageUrl != "" {
-		update.SetImageUrl(clientData.ImageUrl)
+
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
 	}
 
-	client, err := update.Save(context.Background())
+	current, err := h.clients.Get(r.Context(), id)
 	if err != nil {
-		http.Error(w, "Error updating client", http.StatusInternalServerError)
+		problem.FromError(w, r, err, "Client")
+		return
+	}
+	if version != anyVersion && version != current.Version {
+		problem.PreconditionFailed(w, r, "Client was modified since it was read")
 		return
 	}
 
-	response := models.ClientResponse{
-		ID: client.ID,
-		ClientData: models.ClientData{
-			Name:     client.Name,
-			Link:     client.Link,
-			ImageUrl: client.ImageUrl,
-		},
+	var clientData models.ClientData
+	if !applyPatch(w, r, mapper.Client(current).ClientData, &clientData) {
+		return
+	}
+
+	h.replaceClient(w, r, id, version, clientData)
+}
+
+// replaceClient validates clientData and overwrites every field of the
+// client, provided it is still at version (or version is anyVersion)
+func (h *Handler) replaceClient(w http.ResponseWriter, r *http.Request, id, version int, clientData models.ClientData) {
+	if errs := validation.Struct(&clientData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
+		return
 	}
 
+	client, err := h.clients.Replace(r.Context(), id, version, clientData)
+	if err != nil {
+		writeServiceError(w, r, err, "Client")
+		return
+	}
+
+	w.Header().Set("ETag", etag(client.Version))
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(mapper.Client(client))
 }
 
-func DeleteClientHandler(w http.ResponseWriter, r *http.Request) {
+// DeleteClientHandler moves a client whose version matches If-Match to the trash
+func (h *Handler) DeleteClientHandler(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
-	idStr := params["id"]
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid client ID")
+		return
+	}
+
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
+	}
 
-	// Convert string to int64
-	var id int64
-	var err error
-	if idStr == "" {
-		http.Error(w, "Missing client ID", http.StatusBadRequest)
+	if err := h.clients.Delete(r.Context(), id, version); err != nil {
+		writeServiceError(w, r, err, "Client")
 		return
 	}
-	if _, err := fmt.Sscan(idStr, &id); err != nil {
-		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"message": "Client moved to trash"})
+}
+
+// GetClientProjectsHandler lists the projects built for a client
+func (h *Handler) GetClientProjectsHandler(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid client ID")
 		return
 	}
 
-	err = database.Client.Clients.DeleteOneID(int(id)).Exec(context.Background())
+	projects, err := h.clients.Projects(r.Context(), id)
 	if err != nil {
-		http.Error(w, "Error deleting client: "+err.Error(), http.StatusInternalServerError)
+		problem.FromError(w, r, err, "Client")
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{"message": "CLient deleted successfully"})
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mapper.Projects(projects))
 }
@@ -0,0 +1,144 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"project-manager/ent"
+	"project-manager/internal/handlers"
+	"project-manager/internal/health"
+	"project-manager/internal/service"
+	"project-manager/middleware"
+
+	"entgo.io/ent/dialect"
+	"github.com/gorilla/mux"
+)
+
+// blockingDriver is an ent driver whose statements never finish on their own:
+// each one waits for its context to end and reports how it ended
+type blockingDriver struct {
+	started chan struct{}
+	ended   chan error
+}
+
+func newBlockingDriver() *blockingDriver {
+	return &blockingDriver{started: make(chan struct{}, 1), ended: make(chan error, 1)}
+}
+
+func (d *blockingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.block(ctx)
+}
+
+func (d *blockingDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.block(ctx)
+}
+
+func (d *blockingDriver) block(ctx context.Context) error {
+	select {
+	case d.started <- struct{}{}:
+	default:
+	}
+	<-ctx.Done()
+	select {
+	case d.ended <- ctx.Err():
+	default:
+	}
+	return ctx.Err()
+}
+
+func (d *blockingDriver) Tx(ctx context.Context) (dialect.Tx, error) { return dialect.NopTx(d), nil }
+func (d *blockingDriver) Close() error                               { return nil }
+func (d *blockingDriver) Dialect() string                            { return dialect.Postgres }
+
+// useBlockingDriver returns a Handler whose client runs on a blockingDriver
+func useBlockingDriver(t *testing.T) (*handlers.Handler, *blockingDriver) {
+	t.Helper()
+	drv := newBlockingDriver()
+	client := ent.NewClient(ent.Driver(drv))
+	return handlers.New(client, service.New(client), nil, health.NewRegistry()), drv
+}
+
+// waitQueryEnded returns how the blocked query ended, failing the test if it never does
+func waitQueryEnded(t *testing.T, drv *blockingDriver) error {
+	t.Helper()
+	select {
+	case err := <-drv.ended:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("query was not aborted")
+		return nil
+	}
+}
+
+func TestCancelledRequestAbortsQuery(t *testing.T) {
+	h, drv := useBlockingDriver(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/api/projects", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		h.GetProjectsHandler(rec, req)
+		close(done)
+	}()
+
+	<-drv.started
+	cancel()
+
+	if err := waitQueryEnded(t, drv); !errors.Is(err, context.Canceled) {
+		t.Fatalf("query ended with %v, want context.Canceled", err)
+	}
+	<-done
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestRouteTimeoutAbortsQuery(t *testing.T) {
+	h, drv := useBlockingDriver(t)
+
+	r := mux.NewRouter()
+	r.Use(middleware.Timeout(time.Minute, map[string]time.Duration{
+		"/api/projects/{id}": 20 * time.Millisecond,
+	}))
+	r.HandleFunc("/api/projects/{id}", h.GetProjectByIDHandler)
+
+	rec := httptest.NewRecorder()
+	start := time.Now()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/projects/1", nil))
+
+	if err := waitQueryEnded(t, drv); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("query ended with %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request took %s, want it cut at the route timeout", elapsed)
+	}
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusGatewayTimeout)
+	}
+}
+
+func TestDefaultTimeoutAppliesToOtherRoutes(t *testing.T) {
+	h, drv := useBlockingDriver(t)
+
+	r := mux.NewRouter()
+	r.Use(middleware.Timeout(20*time.Millisecond, map[string]time.Duration{
+		"/api/search": time.Minute,
+	}))
+	r.HandleFunc("/api/clients", h.GetClientsHandler)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/clients", nil))
+
+	if err := waitQueryEnded(t, drv); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("query ended with %v, want context.DeadlineExceeded", err)
+	}
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusGatewayTimeout)
+	}
+}
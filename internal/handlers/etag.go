@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"project-manager/internal/problem"
+	"project-manager/internal/service"
+)
+
+// anyVersion is returned by ifMatchVersion for "If-Match: *", which skips the version check
+const anyVersion = service.AnyVersion
+
+// etag renders an entity version as a strong entity tag
+func etag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// embeddingTag is the entity tag of a response that embeds other entities, as
+// projects embed their client and packages. Edits to those do not bump the
+// embedding entity's version, so a hash of the body follows it: "3-9b1f0c2a".
+// Writes check If-Match against the version alone.
+func embeddingTag(version int, body any) string {
+	h := fnv.New64a()
+	json.NewEncoder(h).Encode(body)
+	return fmt.Sprintf(`"%d-%x"`, version, h.Sum64())
+}
+
+// notModified sets the ETag header and, when If-None-Match already names the
+// current tag, answers 304 and returns true
+func notModified(w http.ResponseWriter, r *http.Request, tag string) bool {
+	w.Header().Set("ETag", tag)
+
+	for _, candidate := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == tag || candidate == "*" {
+			w.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion reads the version a write expects from If-Match. It writes
+// 428 when the header is missing and 412 when it is not one of our tags.
+func ifMatchVersion(w http.ResponseWriter, r *http.Request) (int, bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		problem.PreconditionRequired(w, r, "Send If-Match with the ETag from your last read")
+		return 0, false
+	}
+	if header == "*" {
+		return anyVersion, true
+	}
+
+	// The version leads the tag; an embeddingTag's hash is not compared
+	tag, _, _ := strings.Cut(strings.Trim(header, `"`), "-")
+	version, err := strconv.Atoi(tag)
+	if err != nil || version < 1 {
+		problem.PreconditionFailed(w, r, "If-Match does not match the current version")
+		return 0, false
+	}
+	return version, true
+}
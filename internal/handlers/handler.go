@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"project-manager/ent"
+	"project-manager/internal/health"
+	"project-manager/internal/problem"
+	"project-manager/internal/search"
+	"project-manager/internal/service"
+)
+
+// Handler serves the API routes. Projects, packages and clients go through
+// the services; stacks, users, revisions, audit and trash use the ent client.
+type Handler struct {
+	client   *ent.Client
+	projects service.Projects
+	packages service.Packages
+	clients  service.Clients
+	searcher search.Searcher
+	health   *health.Registry
+}
+
+// New returns a Handler reading and writing through client and services,
+// answering /api/search with searcher and the probes with the checks in checks
+func New(client *ent.Client, services service.Services, searcher search.Searcher, checks *health.Registry) *Handler {
+	return &Handler{
+		client:   client,
+		projects: services.Projects,
+		packages: services.Packages,
+		clients:  services.Clients,
+		searcher: searcher,
+		health:   checks,
+	}
+}
+
+// writeServiceError maps an error returned by a service to the matching
+// problem. entity is the human name of the resource, e.g. "Project".
+func writeServiceError(w http.ResponseWriter, r *http.Request, err error, entity string) {
+	switch {
+	case errors.Is(err, service.ErrModified):
+		problem.PreconditionFailed(w, r, entity+" was modified since it was read")
+	case errors.Is(err, service.ErrNotInTrash):
+		problem.NotFound(w, r, entity+" not found in trash")
+	default:
+		problem.FromError(w, r, err, entity)
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"runtime"
+
+	"project-manager/internal/health"
+	"project-manager/internal/models"
+)
+
+// HealthzHandler reports that the process is alive. It checks nothing else,
+// so a slow database never gets the process restarted.
+func HealthzHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
+
+// ReadyzHandler reports whether the server should receive traffic: every
+// dependency check passes and the server is not shutting down. It answers
+// 503 otherwise.
+func (h *Handler) ReadyzHandler(w http.ResponseWriter, r *http.Request) {
+	checks, ok := h.runChecks(r)
+
+	response := models.ReadinessResponse{Status: "ready", Checks: checks}
+	status := http.StatusOK
+	switch {
+	case h.health.Draining():
+		response.Status = "draining"
+		status = http.StatusServiceUnavailable
+	case !ok:
+		response.Status = "not_ready"
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)
+}
+
+// StatusHandler describes the running build, its uptime and the latency of
+// each dependency check
+func (h *Handler) StatusHandler(w http.ResponseWriter, r *http.Request) {
+	checks, ok := h.runChecks(r)
+
+	response := models.StatusResponse{
+		Status:        "ok",
+		Version:       health.Version,
+		Commit:        health.BuildCommit(),
+		GoVersion:     runtime.Version(),
+		StartedAt:     health.StartedAt(),
+		UptimeSeconds: health.Uptime().Seconds(),
+		Dependencies:  checks,
+	}
+	switch {
+	case h.health.Draining():
+		response.Status = "draining"
+	case !ok:
+		response.Status = "degraded"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(response)
+}
+
+// runChecks runs the registered dependency checks and reports whether all passed
+func (h *Handler) runChecks(r *http.Request) (map[string]models.DependencyStatus, bool) {
+	checks := map[string]models.DependencyStatus{}
+	ok := true
+	for _, result := range h.health.Run(r.Context()) {
+		status := models.DependencyStatus{
+			Status:    "ok",
+			LatencyMs: float64(result.Latency.Microseconds()) / 1000,
+		}
+		if result.Err != nil {
+			// The raw error can name hosts and credentials, and these routes are public
+			slog.ErrorContext(r.Context(), "dependency check failed", "check", result.Name, "error", result.Err)
+			status.Status = "failing"
+			status.Error = "unavailable"
+			ok = false
+		}
+		checks[result.Name] = status
+	}
+	return checks, ok
+}
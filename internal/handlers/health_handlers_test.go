@@ -0,0 +1,33 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project-manager/internal/handlers"
+	"project-manager/internal/health"
+	"project-manager/internal/service"
+)
+
+func TestFailingChecksDoNotLeakErrors(t *testing.T) {
+	checks := health.NewRegistry()
+	checks.Register("database", func(context.Context) error {
+		return errors.New(`dial tcp 10.0.0.5:5432: password authentication failed for user "pm"`)
+	})
+	h := handlers.New(nil, service.Services{}, nil, checks)
+
+	rec := httptest.NewRecorder()
+	h.ReadyzHandler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", rec.Code)
+	}
+	body := rec.Body.String()
+	if strings.Contains(body, "10.0.0.5") || !strings.Contains(body, `"error":"unavailable"`) {
+		t.Errorf("body = %s, want the error replaced with unavailable", body)
+	}
+}
@@ -1,208 +1,222 @@
 package handlers
 
 import (
-	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
-	"project-manager/ent"
-	"project-manager/internal/database"
+	"project-manager/internal/listing"
+	"project-manager/internal/mapper"
 	"project-manager/internal/models"
+	"project-manager/internal/problem"
+	"project-manager/internal/service"
+	"project-manager/internal/validation"
 
 	"github.com/gorilla/mux"
 )
 
 // CreatePackageHandler handles the creation of a new package
-func CreatePackageHandler(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) CreatePackageHandler(w http.ResponseWriter, r *http.Request) {
 	var packageData models.PackageData
 
 	if err := json.NewDecoder(r.Body).Decode(&packageData); err != nil {
-		http.Error(w, "Invalid JSON format: "+err.Error(), http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
 		return
 	}
 
-	if packageData.Name == "" {
-		http.Error(w, "Package name is required", http.StatusBadRequest)
+	if errs := validation.Struct(&packageData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
 		return
 	}
 
-	stacksJSON, err := json.Marshal(packageData.Stacks)
+	pkg, err := h.packages.Create(r.Context(), packageData)
 	if err != nil {
-		http.Error(w, "Error processing stacks: "+err.Error(), http.StatusInternalServerError)
+		problem.FromError(w, r, err, "Package")
 		return
 	}
 
-	packageRecord, err := database.Client.Packages.Create().
-		SetName(packageData.Name).
-		SetLink(packageData.Link).
-		SetDescription(packageData.Description).
-		SetStacks(string(stacksJSON)).
-		Save(context.Background())
-
-	if err != nil {
-		http.Error(w, "Error saving package: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	response := models.PackageResponse{
-		ID:          packageRecord.ID,
-		PackageData: packageData,
-	}
-
+	body := mapper.Package(pkg)
+	w.Header().Set("ETag", embeddingTag(pkg.Version, body))
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(body)
 }
 
-// GetPackagesHandler retrieves all packages
-func GetPackagesHandler(w http.ResponseWriter, r *http.Request) {
-	packages, err := database.Client.Packages.Query().All(context.Background())
+// GetPackagesHandler retrieves packages. It supports limit/offset or cursor
+// paging, sort=name,-created_at and the name_contains, stack and
+// updated_since filters; updated_since is an RFC 3339 timestamp.
+func (h *Handler) GetPackagesHandler(w http.ResponseWriter, r *http.Request) {
+	params, err := listing.Parse(r, service.PackageListSpec)
 	if err != nil {
-		http.Error(w, "Error retrieving packages: "+err.Error(), http.StatusInternalServerError)
+		problem.BadRequest(w, r, err.Error())
 		return
 	}
 
-	var response []models.PackageResponse
-	for _, pkg := range packages {
-		var stacks []string
-		if err := json.Unmarshal([]byte(pkg.Stacks), &stacks); err != nil {
-			stacks = []string{}
+	query := r.URL.Query()
+	filter := service.PackageFilter{
+		NameContains: query.Get("name_contains"),
+		Stack:        query.Get("stack"),
+	}
+	if v := query.Get("updated_since"); v != "" {
+		if filter.UpdatedSince, err = time.Parse(time.RFC3339, v); err != nil {
+			problem.BadRequest(w, r, "updated_since must be an RFC 3339 timestamp")
+			return
 		}
+	}
 
-		response = append(response, models.PackageResponse{
-			ID: pkg.ID,
-			PackageData: models.PackageData{
-				Name:        pkg.Name,
-				Link:        pkg.Link,
-				Description: pkg.Description,
-				Stacks:      stacks,
-			},
-		})
+	list, total, err := h.packages.List(r.Context(), filter, params)
+	if err != nil {
+		problem.FromError(w, r, err, "Package")
+		return
 	}
+	list = listing.Page(w, r, params, list, total)
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(mapper.Packages(list))
 }
 
 // GetPackageByIDHandler retrieves a package by its ID
-func GetPackageByIDHandler(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) GetPackageByIDHandler(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
 	packageID, err := strconv.Atoi(params["id"])
 	if err != nil {
-		http.Error(w, "Invalid package ID", http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid package ID")
 		return
 	}
 
-	pkg, err := database.Client.Packages.Get(context.Background(), packageID)
+	pkg, err := h.packages.Get(r.Context(), packageID)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			http.Error(w, "Package not found", http.StatusNotFound)
-		} else {
-			http.Error(w, "Error retrieving package: "+err.Error(), http.StatusInternalServerError)
-		}
+		problem.FromError(w, r, err, "Package")
 		return
 	}
 
-	var stacks []string
-	if err := json.Unmarshal([]byte(pkg.Stacks), &stacks); err != nil {
-		stacks = []string{}
-	}
-
-	response := models.PackageResponse{
-		ID: pkg.ID,
-		PackageData: models.PackageData{
-			Name:        pkg.Name,
-			Link:        pkg.Link,
-			Description: pkg.Description,
-			Stacks:      stacks,
-		},
+	body := mapper.Package(pkg)
+	if notModified(w, r, embeddingTag(pkg.Version, body)) {
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(body)
 }
 
-func UpdatePackageHandler(w http.ResponseWriter, r *http.Request) {
+// UpdatePackageHandler replaces a package. The body is validated like a create
+// and every field is written, so omitted optional fields are cleared.
+// If-Match must carry the ETag of the version being replaced.
+func (h *Handler) UpdatePackageHandler(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
 	packageID, err := strconv.Atoi(params["id"])
 	if err != nil {
-		http.Error(w, "Invalid package ID", http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid package ID")
+		return
+	}
+
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
 		return
 	}
 
 	var packageData models.PackageData
 	if err := json.NewDecoder(r.Body).Decode(&packageData); err != nil {
-		http.Error(w, "Invalid JSON format: "+err.Error(), http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
+		return
+	}
+
+	h.replacePackage(w, r, packageID, version, packageData)
+}
+
+// PatchPackageHandler applies a JSON Merge Patch or JSON Patch to a package.
+// Setting link or description to null clears it. If-Match is required as for PUT.
+func (h *Handler) PatchPackageHandler(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	packageID, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid package ID")
+		return
+	}
+
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
 		return
 	}
 
-	update := database.Client.Packages.UpdateOneID(packageID)
-	if packageData.Name != "" {
-		update.SetName(packageData.Name)
+	current, err := h.packages.Get(r.Context(), packageID)
+	if err != nil {
+		problem.FromError(w, r, err, "Package")
+		return
 	}
-	if packageData.Link != "" {
-		update.SetLink(packageData.Link)
+	if version != anyVersion && version != current.Version {
+		problem.PreconditionFailed(w, r, "Package was modified since it was read")
+		return
 	}
-	if packageData.Description != "" {
-		update.SetDescription(packageData.Description)
+
+	var packageData models.PackageData
+	if !applyPatch(w, r, mapper.Package(current).PackageData, &packageData) {
+		return
 	}
-	if len(packageData.Stacks) > 0 {
-		stacksJSON, err := json.Marshal(packageData.Stacks)
-		if err != nil {
-			http.Error(w, "Error processing stacks: "+err.Error(), http.StatusInternalServerError)
-			return
-		}
-		update.SetStacks(string(stacksJSON))
+
+	h.replacePackage(w, r, packageID, version, packageData)
+}
+
+// replacePackage validates packageData and overwrites every field of the
+// package, provided it is still at version (or version is anyVersion)
+func (h *Handler) replacePackage(w http.ResponseWriter, r *http.Request, packageID, version int, packageData models.PackageData) {
+	if errs := validation.Struct(&packageData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
+		return
 	}
 
-	pkg, err := update.Save(context.Background())
+	pkg, err := h.packages.Replace(r.Context(), packageID, version, packageData)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			http.Error(w, "Package not found", http.StatusNotFound)
-		} else {
-			http.Error(w, "Error updating package: "+err.Error(), http.StatusInternalServerError)
-		}
+		writeServiceError(w, r, err, "Package")
+		return
+	}
+
+	body := mapper.Package(pkg)
+	w.Header().Set("ETag", embeddingTag(pkg.Version, body))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
+
+// DeletePackageHandler moves a package whose version matches If-Match to the trash
+func (h *Handler) DeletePackageHandler(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	packageID, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid package ID")
 		return
 	}
 
-	var stacks []string
-	if err := json.Unmarshal([]byte(pkg.Stacks), &stacks); err != nil {
-		stacks = []string{}
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
 	}
 
-	response := models.PackageResponse{
-		ID: pkg.ID,
-		PackageData: models.PackageData{
-			Name:        pkg.Name,
-			Link:        pkg.Link,
-			Description: pkg.Description,
-			Stacks:      stacks,
-		},
+	if err := h.packages.Delete(r.Context(), packageID, version); err != nil {
+		writeServiceError(w, r, err, "Package")
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"message": "Package moved to trash"})
 }
 
-func DeletePackageHandler(w http.ResponseWriter, r *http.Request) {
+// GetPackageProjectsHandler lists the projects that use a package
+func (h *Handler) GetPackageProjectsHandler(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
-	packageIDStr := params["id"]
-
-	// Convert packageID from string to int
-	var packageID int
-	_, err := fmt.Sscan(packageIDStr, &packageID)
+	packageID, err := strconv.Atoi(params["id"])
 	if err != nil {
-		http.Error(w, "Invalid package ID", http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid package ID")
 		return
 	}
 
-	err = database.Client.Packages.DeleteOneID(packageID).Exec(context.Background())
+	projects, err := h.packages.Projects(r.Context(), packageID)
 	if err != nil {
-		http.Error(w, "Error deleting package: "+err.Error(), http.StatusInternalServerError)
+		problem.FromError(w, r, err, "Package")
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{"message": "Package deleted successfully"})
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mapper.Projects(projects))
 }
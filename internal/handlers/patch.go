@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+
+	"project-manager/internal/problem"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+const (
+	mergePatchType = "application/merge-patch+json" // RFC 7396
+	jsonPatchType  = "application/json-patch+json"  // RFC 6902
+)
+
+// applyPatch applies the request body, a JSON Merge Patch or a JSON Patch
+// depending on Content-Type, to the JSON form of current and decodes the
+// result into target. Fields the patch sets to null or removes come out as
+// zero values in target, which the replace helpers turn into Clear calls.
+// It writes a problem response and returns false when the patch cannot be applied.
+func applyPatch(w http.ResponseWriter, r *http.Request, current, target any) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		// Plain JSON bodies are treated as merge patches
+		mediaType = mergePatchType
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		problem.BadRequest(w, r, "Could not read request body")
+		return false
+	}
+
+	doc, err := json.Marshal(current)
+	if err != nil {
+		problem.Internal(w, r, err)
+		return false
+	}
+
+	var patched []byte
+	switch mediaType {
+	case mergePatchType, "application/json":
+		patched, err = jsonpatch.MergePatch(doc, body)
+	case jsonPatchType:
+		var ops jsonpatch.Patch
+		ops, err = jsonpatch.DecodePatch(body)
+		if err == nil {
+			patched, err = ops.Apply(doc)
+		}
+	default:
+		problem.UnsupportedMediaType(w, r, "PATCH accepts "+mergePatchType+" or "+jsonPatchType)
+		return false
+	}
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid patch: "+err.Error())
+		return false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		problem.BadRequest(w, r, "Patched document is invalid: "+err.Error())
+		return false
+	}
+	return true
+}
@@ -1,230 +1,224 @@
 package handlers
 
 import (
-	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"project-manager/ent"
-	"project-manager/internal/database"
+	"project-manager/internal/listing"
+	"project-manager/internal/mapper"
 	"project-manager/internal/models"
+	"project-manager/internal/problem"
+	"project-manager/internal/service"
+	"project-manager/internal/validation"
 
 	"github.com/gorilla/mux"
 )
 
-func CreateProjectHandler(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) CreateProjectHandler(w http.ResponseWriter, r *http.Request) {
 	var projectData models.ProjectData
 
 	if err := json.NewDecoder(r.Body).Decode(&projectData); err != nil {
-		http.Error(w, "Invalid JSON format: "+err.Error(), http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
 		return
 	}
 
-	// Validate required fields
-	if projectData.Name == "" {
-		http.Error(w, "Project name is required", http.StatusBadRequest)
-		return
-	}
-	if projectData.ImageUrl == "" {
-		http.Error(w, "Image URL is required", http.StatusBadRequest)
-		return
-	}
-	if projectData.Link == "" {
-		http.Error(w, "Link is required", http.StatusBadRequest)
-		return
-	}
-	if projectData.Description == "" {
-		http.Error(w, "Description is required", http.StatusBadRequest)
-		return
-	}
-
-	// Convert stacks array to JSON string
-	stacksJSON, err := json.Marshal(projectData.Stacks)
-	if err != nil {
-		http.Error(w, "Error processing stacks: "+err.Error(), http.StatusInternalServerError)
+	// Validate required fields and formats
+	if errs := validation.Struct(&projectData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
 		return
 	}
 
 	// Create project
-	project, err := database.Client.Projects.Create().
-		SetName(projectData.Name).
-		SetImageUrl(projectData.ImageUrl).
-		SetLink(projectData.Link).
-		SetDescription(projectData.Description).
-		SetStacks(string(stacksJSON)).
-		Save(context.Background())
-
+	project, err := h.projects.Create(r.Context(), projectData)
 	if err != nil {
-		http.Error(w, "Error creating project: "+err.Error(), http.StatusInternalServerError)
+		if ent.IsConstraintError(err) {
+			problem.Validation(w, r, "Unknown client or package ID")
+		} else {
+			problem.FromError(w, r, err, "Project")
+		}
 		return
 	}
 
-	response := models.ProjectResponse{
-		ID:          project.ID,
-		ProjectData: projectData,
-	}
-
+	body := mapper.Project(project)
+	w.Header().Set("ETag", embeddingTag(project.Version, body))
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(body)
 }
 
-func GetProjectsHandler(w http.ResponseWriter, r *http.Request) {
-	projects, err := database.Client.Projects.Query().All(context.Background())
+// GetProjectsHandler lists projects. It supports limit/offset or cursor paging,
+// sort=name,-updated_at and the name_contains, stack, client, package and
+// updated_since filters; updated_since is an RFC 3339 timestamp.
+func (h *Handler) GetProjectsHandler(w http.ResponseWriter, r *http.Request) {
+	params, err := listing.Parse(r, service.ProjectListSpec)
 	if err != nil {
-		http.Error(w, "Error fetching projects: "+err.Error(), http.StatusInternalServerError)
+		problem.BadRequest(w, r, err.Error())
 		return
 	}
 
-	var response []models.ProjectResponse
-	for _, project := range projects {
-		var stacks []string
-		if err := json.Unmarshal([]byte(project.Stacks), &stacks); err != nil {
-			stacks = []string{}
+	query := r.URL.Query()
+	filter := service.ProjectFilter{
+		NameContains: query.Get("name_contains"),
+		Stack:        query.Get("stack"),
+	}
+	if v := query.Get("client"); v != "" {
+		if filter.ClientID, err = strconv.Atoi(v); err != nil {
+			problem.BadRequest(w, r, "Invalid client filter")
+			return
+		}
+	}
+	if v := query.Get("package"); v != "" {
+		if filter.PackageID, err = strconv.Atoi(v); err != nil {
+			problem.BadRequest(w, r, "Invalid package filter")
+			return
 		}
+	}
+	if v := query.Get("updated_since"); v != "" {
+		if filter.UpdatedSince, err = time.Parse(time.RFC3339, v); err != nil {
+			problem.BadRequest(w, r, "updated_since must be an RFC 3339 timestamp")
+			return
+		}
+	}
 
-		response = append(response, models.ProjectResponse{
-			ID: project.ID,
-			ProjectData: models.ProjectData{
-				Name:        project.Name,
-				ImageUrl:    project.ImageUrl,
-				Link:        project.Link,
-				Description: project.Description,
-				Stacks:      stacks,
-			},
-		})
+	list, total, err := h.projects.List(r.Context(), filter, params)
+	if err != nil {
+		problem.FromError(w, r, err, "Project")
+		return
 	}
+	list = listing.Page(w, r, params, list, total)
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(mapper.Projects(list))
 }
 
-func GetProjectByIDHandler(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) GetProjectByIDHandler(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
 	id, err := strconv.Atoi(params["id"])
 	if err != nil {
-		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid project ID")
 		return
 	}
 
-	project, err := database.Client.Projects.Get(context.Background(), id)
+	project, err := h.projects.Get(r.Context(), id)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			http.Error(w, "Project not found", http.StatusNotFound)
-		} else {
-			http.Error(w, "Internal server error", http.StatusInternalServerError)
-		}
+		problem.FromError(w, r, err, "Project")
 		return
 	}
 
-	var stacks []string
-	if err := json.Unmarshal([]byte(project.Stacks), &stacks); err != nil {
-		stacks = []string{}
-	}
-
-	response := models.ProjectResponse{
-		ID: project.ID,
-		ProjectData: models.ProjectData{
-			Name:        project.Name,
-			ImageUrl:    project.ImageUrl,
-			Link:        project.Link,
-			Description: project.Description,
-			Stacks:      stacks,
-		},
+	body := mapper.Project(project)
+	if notModified(w, r, embeddingTag(project.Version, body)) {
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(body)
 }
 
-func UpdateProjectHandler(w http.ResponseWriter, r *http.Request) {
+// UpdateProjectHandler replaces a project. The body is validated like a create
+// and every field is written, so omitting clientId detaches the client.
+// If-Match must carry the ETag of the version being replaced.
+func (h *Handler) UpdateProjectHandler(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
-	idStr := params["id"]
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid project ID")
+		return
+	}
 
-	var id int64
-	if idStr == "" {
-		http.Error(w, "Missing project ID", http.StatusBadRequest)
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
 		return
 	}
-	fmt.Sscan(idStr, &id)
 
 	var projectData models.ProjectData
 	if err := json.NewDecoder(r.Body).Decode(&projectData); err != nil {
-		http.Error(w, "Invalid JSON format", http.StatusBadRequest)
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
 		return
 	}
 
-	update := database.Client.Projects.UpdateOneID(int(id))
-	if projectData.Name != "" {
-		update.SetName(projectData.Name)
-	}
-	if projectData.ImageUrl != "" {
-		update.SetImageUrl(projectData.ImageUrl)
+	h.replaceProject(w, r, id, version, projectData)
+}
+
+// PatchProjectHandler applies a JSON Merge Patch or JSON Patch to a project.
+// Setting a field to null clears it. If-Match is required as for PUT.
+func (h *Handler) PatchProjectHandler(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid project ID")
+		return
 	}
-	if projectData.Link != "" {
-		update.SetLink(projectData.Link)
+
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
 	}
-	if projectData.Description != "" {
-		update.SetDescription(projectData.Description)
+
+	current, err := h.projects.Get(r.Context(), id)
+	if err != nil {
+		problem.FromError(w, r, err, "Project")
+		return
 	}
-	if len(projectData.Stacks) > 0 {
-		stacksJSON, err := json.Marshal(projectData.Stacks)
-		if err != nil {
-			http.Error(w, "Error processing stacks", http.StatusInternalServerError)
-			return
-		}
-		update.SetStacks(string(stacksJSON))
+	if version != anyVersion && version != current.Version {
+		problem.PreconditionFailed(w, r, "Project was modified since it was read")
+		return
 	}
 
-	project, err := update.Save(context.Background())
-	if err != nil {
-		http.Error(w, "Error updating project", http.StatusInternalServerError)
+	var projectData models.ProjectData
+	if !applyPatch(w, r, mapper.Project(current).ProjectData, &projectData) {
 		return
 	}
 
-	var stacks []string
-	if err := json.Unmarshal([]byte(project.Stacks), &stacks); err != nil {
-		stacks = []string{}
+	h.replaceProject(w, r, id, version, projectData)
+}
+
+// replaceProject validates projectData and overwrites every field and edge of
+// the project, provided it is still at version (or version is anyVersion)
+func (h *Handler) replaceProject(w http.ResponseWriter, r *http.Request, id, version int, projectData models.ProjectData) {
+	if errs := validation.Struct(&projectData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
+		return
 	}
 
-	response := models.ProjectResponse{
-		ID: project.ID,
-		ProjectData: models.ProjectData{
-			Name:        project.Name,
-			ImageUrl:    project.ImageUrl,
-			Link:        project.Link,
-			Description: project.Description,
-			Stacks:      stacks,
-		},
+	project, err := h.projects.Replace(r.Context(), id, version, projectData)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			problem.Validation(w, r, "Unknown client or package ID")
+		} else {
+			writeServiceError(w, r, err, "Project")
+		}
+		return
 	}
 
+	body := mapper.Project(project)
+	w.Header().Set("ETag", embeddingTag(project.Version, body))
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(body)
 }
 
-func DeleteProjectHandler(w http.ResponseWriter, r *http.Request) {
+// DeleteProjectHandler moves a project whose version matches If-Match to the trash
+func (h *Handler) DeleteProjectHandler(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
-	idStr := params["id"]
-
-	// Convert string to int64
-	var id int64
-	var err error
-	if idStr == "" {
-		http.Error(w, "Missing project ID", http.StatusBadRequest)
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid project ID")
 		return
 	}
-	if _, err := fmt.Sscan(idStr, &id); err != nil {
-		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
 		return
 	}
 
-	err = database.Client.Projects.DeleteOneID(int(id)).Exec(context.Background())
-	if err != nil {
-		http.Error(w, "Error deleting project: "+err.Error(), http.StatusInternalServerError)
+	if err := h.projects.Delete(r.Context(), id, version); err != nil {
+		writeServiceError(w, r, err, "Project")
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{"message": "Project deleted successfully"})
+	json.NewEncoder(w).Encode(map[string]string{"message": "Project moved to trash"})
 }
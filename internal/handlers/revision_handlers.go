@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"project-manager/ent"
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
+	"project-manager/ent/projectrevisions"
+	"project-manager/ent/projects"
+	"project-manager/internal/listing"
+	"project-manager/internal/mapper"
+	"project-manager/internal/models"
+	"project-manager/internal/problem"
+
+	"github.com/gorilla/mux"
+)
+
+// revisionListSpec lists the fields GetProjectRevisionsHandler can sort on; newest first by default
+var revisionListSpec = listing.Spec[*ent.ProjectRevisions]{
+	IDName:      "revision",
+	DefaultSort: "-revision",
+	Fields: map[string]listing.Field[*ent.ProjectRevisions]{
+		"revision":   {Column: projectrevisions.FieldRevision, Kind: listing.KindInt, Value: func(r *ent.ProjectRevisions) any { return r.Revision }},
+		"created_at": {Column: projectrevisions.FieldCreatedAt, Kind: listing.KindTime, Value: func(r *ent.ProjectRevisions) any { return r.CreatedAt }},
+	},
+}
+
+// GetProjectRevisionsHandler lists the saved revisions of a project
+func (h *Handler) GetProjectRevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := projectIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	params, err := listing.Parse(r, revisionListSpec)
+	if err != nil {
+		problem.BadRequest(w, r, err.Error())
+		return
+	}
+
+	query := h.client.Projects.Query().Where(projects.ID(id)).QueryRevisions()
+
+	total, err := query.Clone().Count(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "Project")
+		return
+	}
+	if total == 0 && !h.projectExists(w, r, id) {
+		return
+	}
+
+	if after := params.After(); after != nil {
+		query.Where(after)
+	}
+	for _, order := range params.Order() {
+		query.Order(order)
+	}
+	limit, offset := params.Window()
+
+	list, err := query.Limit(limit).Offset(offset).All(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "Project")
+		return
+	}
+	list = listing.Page(w, r, params, list, total)
+
+	response := []models.ProjectRevisionResponse{}
+	for _, rev := range list {
+		response = append(response, mapper.Revision(rev))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetProjectRevisionHandler retrieves one revision of a project
+func (h *Handler) GetProjectRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := projectIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	rev, ok := h.queryRevision(w, r, id, mux.Vars(r)["rev"])
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mapper.Revision(rev))
+}
+
+// DiffProjectRevisionsHandler compares revisions from and to of a project
+// field by field. Only fields that differ are returned.
+func (h *Handler) DiffProjectRevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := projectIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	if query.Get("from") == "" || query.Get("to") == "" {
+		problem.BadRequest(w, r, "from and to revisions are required")
+		return
+	}
+	from, ok := h.queryRevision(w, r, id, query.Get("from"))
+	if !ok {
+		return
+	}
+	to, ok := h.queryRevision(w, r, id, query.Get("to"))
+	if !ok {
+		return
+	}
+
+	before, after := mapper.Revision(from).ProjectData, mapper.Revision(to).ProjectData
+	changes := map[string]models.FieldChange{}
+	compare := func(name string, before, after any) {
+		if !reflect.DeepEqual(before, after) {
+			changes[name] = models.FieldChange{Before: before, After: after}
+		}
+	}
+	compare("name", before.Name, after.Name)
+	compare("imageUrl", before.ImageUrl, after.ImageUrl)
+	compare("link", before.Link, after.Link)
+	compare("description", before.Description, after.Description)
+	compare("stacks", before.Stacks, after.Stacks)
+	compare("clientId", before.ClientID, after.ClientID)
+	compare("packageIds", before.PackageIDs, after.PackageIDs)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(changes)
+}
+
+// RestoreProjectRevisionHandler rolls a project back to the content of a
+// revision. The rollback is saved as a new version, so If-Match is required.
+func (h *Handler) RestoreProjectRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := projectIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
+	}
+
+	rev, ok := h.queryRevision(w, r, id, mux.Vars(r)["rev"])
+	if !ok {
+		return
+	}
+
+	missing, err := h.missingReferences(r, rev)
+	if err != nil {
+		problem.FromError(w, r, err, "Revision")
+		return
+	}
+	if len(missing) > 0 {
+		problem.Conflict(w, r, fmt.Sprintf("Revision %d refers to rows that are in the trash or were purged: %s",
+			rev.Revision, strings.Join(missing, ", ")))
+		return
+	}
+
+	h.replaceProject(w, r, id, version, mapper.Revision(rev).ProjectData)
+}
+
+// missingReferences names the client and packages rev refers to that are in
+// the trash or purged. Linking the project to a trashed row would succeed,
+// but soft delete would then hide it from every read of the project.
+func (h *Handler) missingReferences(r *http.Request, rev *ent.ProjectRevisions) ([]string, error) {
+	var missing []string
+	if rev.ClientID != nil {
+		live, err := h.client.Clients.Query().Where(clients.ID(*rev.ClientID)).Exist(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		if !live {
+			missing = append(missing, fmt.Sprintf("client %d", *rev.ClientID))
+		}
+	}
+	if len(rev.PackageIds) > 0 {
+		live, err := h.client.Packages.Query().Where(packages.IDIn(rev.PackageIds...)).IDs(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		found := map[int]bool{}
+		for _, id := range live {
+			found[id] = true
+		}
+		for _, id := range rev.PackageIds {
+			if !found[id] {
+				missing = append(missing, fmt.Sprintf("package %d", id))
+			}
+		}
+	}
+	return missing, nil
+}
+
+func projectIDParam(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid project ID")
+		return 0, false
+	}
+	return id, true
+}
+
+// projectExists writes 404 and returns false when the project does not exist
+func (h *Handler) projectExists(w http.ResponseWriter, r *http.Request, id int) bool {
+	exists, err := h.client.Projects.Query().Where(projects.ID(id)).Exist(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "Project")
+		return false
+	}
+	if !exists {
+		problem.NotFound(w, r, "Project not found")
+		return false
+	}
+	return true
+}
+
+// queryRevision loads revision number revParam of a live project
+func (h *Handler) queryRevision(w http.ResponseWriter, r *http.Request, id int, revParam string) (*ent.ProjectRevisions, bool) {
+	number, err := strconv.Atoi(revParam)
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid revision number")
+		return nil, false
+	}
+
+	rev, err := h.client.Projects.Query().
+		Where(projects.ID(id)).
+		QueryRevisions().
+		Where(projectrevisions.Revision(number)).
+		Only(r.Context())
+	switch {
+	case err == nil:
+		return rev, true
+	case !ent.IsNotFound(err):
+		problem.FromError(w, r, err, "Revision")
+	case h.projectExists(w, r, id):
+		problem.NotFound(w, r, "Revision not found")
+	}
+	return nil, false
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"project-manager/internal/problem"
+	"project-manager/internal/search"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// SearchHandler searches names, descriptions and stacks across projects,
+// packages and clients. Use kind=project,package to narrow the result types.
+func (h *Handler) SearchHandler(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+
+	q := strings.TrimSpace(params.Get("q"))
+	if q == "" {
+		problem.Validation(w, r, "Search query is required")
+		return
+	}
+
+	limit := defaultSearchLimit
+	if v := params.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			problem.Validation(w, r, "limit must be a positive integer")
+			return
+		}
+		limit = min(n, maxSearchLimit)
+	}
+
+	var kinds []string
+	if v := params.Get("kind"); v != "" {
+		for _, kind := range strings.Split(v, ",") {
+			switch kind {
+			case search.KindProject, search.KindPackage, search.KindClient:
+				kinds = append(kinds, kind)
+			default:
+				problem.Validation(w, r, "kind must be project, package or client")
+				return
+			}
+		}
+	}
+
+	hits, err := h.searcher.Search(r.Context(), q, kinds, limit)
+	if err != nil {
+		problem.Internal(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(hits)
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"project-manager/ent"
+	"project-manager/ent/stacks"
+	"project-manager/internal/mapper"
+	"project-manager/internal/models"
+	"project-manager/internal/problem"
+	"project-manager/internal/service"
+	"project-manager/internal/slug"
+	"project-manager/internal/validation"
+
+	"github.com/gorilla/mux"
+)
+
+// CreateStackHandler handles the creation of a new stack
+func (h *Handler) CreateStackHandler(w http.ResponseWriter, r *http.Request) {
+	var stackData models.StackData
+
+	if err := json.NewDecoder(r.Body).Decode(&stackData); err != nil {
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
+		return
+	}
+
+	if errs := validation.Struct(&stackData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
+		return
+	}
+	if stackData.Slug == "" {
+		stackData.Slug = stackData.Name
+	}
+	stackData.Slug = slug.Make(stackData.Slug)
+	if stackData.Slug == "" {
+		problem.Validation(w, r, "Stack slug must contain letters or digits")
+		return
+	}
+
+	stack, err := h.client.Stacks.Create().
+		SetName(stackData.Name).
+		SetSlug(stackData.Slug).
+		SetCategory(stackData.Category).
+		SetIconUrl(stackData.IconUrl).
+		Save(r.Context())
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			problem.Conflict(w, r, "A stack with this slug already exists")
+		} else {
+			problem.FromError(w, r, err, "Stack")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(mapper.Stack(stack))
+}
+
+// GetStacksHandler retrieves all stacks ordered by name
+func (h *Handler) GetStacksHandler(w http.ResponseWriter, r *http.Request) {
+	list, err := h.client.Stacks.Query().
+		Order(ent.Asc(stacks.FieldName)).
+		All(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "Stack")
+		return
+	}
+
+	response := []models.StackResponse{}
+	for _, stack := range list {
+		response = append(response, mapper.Stack(stack))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetStackBySlugHandler retrieves a stack by its slug
+func (h *Handler) GetStackBySlugHandler(w http.ResponseWriter, r *http.Request) {
+	stack, ok := h.stackFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mapper.Stack(stack))
+}
+
+// UpdateStackHandler replaces a stack. The body is validated like a create;
+// an empty slug is derived from the name again.
+func (h *Handler) UpdateStackHandler(w http.ResponseWriter, r *http.Request) {
+	stack, ok := h.stackFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var stackData models.StackData
+	if err := json.NewDecoder(r.Body).Decode(&stackData); err != nil {
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
+		return
+	}
+
+	h.replaceStack(w, r, stack, stackData)
+}
+
+// PatchStackHandler applies a JSON Merge Patch or JSON Patch to a stack.
+// Setting category or iconUrl to null clears it.
+func (h *Handler) PatchStackHandler(w http.ResponseWriter, r *http.Request) {
+	stack, ok := h.stackFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var stackData models.StackData
+	if !applyPatch(w, r, mapper.Stack(stack).StackData, &stackData) {
+		return
+	}
+
+	h.replaceStack(w, r, stack, stackData)
+}
+
+// replaceStack validates stackData and overwrites every field of the stack
+func (h *Handler) replaceStack(w http.ResponseWriter, r *http.Request, stack *ent.Stacks, stackData models.StackData) {
+	if errs := validation.Struct(&stackData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
+		return
+	}
+	if stackData.Slug == "" {
+		stackData.Slug = stackData.Name
+	}
+	stackData.Slug = slug.Make(stackData.Slug)
+	if stackData.Slug == "" {
+		problem.Validation(w, r, "Stack slug must contain letters or digits")
+		return
+	}
+
+	update := stack.Update().
+		SetName(stackData.Name).
+		SetSlug(stackData.Slug)
+	service.SetOrClear(stackData.Category, update.SetCategory, update.ClearCategory)
+	service.SetOrClear(stackData.IconUrl, update.SetIconUrl, update.ClearIconUrl)
+
+	stack, err := update.Save(r.Context())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			problem.Conflict(w, r, "A stack with this slug already exists")
+		} else {
+			problem.FromError(w, r, err, "Stack")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mapper.Stack(stack))
+}
+
+// DeleteStackHandler deletes a stack and detaches it from projects and packages
+func (h *Handler) DeleteStackHandler(w http.ResponseWriter, r *http.Request) {
+	stack, ok := h.stackFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.client.Stacks.DeleteOne(stack).Exec(r.Context()); err != nil {
+		problem.FromError(w, r, err, "Stack")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"message": "Stack deleted successfully"})
+}
+
+// GetStackProjectsHandler lists the projects built with a stack
+func (h *Handler) GetStackProjectsHandler(w http.ResponseWriter, r *http.Request) {
+	stack, ok := h.stackFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	projects, err := service.WithProjectEdges(stack.QueryProjects()).All(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "Stack")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mapper.Projects(projects))
+}
+
+// stackFromRequest loads the stack named by the {slug} route variable,
+// writing a 404 or 500 and returning false when it cannot
+func (h *Handler) stackFromRequest(w http.ResponseWriter, r *http.Request) (*ent.Stacks, bool) {
+	params := mux.Vars(r)
+
+	stack, err := h.client.Stacks.Query().
+		Where(stacks.Slug(params["slug"])).
+		Only(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "Stack")
+		return nil, false
+	}
+	return stack, true
+}
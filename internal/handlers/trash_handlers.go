@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
+	"project-manager/ent/projects"
+	"project-manager/ent/schema"
+	"project-manager/internal/mapper"
+	"project-manager/internal/models"
+	"project-manager/internal/problem"
+	"project-manager/internal/search"
+
+	"github.com/gorilla/mux"
+)
+
+// GetTrashHandler lists soft-deleted projects, packages and clients, most
+// recently deleted first. Use kind=project,client to narrow the result types.
+func (h *Handler) GetTrashHandler(w http.ResponseWriter, r *http.Request) {
+	kinds := map[string]bool{}
+	if v := r.URL.Query().Get("kind"); v != "" {
+		for _, kind := range strings.Split(v, ",") {
+			switch kind {
+			case search.KindProject, search.KindPackage, search.KindClient:
+				kinds[kind] = true
+			default:
+				problem.Validation(w, r, "kind must be project, package or client")
+				return
+			}
+		}
+	}
+	want := func(kind string) bool { return len(kinds) == 0 || kinds[kind] }
+
+	ctx := schema.SkipSoftDelete(r.Context())
+	response := []models.TrashItem{}
+
+	if want(search.KindProject) {
+		list, err := h.client.Projects.Query().Where(projects.DeletedAtNotNil()).All(ctx)
+		if err != nil {
+			problem.FromError(w, r, err, "Project")
+			return
+		}
+		for _, project := range list {
+			response = append(response, models.TrashItem{Kind: search.KindProject, ID: project.ID, Name: project.Name, DeletedAt: *project.DeletedAt})
+		}
+	}
+	if want(search.KindPackage) {
+		list, err := h.client.Packages.Query().Where(packages.DeletedAtNotNil()).All(ctx)
+		if err != nil {
+			problem.FromError(w, r, err, "Package")
+			return
+		}
+		for _, pkg := range list {
+			response = append(response, models.TrashItem{Kind: search.KindPackage, ID: pkg.ID, Name: pkg.Name, DeletedAt: *pkg.DeletedAt})
+		}
+	}
+	if want(search.KindClient) {
+		list, err := h.client.Clients.Query().Where(clients.DeletedAtNotNil()).All(ctx)
+		if err != nil {
+			problem.FromError(w, r, err, "Client")
+			return
+		}
+		for _, client := range list {
+			response = append(response, models.TrashItem{Kind: search.KindClient, ID: client.ID, Name: client.Name, DeletedAt: *client.DeletedAt})
+		}
+	}
+
+	sort.SliceStable(response, func(i, j int) bool {
+		return response[i].DeletedAt.After(response[j].DeletedAt)
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// RestoreProjectHandler moves a project out of the trash
+func (h *Handler) RestoreProjectHandler(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid project ID")
+		return
+	}
+
+	project, err := h.projects.Restore(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, r, err, "Project")
+		return
+	}
+
+	body := mapper.Project(project)
+	w.Header().Set("ETag", embeddingTag(project.Version, body))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
+
+// RestorePackageHandler moves a package out of the trash
+func (h *Handler) RestorePackageHandler(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	packageID, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid package ID")
+		return
+	}
+
+	pkg, err := h.packages.Restore(r.Context(), packageID)
+	if err != nil {
+		writeServiceError(w, r, err, "Package")
+		return
+	}
+
+	body := mapper.Package(pkg)
+	w.Header().Set("ETag", embeddingTag(pkg.Version, body))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
+
+// RestoreClientHandler moves a client out of the trash
+func (h *Handler) RestoreClientHandler(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid client ID")
+		return
+	}
+
+	client, err := h.clients.Restore(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, r, err, "Client")
+		return
+	}
+
+	w.Header().Set("ETag", etag(client.Version))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mapper.Client(client))
+}
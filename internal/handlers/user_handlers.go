@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"project-manager/ent"
+	"project-manager/ent/users"
+	"project-manager/internal/auth"
+	"project-manager/internal/mapper"
+	"project-manager/internal/models"
+	"project-manager/internal/problem"
+	"project-manager/internal/validation"
+
+	"github.com/gorilla/mux"
+)
+
+// CreateUserHandler creates a new user account. Only admins may call it.
+func (h *Handler) CreateUserHandler(w http.ResponseWriter, r *http.Request) {
+	var userData models.UserData
+
+	if err := json.NewDecoder(r.Body).Decode(&userData); err != nil {
+		problem.BadRequest(w, r, "Invalid JSON format: "+err.Error())
+		return
+	}
+
+	// Validate required fields and formats
+	if errs := validation.Struct(&userData); errs != nil {
+		problem.ValidationErrors(w, r, errs)
+		return
+	}
+
+	role := users.DefaultRole
+	if userData.Role != "" {
+		role = users.Role(userData.Role)
+	}
+
+	hash, err := auth.HashPassword(userData.Password)
+	if err != nil {
+		problem.Internal(w, r, err)
+		return
+	}
+
+	user, err := h.client.Users.Create().
+		SetEmail(userData.Email).
+		SetName(userData.Name).
+		SetPasswordHash(hash).
+		SetRole(role).
+		Save(r.Context())
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			problem.Conflict(w, r, "A user with this email already exists")
+		} else {
+			problem.FromError(w, r, err, "User")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(mapper.User(user))
+}
+
+// GetUsersHandler lists all user accounts. Only admins may call it.
+func (h *Handler) GetUsersHandler(w http.ResponseWriter, r *http.Request) {
+	list, err := h.client.Users.Query().All(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "User")
+		return
+	}
+
+	response := []models.UserResponse{}
+	for _, user := range list {
+		response = append(response, mapper.User(user))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// DeleteUserHandler removes a user account. Only admins may call it.
+func (h *Handler) DeleteUserHandler(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		problem.BadRequest(w, r, "Invalid user ID")
+		return
+	}
+
+	err = h.client.Users.DeleteOneID(id).Exec(r.Context())
+	if err != nil {
+		problem.FromError(w, r, err, "User")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{"message": "User deleted successfully"})
+}
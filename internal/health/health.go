@@ -0,0 +1,116 @@
+package health
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Version and Commit describe the build. Set them with
+// -ldflags "-X project-manager/internal/health.Version=1.2.0 -X project-manager/internal/health.Commit=abc123";
+// Commit falls back to the VCS revision Go stamps into the binary.
+var (
+	Version = "dev"
+	Commit  = ""
+)
+
+// checkTimeout bounds each dependency check so a hung dependency cannot hang a probe
+const checkTimeout = 2 * time.Second
+
+// started is when the process started, which every Registry shares
+var started = time.Now()
+
+// Registry holds the dependency checks run by readiness and status probes,
+// and whether the server is draining. Build one with NewRegistry.
+type Registry struct {
+	draining atomic.Bool
+
+	mu     sync.RWMutex
+	checks []check
+}
+
+// NewRegistry returns a Registry without checks
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+type check struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Result is the outcome of one dependency check
+type Result struct {
+	Name    string
+	Err     error
+	Latency time.Duration
+}
+
+// Register adds a dependency check run by readiness and status probes
+func (r *Registry) Register(name string, fn func(ctx context.Context) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks = append(r.checks, check{name, fn})
+}
+
+// SetDraining marks the server as shutting down, which fails readiness so
+// load balancers stop routing new requests to it
+func (r *Registry) SetDraining() {
+	r.draining.Store(true)
+}
+
+// Draining reports whether SetDraining was called
+func (r *Registry) Draining() bool {
+	return r.draining.Load()
+}
+
+// Uptime is how long the process has been running
+func Uptime() time.Duration {
+	return time.Since(started)
+}
+
+// StartedAt is when the process started
+func StartedAt() time.Time {
+	return started
+}
+
+// Run runs every registered check concurrently and returns their results in
+// registration order
+func (r *Registry) Run(ctx context.Context) []Result {
+	r.mu.RLock()
+	list := append([]check(nil), r.checks...)
+	r.mu.RUnlock()
+
+	results := make([]Result, len(list))
+	var wg sync.WaitGroup
+	for i, c := range list {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			start := time.Now()
+			err := c.fn(ctx)
+			results[i] = Result{Name: c.name, Err: err, Latency: time.Since(start)}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// BuildCommit returns Commit, or the VCS revision recorded by the Go toolchain
+func BuildCommit() string {
+	if Commit != "" {
+		return Commit
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range info.Settings {
+			if s.Key == "vcs.revision" {
+				return s.Value
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,257 @@
+package listing
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 100
+)
+
+// Kind is the Go type of a sortable column, needed to decode cursor values
+type Kind int
+
+const (
+	KindInt Kind = iota
+	KindString
+	KindTime
+)
+
+// Field describes a column that list endpoints may sort on
+type Field[T any] struct {
+	Column string
+	Kind   Kind
+	Value  func(T) any // Reads the column value from an entity for cursors
+}
+
+// Spec lists the sortable fields of an entity, keyed by their query parameter name
+type Spec[T any] struct {
+	Fields      map[string]Field[T]
+	IDName      string // Query parameter name of the primary key field, used as tie-breaker
+	DefaultSort string // Sort used when the request has none, e.g. "-id"; empty means by ID
+}
+
+type sortTerm[T any] struct {
+	field Field[T]
+	desc  bool
+}
+
+// Params holds the parsed pagination and sorting query parameters
+type Params[T any] struct {
+	Limit  int
+	Offset int
+
+	// UseOffset is true when the client asked for offset paging; otherwise
+	// the next page is described with a cursor
+	UseOffset bool
+
+	sort   []sortTerm[T]
+	cursor []any
+}
+
+// Parse reads limit, offset, cursor and sort from the request query string
+func Parse[T any](r *http.Request, spec Spec[T]) (*Params[T], error) {
+	q := r.URL.Query()
+	p := &Params[T]{Limit: DefaultLimit}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			return nil, fmt.Errorf("limit must be a positive integer")
+		}
+		if limit > MaxLimit {
+			limit = MaxLimit
+		}
+		p.Limit = limit
+	}
+
+	if v := q.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("offset must be a non-negative integer")
+		}
+		p.Offset = offset
+		p.UseOffset = true
+	}
+
+	seen := map[string]bool{}
+	sort := q.Get("sort")
+	if sort == "" {
+		sort = spec.DefaultSort
+	}
+	if sort != "" {
+		for _, term := range strings.Split(sort, ",") {
+			term = strings.TrimSpace(term)
+			desc := strings.HasPrefix(term, "-")
+			name := strings.TrimPrefix(term, "-")
+			field, ok := spec.Fields[name]
+			if !ok {
+				return nil, fmt.Errorf("cannot sort by %q", name)
+			}
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			p.sort = append(p.sort, sortTerm[T]{field: field, desc: desc})
+		}
+	}
+	// Always end on the primary key so the order, and therefore cursors, are stable
+	if !seen[spec.IDName] {
+		p.sort = append(p.sort, sortTerm[T]{field: spec.Fields[spec.IDName]})
+	}
+
+	if v := q.Get("cursor"); v != "" {
+		if p.UseOffset {
+			return nil, fmt.Errorf("cursor and offset cannot be combined")
+		}
+		values, err := p.decodeCursor(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+		p.cursor = values
+	}
+
+	return p, nil
+}
+
+// Order returns the ORDER BY terms for the requested sort
+func (p *Params[T]) Order() []func(*sql.Selector) {
+	var terms []func(*sql.Selector)
+	for _, t := range p.sort {
+		opts := []sql.OrderTermOption{sql.OrderAsc()}
+		if t.desc {
+			opts = []sql.OrderTermOption{sql.OrderDesc()}
+		}
+		terms = append(terms, sql.OrderByField(t.field.Column, opts...).ToFunc())
+	}
+	return terms
+}
+
+// After returns a predicate selecting rows that sort after the cursor,
+// or nil when no cursor was given
+func (p *Params[T]) After() func(*sql.Selector) {
+	if p.cursor == nil {
+		return nil
+	}
+	return func(s *sql.Selector) {
+		// (a > x) OR (a = x AND b > y) OR (a = x AND b = y AND c > z) ...
+		var or []*sql.Predicate
+		for i, t := range p.sort {
+			var and []*sql.Predicate
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(p.sort[j].field.Column), p.cursor[j]))
+			}
+			if t.desc {
+				and = append(and, sql.LT(s.C(t.field.Column), p.cursor[i]))
+			} else {
+				and = append(and, sql.GT(s.C(t.field.Column), p.cursor[i]))
+			}
+			or = append(or, sql.And(and...))
+		}
+		s.Where(sql.Or(or...))
+	}
+}
+
+// Window returns the LIMIT and OFFSET to query. One extra row is fetched
+// so Page can tell whether another page exists.
+func (p *Params[T]) Window() (limit, offset int) {
+	if p.cursor != nil {
+		return p.Limit + 1, 0
+	}
+	return p.Limit + 1, p.Offset
+}
+
+// Page trims the extra row fetched by Window and writes the X-Total-Count,
+// X-Next-Cursor and Link headers describing the surrounding pages
+func Page[T any](w http.ResponseWriter, r *http.Request, p *Params[T], items []T, total int) []T {
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+
+	var links []string
+	more := len(items) > p.Limit
+	if more {
+		items = items[:p.Limit]
+	}
+
+	if p.UseOffset {
+		if more {
+			links = append(links, link(r, "next", "offset", strconv.Itoa(p.Offset+p.Limit)))
+		}
+		if p.Offset > 0 {
+			links = append(links, link(r, "prev", "offset", strconv.Itoa(max(p.Offset-p.Limit, 0))))
+		}
+	} else if more && len(items) > 0 {
+		cursor := p.encodeCursor(items[len(items)-1])
+		w.Header().Set("X-Next-Cursor", cursor)
+		links = append(links, link(r, "next", "cursor", cursor))
+	}
+
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+	return items
+}
+
+func link(r *http.Request, rel, key, value string) string {
+	u := url.URL{Path: r.URL.Path}
+	q := r.URL.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+	return fmt.Sprintf("<%s>; rel=%q", u.String(), rel)
+}
+
+func (p *Params[T]) encodeCursor(item T) string {
+	values := make([]any, len(p.sort))
+	for i, t := range p.sort {
+		values[i] = t.field.Value(item)
+	}
+	raw, _ := json.Marshal(values)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func (p *Params[T]) decodeCursor(cursor string) ([]any, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	var encoded []json.RawMessage
+	if err := json.Unmarshal(raw, &encoded); err != nil {
+		return nil, err
+	}
+	// A cursor is only valid for the sort it was issued with
+	if len(encoded) != len(p.sort) {
+		return nil, fmt.Errorf("cursor does not match sort")
+	}
+
+	values := make([]any, len(encoded))
+	for i, t := range p.sort {
+		var err error
+		switch t.field.Kind {
+		case KindInt:
+			var v int
+			err = json.Unmarshal(encoded[i], &v)
+			values[i] = v
+		case KindString:
+			var v string
+			err = json.Unmarshal(encoded[i], &v)
+			values[i] = v
+		case KindTime:
+			var v time.Time
+			err = json.Unmarshal(encoded[i], &v)
+			values[i] = v
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,84 @@
+package logging
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// Driver wraps an ent driver to log every statement at debug level, with the
+// request ID of its context. Arguments are left out since they can hold
+// password hashes and other personal data.
+func Driver(drv dialect.Driver) dialect.Driver {
+	return &driver{drv}
+}
+
+type driver struct {
+	dialect.Driver
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v any) error {
+	return logQuery(ctx, query, func() error { return d.Driver.Exec(ctx, query, args, v) })
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v any) error {
+	return logQuery(ctx, query, func() error { return d.Driver.Query(ctx, query, args, v) })
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &txDriver{tx}, nil
+}
+
+// BeginTx lets ent's Client.BeginTx pass transaction options through
+func (d *driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	beginner, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return d.Tx(ctx)
+	}
+	tx, err := beginner.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &txDriver{tx}, nil
+}
+
+type txDriver struct {
+	dialect.Tx
+}
+
+func (t *txDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return logQuery(ctx, query, func() error { return t.Tx.Exec(ctx, query, args, v) })
+}
+
+func (t *txDriver) Query(ctx context.Context, query string, args, v any) error {
+	return logQuery(ctx, query, func() error { return t.Tx.Query(ctx, query, args, v) })
+}
+
+func logQuery(ctx context.Context, query string, fn func() error) error {
+	if !slog.Default().Enabled(ctx, slog.LevelDebug) {
+		return fn()
+	}
+
+	start := time.Now()
+	err := fn()
+	attrs := []any{"statement", query, "duration_ms", durationMs(time.Since(start))}
+	if err != nil {
+		attrs = append(attrs, "error", err)
+	}
+	slog.DebugContext(ctx, "query", attrs...)
+	return err
+}
+
+// durationMs renders d in milliseconds with microsecond precision
+func durationMs(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
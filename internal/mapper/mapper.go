@@ -0,0 +1,159 @@
+// Package mapper converts ent entities into the API shapes in internal/models.
+// Every response body built from an entity goes through here.
+package mapper
+
+import (
+	"project-manager/ent"
+	"project-manager/internal/models"
+)
+
+// Project converts a project, and any eager-loaded edges, into its API shape
+func Project(project *ent.Projects) models.ProjectResponse {
+	response := models.ProjectResponse{
+		ID: project.ID,
+		ProjectData: models.ProjectData{
+			Name:        project.Name,
+			ImageUrl:    project.ImageUrl,
+			Link:        project.Link,
+			Description: project.Description,
+			Stacks:      StackNames(project.Edges.Stacks),
+		},
+		CreatedAt: project.CreatedAt,
+		UpdatedAt: project.UpdatedAt,
+	}
+
+	if client := project.Edges.Client; client != nil {
+		clientResp := Client(client)
+		response.ClientID = &client.ID
+		response.Client = &clientResp
+	}
+	if pkgs := project.Edges.Packages; pkgs != nil {
+		response.PackageIDs = []int{}
+		for _, pkg := range pkgs {
+			response.PackageIDs = append(response.PackageIDs, pkg.ID)
+			response.Packages = append(response.Packages, Package(pkg))
+		}
+	}
+
+	return response
+}
+
+// Projects converts a list of projects, returning an empty slice rather than nil
+func Projects(list []*ent.Projects) []models.ProjectResponse {
+	response := []models.ProjectResponse{}
+	for _, project := range list {
+		response = append(response, Project(project))
+	}
+	return response
+}
+
+// Package converts a package entity into its API shape.
+// Stacks are only filled in when the stacks edge was eager-loaded.
+func Package(pkg *ent.Packages) models.PackageResponse {
+	return models.PackageResponse{
+		ID: pkg.ID,
+		PackageData: models.PackageData{
+			Name:        pkg.Name,
+			Link:        pkg.Link,
+			Description: pkg.Description,
+			Stacks:      StackNames(pkg.Edges.Stacks),
+		},
+		CreatedAt: pkg.CreatedAt,
+		UpdatedAt: pkg.UpdatedAt,
+	}
+}
+
+// Packages converts a list of packages, returning an empty slice rather than nil
+func Packages(list []*ent.Packages) []models.PackageResponse {
+	response := []models.PackageResponse{}
+	for _, pkg := range list {
+		response = append(response, Package(pkg))
+	}
+	return response
+}
+
+// Client converts a client entity into its API shape
+func Client(client *ent.Clients) models.ClientResponse {
+	return models.ClientResponse{
+		ID: client.ID,
+		ClientData: models.ClientData{
+			Name:     client.Name,
+			Link:     client.Link,
+			ImageUrl: client.ImageUrl,
+		},
+		CreatedAt: client.CreatedAt,
+		UpdatedAt: client.UpdatedAt,
+	}
+}
+
+// Clients converts a list of clients, returning an empty slice rather than nil
+func Clients(list []*ent.Clients) []models.ClientResponse {
+	response := []models.ClientResponse{}
+	for _, client := range list {
+		response = append(response, Client(client))
+	}
+	return response
+}
+
+// Stack converts a stack entity into its API shape
+func Stack(stack *ent.Stacks) models.StackResponse {
+	return models.StackResponse{
+		ID: stack.ID,
+		StackData: models.StackData{
+			Name:     stack.Name,
+			Slug:     stack.Slug,
+			Category: stack.Category,
+			IconUrl:  stack.IconUrl,
+		},
+	}
+}
+
+// StackNames flattens eager-loaded stacks into the name list used by
+// ProjectData and PackageData
+func StackNames(list []*ent.Stacks) []string {
+	names := []string{}
+	for _, stack := range list {
+		names = append(names, stack.Name)
+	}
+	return names
+}
+
+// User converts a user entity into its API shape. The password hash is never included.
+func User(user *ent.Users) models.UserResponse {
+	return models.UserResponse{
+		ID:    user.ID,
+		Email: user.Email,
+		Name:  user.Name,
+		Role:  string(user.Role),
+	}
+}
+
+// Revision converts a project revision into its API shape
+func Revision(rev *ent.ProjectRevisions) models.ProjectRevisionResponse {
+	return models.ProjectRevisionResponse{
+		Revision: rev.Revision,
+		ProjectData: models.ProjectData{
+			Name:        rev.Name,
+			ImageUrl:    rev.ImageUrl,
+			Link:        rev.Link,
+			Description: rev.Description,
+			Stacks:      rev.Stacks,
+			ClientID:    rev.ClientID,
+			PackageIDs:  rev.PackageIds,
+		},
+		CreatedAt: rev.CreatedAt,
+	}
+}
+
+// AuditEvent converts an audit log entry into its API shape
+func AuditEvent(event *ent.AuditEvents) models.AuditEventResponse {
+	return models.AuditEventResponse{
+		ID:         event.ID,
+		EntityType: event.EntityType,
+		EntityID:   event.EntityID,
+		Operation:  string(event.Operation),
+		ActorID:    event.ActorID,
+		Changes:    event.Changes,
+		CreatedAt:  event.CreatedAt,
+	}
+}
@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	dbQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_queries_total",
+		Help: "Database statements run by ent, by table, operation and outcome.",
+	}, []string{"table", "operation", "outcome"})
+
+	dbDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Latency of database statements run by ent, by table and operation.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"table", "operation"})
+)
+
+// Driver wraps an ent driver to record the count and latency of every
+// statement, labeled by the table it targets and its SQL operation
+func Driver(drv dialect.Driver) dialect.Driver {
+	return &driver{drv}
+}
+
+type driver struct {
+	dialect.Driver
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v any) error {
+	return observe(query, func() error { return d.Driver.Exec(ctx, query, args, v) })
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v any) error {
+	return observe(query, func() error { return d.Driver.Query(ctx, query, args, v) })
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &txDriver{tx}, nil
+}
+
+// BeginTx lets ent's Client.BeginTx pass transaction options through
+func (d *driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	beginner, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return d.Tx(ctx)
+	}
+	tx, err := beginner.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &txDriver{tx}, nil
+}
+
+type txDriver struct {
+	dialect.Tx
+}
+
+func (t *txDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return observe(query, func() error { return t.Tx.Exec(ctx, query, args, v) })
+}
+
+func (t *txDriver) Query(ctx context.Context, query string, args, v any) error {
+	return observe(query, func() error { return t.Tx.Query(ctx, query, args, v) })
+}
+
+// observe runs fn and records it under the table and operation of query
+func observe(query string, fn func() error) error {
+	table, operation := classify(query)
+
+	start := time.Now()
+	err := fn()
+	dbDuration.WithLabelValues(table, operation).Observe(time.Since(start).Seconds())
+
+	outcome := "ok"
+	if err != nil {
+		outcome = "error"
+	}
+	dbQueries.WithLabelValues(table, operation, outcome).Inc()
+	return err
+}
+
+// tablePattern finds the table a statement targets, quoted or not
+var tablePattern = regexp.MustCompile("(?i)\\b(?:FROM|INTO|UPDATE|JOIN)\\s+[\"`]?(\\w+)")
+
+// classify returns the table and the lower-case SQL operation of query. Only
+// ent's own statements are expected, so the first quoted table is the target.
+func classify(query string) (table, operation string) {
+	operation, _, _ = strings.Cut(strings.TrimSpace(query), " ")
+	operation = strings.ToLower(operation)
+	switch operation {
+	case "select", "insert", "update", "delete":
+	default:
+		operation = "other"
+	}
+
+	table = "other"
+	if m := tablePattern.FindStringSubmatch(query); m != nil {
+		table = m[1]
+	}
+	return table, operation
+}
@@ -1,43 +1,172 @@
 package models
 
+import "time"
+
 // ProjectData represents the structure for creating or updating a project
 type ProjectData struct {
-	Name        string   `json:"name"`
-	ImageUrl    string   `json:"imageUrl"`
-	Link        string   `json:"link"`
-	Description string   `json:"description"`
-	Stacks      []string `json:"stacks"` // Array of technology stacks
+	Name        string   `json:"name" validate:"required,trimmed,max=100"`
+	ImageUrl    string   `json:"imageUrl" validate:"url"`
+	Link        string   `json:"link" validate:"url"`
+	Description string   `json:"description" validate:"max=1000"`
+	Stacks      []string `json:"stacks" validate:"max=30,unique" items:"required,trimmed,max=50"` // Array of technology stacks
+	ClientID    *int     `json:"clientId,omitempty" validate:"min=1"`                             // Client the project was built for
+	PackageIDs  []int    `json:"packageIds,omitempty" validate:"unique" items:"min=1"`            // Packages used by the project
 }
 
 // PackageData represents the structure for creating or updating a package
 type PackageData struct {
-	Name        string   `json:"name"`
-	Link        string   `json:"link,omitempty"`
-	Description string   `json:"description,omitempty"`
-	Stacks      []string `json:"stacks"` // Array of technology stacks
+	Name        string   `json:"name" validate:"required,trimmed,max=100"`
+	Link        string   `json:"link,omitempty" validate:"url"`
+	Description string   `json:"description,omitempty" validate:"max=1000"`
+	Stacks      []string `json:"stacks" validate:"max=30,unique" items:"required,trimmed,max=50"` // Array of technology stacks
 }
 
 // ClientData represents the structure for creating or updating a client
 type ClientData struct {
-	Name     string `json:"name"`
-	Link     string `json:"link,omitempty"`
-	ImageUrl string `json:"imageUrl"`
+	Name     string `json:"name" validate:"required,trimmed,max=100"`
+	Link     string `json:"link,omitempty" validate:"url"`
+	ImageUrl string `json:"imageUrl" validate:"required,url"`
+}
+
+// StackData represents the structure for creating or updating a stack
+type StackData struct {
+	Name     string `json:"name" validate:"required,trimmed,max=100"`
+	Slug     string `json:"slug,omitempty" validate:"max=100"` // Derived from the name when omitted
+	Category string `json:"category,omitempty" validate:"max=50"`
+	IconUrl  string `json:"iconUrl,omitempty" validate:"url"`
 }
 
 // ProjectResponse is used when returning project details including ID
+// along with the related client and packages
 type ProjectResponse struct {
 	ID int `json:"id"`
 	ProjectData
+	Client    *ClientResponse   `json:"client,omitempty"`
+	Packages  []PackageResponse `json:"packages,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt time.Time         `json:"updatedAt"`
 }
 
 // PackageResponse is used when returning package details including ID
 type PackageResponse struct {
 	ID int `json:"id"`
 	PackageData
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 // ClientResponse is used when returning client details including ID
 type ClientResponse struct {
 	ID int `json:"id"`
 	ClientData
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// LoginData represents the credentials posted to the login endpoint
+type LoginData struct {
+	Email    string `json:"email" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+// RefreshData represents the body posted to the token refresh endpoint
+type RefreshData struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// TokenResponse is returned after a successful login or refresh
+type TokenResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	TokenType    string `json:"tokenType"`
+	ExpiresIn    int    `json:"expiresIn"` // Access token lifetime in seconds
+}
+
+// UserData represents the structure for creating or updating a user
+type UserData struct {
+	Email    string `json:"email" validate:"required,email,max=254"`
+	Name     string `json:"name,omitempty" validate:"max=100"`
+	Password string `json:"password,omitempty" validate:"required,min=8,max=72"` // bcrypt ignores bytes past 72
+	Role     string `json:"role,omitempty" validate:"oneof=admin editor viewer"`
+}
+
+// UserResponse is used when returning user details including ID.
+// The password is never included.
+type UserResponse struct {
+	ID    int    `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+	Role  string `json:"role"`
+}
+
+// StackResponse is used when returning stack details including ID
+type StackResponse struct {
+	ID int `json:"id"`
+	StackData
+}
+
+// SearchHit is a single ranked result returned by the search endpoint
+type SearchHit struct {
+	Kind    string  `json:"kind"` // One of project, package or client
+	ID      int     `json:"id"`
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"` // HTML: stored text is escaped and matched terms are wrapped in <mark> tags
+	Score   float64 `json:"score"`
+}
+
+// TrashItem is a soft-deleted project, package or client awaiting restore or purge
+type TrashItem struct {
+	Kind      string    `json:"kind"` // One of project, package or client
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// FieldChange is the value of one field before and after an audited write.
+// Before is null on create and After is null on delete.
+type FieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditEventResponse is a single entry of the audit log
+type AuditEventResponse struct {
+	ID         int                    `json:"id"`
+	EntityType string                 `json:"entityType"`
+	EntityID   int                    `json:"entityId"`
+	Operation  string                 `json:"operation"` // One of create, update, delete or restore
+	ActorID    *int                   `json:"actorId"`   // Null for system jobs
+	Changes    map[string]FieldChange `json:"changes"`
+	CreatedAt  time.Time              `json:"createdAt"`
+}
+
+// ProjectRevisionResponse is a project as it was saved at one version
+type ProjectRevisionResponse struct {
+	Revision int `json:"revision"` // The project version, as sent in its ETag
+	ProjectData
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// DependencyStatus is the outcome of one dependency check
+type DependencyStatus struct {
+	Status    string  `json:"status"` // "ok" or "failing"
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"` // "unavailable" when failing; details are only logged
+}
+
+// ReadinessResponse is returned by /readyz
+type ReadinessResponse struct {
+	Status string                      `json:"status"` // "ready", "not_ready" or "draining"
+	Checks map[string]DependencyStatus `json:"checks"`
+}
+
+// StatusResponse describes the running build and the health of its dependencies
+type StatusResponse struct {
+	Status        string                      `json:"status"` // "ok", "degraded" or "draining"
+	Version       string                      `json:"version"`
+	Commit        string                      `json:"commit,omitempty"`
+	GoVersion     string                      `json:"goVersion"`
+	StartedAt     time.Time                   `json:"startedAt"`
+	UptimeSeconds float64                     `json:"uptimeSeconds"`
+	Dependencies  map[string]DependencyStatus `json:"dependencies"`
 }
@@ -0,0 +1,84 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"project-manager/internal/openapi"
+)
+
+func TestOperationIDsAreUnique(t *testing.T) {
+	seen := map[string]string{}
+	for path, item := range openapi.Spec().Paths {
+		for method, op := range item {
+			where := strings.ToUpper(method) + " " + path
+			if op.OperationID == "" {
+				t.Errorf("%s has no operationId", where)
+			}
+			if other, ok := seen[op.OperationID]; ok {
+				t.Errorf("%s and %s share operationId %q", where, other, op.OperationID)
+			}
+			seen[op.OperationID] = where
+		}
+	}
+}
+
+func TestEveryRefResolves(t *testing.T) {
+	spec := openapi.Spec()
+	doc, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tree any
+	if err := json.Unmarshal(doc, &tree); err != nil {
+		t.Fatal(err)
+	}
+
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if ref, ok := v["$ref"].(string); ok {
+				name := strings.TrimPrefix(ref, "#/components/schemas/")
+				if spec.Components.Schemas[name] == nil {
+					t.Errorf("$ref %s does not resolve", ref)
+				}
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(tree)
+}
+
+func TestSchemasFollowValidateTags(t *testing.T) {
+	schemas := openapi.Spec().Components.Schemas
+
+	project := schemas["ProjectData"]
+	if got := strings.Join(project.Required, ","); got != "name" {
+		t.Errorf("ProjectData required = %s", got)
+	}
+	if name := project.Properties["name"]; name.MaxLength == nil || *name.MaxLength != 100 {
+		t.Errorf("ProjectData.name maxLength = %v, want 100", name.MaxLength)
+	}
+	if stacks := project.Properties["stacks"]; !stacks.UniqueItems || stacks.Items.MaxLength == nil || *stacks.Items.MaxLength != 50 {
+		t.Errorf("ProjectData.stacks = %+v, want unique items of at most 50 characters", stacks)
+	}
+	if clientID := project.Properties["clientId"]; !clientID.Nullable || clientID.Minimum == nil || *clientID.Minimum != 1 {
+		t.Errorf("ProjectData.clientId = %+v, want a nullable integer of at least 1", clientID)
+	}
+
+	// Optional fields may be left empty even when their rules would reject ""
+	if link := schemas["PackageData"].Properties["link"]; !strings.HasPrefix(link.Pattern, "^$|") {
+		t.Errorf("PackageData.link pattern %q rejects an empty link", link.Pattern)
+	}
+	if role := schemas["UserData"].Properties["role"]; strings.Join(role.Enum, ",") != "admin,editor,viewer," {
+		t.Errorf("UserData.role enum = %q", role.Enum)
+	}
+}
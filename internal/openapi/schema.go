@@ -0,0 +1,199 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// trimmedPattern matches strings without leading or trailing whitespace
+const trimmedPattern = `^\S([\s\S]*\S)?$`
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// generator turns Go types into schemas. Named structs are registered once
+// under components/schemas and referenced from everywhere they are used.
+type generator struct {
+	schemas map[string]*Schema
+}
+
+// schemaOf returns the schema of t
+func (g *generator) schemaOf(t reflect.Type) *Schema {
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		s := g.schemaOf(t.Elem())
+		// A nil pointer encodes as null; $ref siblings are ignored in 3.0, so
+		// pointers to structs are only marked optional by leaving them out of required
+		if s.Ref == "" {
+			s.Nullable = true
+		}
+		return s
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Interface:
+		// Any JSON value
+		return &Schema{}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t)
+		}
+		if _, ok := g.schemas[t.Name()]; !ok {
+			// Register before descending so that self-references terminate
+			g.schemas[t.Name()] = &Schema{}
+			g.schemas[t.Name()] = g.object(t)
+		}
+		return ref(t.Name())
+	}
+	panic("openapi: no schema for " + t.String())
+}
+
+// ref points at a schema registered under components/schemas
+func ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// object builds the schema of a struct from its exported fields, their json
+// names and their validate and items tags. Embedded structs are flattened
+// the way encoding/json flattens them.
+func (g *generator) object(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	g.fields(t, s)
+	return s
+}
+
+func (g *generator) fields(t reflect.Type, s *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			g.fields(field.Type, s)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+
+		fs := g.schemaOf(field.Type)
+		if kind := field.Type.Kind(); (kind == reflect.Slice || kind == reflect.Map) && !strings.Contains(options, "omitempty") {
+			// encoding/json writes a nil slice or map as null
+			fs.Nullable = true
+		}
+		if rules := field.Tag.Get("validate"); rules != "" {
+			constrain(fs, rules)
+			if hasRule(rules, "required") {
+				s.Required = append(s.Required, name)
+			}
+		}
+		if rules := field.Tag.Get("items"); rules != "" && fs.Items != nil {
+			constrain(fs.Items, rules)
+		}
+		s.Properties[name] = fs
+		s.order = append(s.order, name)
+	}
+}
+
+// constrain translates the rules of a validate tag, as understood by
+// internal/validation, into schema keywords. Rules with no exact JSON Schema
+// equivalent are approximated: unique ignores case in validation but not here.
+func constrain(s *Schema, rules string) {
+	if s.Ref != "" {
+		return
+	}
+	for _, rule := range strings.Split(rules, ",") {
+		key, arg, _ := strings.Cut(rule, "=")
+		switch key {
+		case "required":
+			switch s.Type {
+			case "string":
+				if s.MinLength == nil {
+					s.MinLength = intPtr(1)
+				}
+			case "array":
+				if s.MinItems == nil {
+					s.MinItems = intPtr(1)
+				}
+			}
+		case "min", "max":
+			n, _ := strconv.Atoi(arg)
+			switch s.Type {
+			case "string":
+				setLimit(&s.MinLength, &s.MaxLength, key, n)
+			case "array":
+				setLimit(&s.MinItems, &s.MaxItems, key, n)
+			case "integer", "number":
+				if key == "min" {
+					s.Minimum = floatPtr(float64(n))
+				} else {
+					s.Maximum = floatPtr(float64(n))
+				}
+			}
+		case "url":
+			s.Format = "uri"
+			s.Pattern = "^https?://"
+		case "email":
+			s.Format = "email"
+		case "oneof":
+			s.Enum = strings.Fields(arg)
+		case "trimmed":
+			s.Pattern = trimmedPattern
+		case "unique":
+			s.UniqueItems = true
+		default:
+			panic("openapi: unknown validation rule " + key)
+		}
+	}
+
+	// validation skips the rules of optional fields left empty
+	if s.Type == "string" && !hasRule(rules, "required") {
+		if s.Pattern != "" {
+			s.Pattern = "^$|" + s.Pattern
+		}
+		if s.Enum != nil {
+			s.Enum = append(s.Enum, "")
+		}
+	}
+}
+
+func setLimit(min, max **int, key string, n int) {
+	if key == "min" {
+		*min = intPtr(n)
+	} else {
+		*max = intPtr(n)
+	}
+}
+
+func hasRule(rules, name string) bool {
+	for _, rule := range strings.Split(rules, ",") {
+		if rule == name {
+			return true
+		}
+	}
+	return false
+}
+
+func intPtr(n int) *int { return &n }
+
+func floatPtr(f float64) *float64 { return &f }
@@ -0,0 +1,880 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"project-manager/internal/health"
+	"project-manager/internal/listing"
+	"project-manager/internal/models"
+	"project-manager/internal/problem"
+)
+
+// Media types read or written by the API
+const (
+	jsonType       = "application/json"
+	mergePatchType = "application/merge-patch+json" // RFC 7396
+	jsonPatchType  = "application/json-patch+json"  // RFC 6902
+)
+
+// Roles allowed to call the mutating routes, mirroring the router
+var (
+	editors = []string{"admin", "editor"}
+	admins  = []string{"admin"}
+)
+
+// builder collects operations and the schemas they reference
+type builder struct {
+	doc *Document
+	gen *generator
+}
+
+// build describes every route mounted by internal/router
+func build() *Document {
+	b := &builder{
+		doc: &Document{
+			OpenAPI: Version,
+			Info: Info{
+				Title:       "Project Manager API",
+				Description: "Manage portfolio projects together with the clients they were built for, the packages they use and their technology stacks. Errors are RFC 7807 problem documents.",
+				Version:     health.Version,
+			},
+			Tags: []Tag{
+				{Name: "Health", Description: "Probes and build information"},
+				{Name: "Auth", Description: "Token issue and refresh"},
+				{Name: "Users", Description: "User accounts; admins only"},
+				{Name: "Projects"},
+				{Name: "Revisions", Description: "Saved versions of a project"},
+				{Name: "Packages"},
+				{Name: "Clients"},
+				{Name: "Stacks"},
+				{Name: "Trash", Description: "Soft-deleted projects, packages and clients"},
+				{Name: "Audit", Description: "Log of every write"},
+				{Name: "Search"},
+				{Name: "Docs", Description: "This document and the metrics endpoint"},
+			},
+			Paths: map[string]PathItem{},
+			Components: Components{
+				SecuritySchemes: map[string]SecurityScheme{
+					"bearerAuth": {
+						Type:         "http",
+						Scheme:       "bearer",
+						BearerFormat: "JWT",
+						Description:  "Access token from /api/auth/login or /api/auth/refresh",
+					},
+				},
+			},
+		},
+		gen: &generator{schemas: map[string]*Schema{}},
+	}
+	b.gen.schemas["Message"] = &Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{"message": {Type: "string"}},
+		Required:   []string{"message"},
+	}
+	b.gen.schemas["JSONPatchOperation"] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"op":    {Type: "string", Enum: []string{"add", "remove", "replace", "move", "copy", "test"}},
+			"path":  {Type: "string", Description: "JSON Pointer to the target field"},
+			"from":  {Type: "string", Description: "JSON Pointer to the source field of move and copy"},
+			"value": {Description: "Value for add, replace and test"},
+		},
+		Required: []string{"op", "path"},
+	}
+	// Every error response is a problem document
+	b.schema(problem.Problem{})
+
+	b.health()
+	b.auth()
+	b.users()
+	b.projects()
+	b.revisions()
+	b.packages()
+	b.clients()
+	b.stacks()
+	b.trash()
+	b.audit()
+	b.search()
+	b.docs()
+
+	b.doc.Components.Schemas = b.gen.schemas
+	return b.doc
+}
+
+func (b *builder) health() {
+	status := &Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{"status": {Type: "string", Enum: []string{"ok"}}},
+		Required:   []string{"status"},
+	}
+	b.add("GET", "/healthz", &Operation{
+		OperationID: "healthz",
+		Summary:     "Liveness probe",
+		Description: "Reports that the process is alive without checking any dependency.",
+		Tags:        []string{"Health"},
+		Responses:   responses(schemaResponse(http.StatusOK, "The process is alive", status)),
+	})
+	b.add("GET", "/readyz", &Operation{
+		OperationID: "readyz",
+		Summary:     "Readiness probe",
+		Description: "Runs every dependency check. Answers 503 when one fails or the server is draining.",
+		Tags:        []string{"Health"},
+		Responses: responses(
+			b.json(http.StatusOK, "Ready to receive traffic", models.ReadinessResponse{}),
+			b.json(http.StatusServiceUnavailable, "A dependency check failed or the server is draining", models.ReadinessResponse{}),
+		),
+	})
+	b.add("GET", "/api/status", &Operation{
+		OperationID: "getStatus",
+		Summary:     "Build and dependency status",
+		Tags:        []string{"Health"},
+		Responses:   responses(b.json(http.StatusOK, "Build information and dependency latencies", models.StatusResponse{})),
+	})
+}
+
+func (b *builder) auth() {
+	b.add("POST", "/api/auth/login", &Operation{
+		OperationID: "login",
+		Summary:     "Exchange an email and password for tokens",
+		Tags:        []string{"Auth"},
+		RequestBody: b.body(models.LoginData{}),
+		Responses: responses(
+			b.json(http.StatusOK, "A new access and refresh token pair", models.TokenResponse{}),
+			problemResponse(http.StatusBadRequest, "The body is not valid JSON"),
+			problemResponse(http.StatusUnauthorized, "Wrong email or password"),
+			problemResponse(http.StatusUnprocessableEntity, "Email or password is missing"),
+		),
+	})
+	b.add("POST", "/api/auth/refresh", &Operation{
+		OperationID: "refreshToken",
+		Summary:     "Exchange a refresh token for a new token pair",
+		Tags:        []string{"Auth"},
+		RequestBody: b.body(models.RefreshData{}),
+		Responses: responses(
+			b.json(http.StatusOK, "A new access and refresh token pair", models.TokenResponse{}),
+			problemResponse(http.StatusBadRequest, "The body is not valid JSON"),
+			problemResponse(http.StatusUnauthorized, "The refresh token is invalid or expired"),
+		),
+	})
+}
+
+func (b *builder) users() {
+	b.add("POST", "/api/users/new", &Operation{
+		OperationID: "createUser",
+		Summary:     "Create a user",
+		Description: "The role defaults to viewer.",
+		Tags:        []string{"Users"},
+		RequestBody: b.body(models.UserData{}),
+		Responses: responses(
+			b.json(http.StatusCreated, "The created user", models.UserResponse{}),
+			problemResponse(http.StatusBadRequest, "The body is not valid JSON"),
+			problemResponse(http.StatusConflict, "A user with this email already exists"),
+			problemResponse(http.StatusUnprocessableEntity, "A field is invalid"),
+		),
+	}, admins)
+	b.add("GET", "/api/users", &Operation{
+		OperationID: "listUsers",
+		Summary:     "List users",
+		Tags:        []string{"Users"},
+		Responses:   responses(b.json(http.StatusOK, "Every user", []models.UserResponse{})),
+	}, admins)
+	b.add("DELETE", "/api/users/{id}", &Operation{
+		OperationID: "deleteUser",
+		Summary:     "Delete a user",
+		Tags:        []string{"Users"},
+		Parameters:  []Parameter{idParam("user")},
+		Responses: responses(
+			messageResponse("The user was deleted"),
+			problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+			problemResponse(http.StatusNotFound, "No such user"),
+		),
+	}, admins)
+}
+
+func (b *builder) projects() {
+	b.add("POST", "/api/projects/new", &Operation{
+		OperationID: "createProject",
+		Summary:     "Create a project",
+		Description: "Stacks are matched by name or slug and created when new.",
+		Tags:        []string{"Projects"},
+		RequestBody: b.body(models.ProjectData{}),
+		Responses: responses(
+			b.created("The created project", models.ProjectResponse{}),
+			problemResponse(http.StatusBadRequest, "The body is not valid JSON"),
+			problemResponse(http.StatusUnprocessableEntity, "A field is invalid, or the client or a package does not exist"),
+		),
+	}, editors)
+	b.add("GET", "/api/projects", &Operation{
+		OperationID: "listProjects",
+		Summary:     "List projects",
+		Tags:        []string{"Projects"},
+		Parameters: append(listParams("id", "name", "created_at", "updated_at"),
+			queryParam("name_contains", "Only projects whose name contains this text, ignoring case", &Schema{Type: "string"}),
+			queryParam("stack", "Only projects built with this stack, by name or slug", &Schema{Type: "string"}),
+			queryParam("client", "Only projects built for this client ID", &Schema{Type: "integer"}),
+			queryParam("package", "Only projects using this package ID", &Schema{Type: "integer"}),
+			queryParam("updated_since", "Only projects updated at or after this time", &Schema{Type: "string", Format: "date-time"}),
+		),
+		Responses: responses(
+			b.page("One page of projects", models.ProjectResponse{}),
+			problemResponse(http.StatusBadRequest, "A paging, sort or filter parameter is invalid"),
+		),
+	})
+	b.add("GET", "/api/projects/{id}", &Operation{
+		OperationID: "getProject",
+		Summary:     "Get a project",
+		Tags:        []string{"Projects"},
+		Parameters:  []Parameter{idParam("project"), ifNoneMatchParam()},
+		Responses: responses(
+			b.tagged(http.StatusOK, "The project", models.ProjectResponse{}),
+			notModifiedResponse(),
+			problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+			problemResponse(http.StatusNotFound, "No such project"),
+		),
+	})
+	b.add("PUT", "/api/projects/{id}", &Operation{
+		OperationID: "replaceProject",
+		Summary:     "Replace a project",
+		Description: "Every field and edge is written, so omitting clientId detaches the client.",
+		Tags:        []string{"Projects"},
+		Parameters:  []Parameter{idParam("project"), ifMatchParam()},
+		RequestBody: b.body(models.ProjectData{}),
+		Responses:   b.writeResponses("project", models.ProjectResponse{}, true),
+	}, editors)
+	b.add("PATCH", "/api/projects/{id}", &Operation{
+		OperationID: "patchProject",
+		Summary:     "Patch a project",
+		Description: "Setting a field to null clears it.",
+		Tags:        []string{"Projects"},
+		Parameters:  []Parameter{idParam("project"), ifMatchParam()},
+		RequestBody: patchBody("ProjectData"),
+		Responses:   b.patchResponses("project", models.ProjectResponse{}),
+	}, editors)
+	b.add("DELETE", "/api/projects/{id}", &Operation{
+		OperationID: "deleteProject",
+		Summary:     "Move a project to the trash",
+		Tags:        []string{"Projects"},
+		Parameters:  []Parameter{idParam("project"), ifMatchParam()},
+		Responses:   deleteResponses("project"),
+	}, admins)
+}
+
+func (b *builder) revisions() {
+	b.add("GET", "/api/projects/{id}/revisions", &Operation{
+		OperationID: "listProjectRevisions",
+		Summary:     "List the revisions of a project",
+		Description: "Newest first unless sort says otherwise.",
+		Tags:        []string{"Revisions"},
+		Parameters:  append([]Parameter{idParam("project")}, listParams("revision", "created_at")...),
+		Responses: responses(
+			b.page("One page of revisions", models.ProjectRevisionResponse{}),
+			problemResponse(http.StatusBadRequest, "The ID or a paging or sort parameter is invalid"),
+			problemResponse(http.StatusNotFound, "No such project"),
+		),
+	})
+	b.add("GET", "/api/projects/{id}/revisions/diff", &Operation{
+		OperationID: "diffProjectRevisions",
+		Summary:     "Compare two revisions of a project",
+		Description: "Returns only the fields that differ, keyed by field name.",
+		Tags:        []string{"Revisions"},
+		Parameters: []Parameter{
+			idParam("project"),
+			requiredQueryParam("from", "Revision to compare from", &Schema{Type: "integer"}),
+			requiredQueryParam("to", "Revision to compare to", &Schema{Type: "integer"}),
+		},
+		Responses: responses(
+			b.json(http.StatusOK, "The changed fields", map[string]models.FieldChange{}),
+			problemResponse(http.StatusBadRequest, "The ID or a revision number is missing or invalid"),
+			problemResponse(http.StatusNotFound, "No such project or revision"),
+		),
+	})
+	b.add("GET", "/api/projects/{id}/revisions/{rev}", &Operation{
+		OperationID: "getProjectRevision",
+		Summary:     "Get one revision of a project",
+		Tags:        []string{"Revisions"},
+		Parameters:  []Parameter{idParam("project"), revParam()},
+		Responses: responses(
+			b.json(http.StatusOK, "The project as saved at that revision", models.ProjectRevisionResponse{}),
+			problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+			problemResponse(http.StatusNotFound, "No such project or revision"),
+		),
+	})
+	b.add("POST", "/api/projects/{id}/revisions/{rev}/restore", &Operation{
+		OperationID: "restoreProjectRevision",
+		Summary:     "Roll a project back to a revision",
+		Description: "The rollback is saved as a new version.",
+		Tags:        []string{"Revisions"},
+		Parameters:  []Parameter{idParam("project"), revParam(), ifMatchParam()},
+		Responses: responses(
+			b.tagged(http.StatusOK, "The project after the rollback", models.ProjectResponse{}),
+			problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+			problemResponse(http.StatusNotFound, "No such project or revision"),
+			problemResponse(http.StatusConflict, "The revision refers to a client or package that is in the trash or was purged"),
+			problemResponse(http.StatusPreconditionFailed, "The project was modified since it was read"),
+			problemResponse(http.StatusUnprocessableEntity, "The revision refers to a client or package that no longer exists"),
+			problemResponse(http.StatusPreconditionRequired, "If-Match is missing"),
+		),
+	}, editors)
+}
+
+func (b *builder) packages() {
+	b.add("POST", "/api/packages/new", &Operation{
+		OperationID: "createPackage",
+		Summary:     "Create a package",
+		Tags:        []string{"Packages"},
+		RequestBody: b.body(models.PackageData{}),
+		Responses: responses(
+			b.created("The created package", models.PackageResponse{}),
+			problemResponse(http.StatusBadRequest, "The body is not valid JSON"),
+			problemResponse(http.StatusConflict, "A package with this name already exists"),
+			problemResponse(http.StatusUnprocessableEntity, "A field is invalid"),
+		),
+	}, editors)
+	b.add("GET", "/api/packages", &Operation{
+		OperationID: "listPackages",
+		Summary:     "List packages",
+		Tags:        []string{"Packages"},
+		Parameters: append(listParams("id", "name", "created_at", "updated_at"),
+			queryParam("name_contains", "Only packages whose name contains this text, ignoring case", &Schema{Type: "string"}),
+			queryParam("stack", "Only packages for this stack, by name or slug", &Schema{Type: "string"}),
+			queryParam("updated_since", "Only packages updated at or after this time", &Schema{Type: "string", Format: "date-time"}),
+		),
+		Responses: responses(
+			b.page("One page of packages", models.PackageResponse{}),
+			problemResponse(http.StatusBadRequest, "A paging, sort or filter parameter is invalid"),
+		),
+	})
+	b.add("GET", "/api/packages/{id}", &Operation{
+		OperationID: "getPackage",
+		Summary:     "Get a package",
+		Tags:        []string{"Packages"},
+		Parameters:  []Parameter{idParam("package"), ifNoneMatchParam()},
+		Responses: responses(
+			b.tagged(http.StatusOK, "The package", models.PackageResponse{}),
+			notModifiedResponse(),
+			problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+			problemResponse(http.StatusNotFound, "No such package"),
+		),
+	})
+	b.add("GET", "/api/packages/{id}/projects", &Operation{
+		OperationID: "listPackageProjects",
+		Summary:     "List the projects that use a package",
+		Tags:        []string{"Packages"},
+		Parameters:  []Parameter{idParam("package")},
+		Responses: responses(
+			b.json(http.StatusOK, "Every project using the package", []models.ProjectResponse{}),
+			problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+			problemResponse(http.StatusNotFound, "No such package"),
+		),
+	})
+	b.add("PUT", "/api/packages/{id}", &Operation{
+		OperationID: "replacePackage",
+		Summary:     "Replace a package",
+		Description: "Every field is written, so omitted optional fields are cleared.",
+		Tags:        []string{"Packages"},
+		Parameters:  []Parameter{idParam("package"), ifMatchParam()},
+		RequestBody: b.body(models.PackageData{}),
+		Responses:   b.writeResponses("package", models.PackageResponse{}, false),
+	}, editors)
+	b.add("PATCH", "/api/packages/{id}", &Operation{
+		OperationID: "patchPackage",
+		Summary:     "Patch a package",
+		Description: "Setting link or description to null clears it.",
+		Tags:        []string{"Packages"},
+		Parameters:  []Parameter{idParam("package"), ifMatchParam()},
+		RequestBody: patchBody("PackageData"),
+		Responses:   b.patchResponses("package", models.PackageResponse{}),
+	}, editors)
+	b.add("DELETE", "/api/packages/{id}", &Operation{
+		OperationID: "deletePackage",
+		Summary:     "Move a package to the trash",
+		Tags:        []string{"Packages"},
+		Parameters:  []Parameter{idParam("package"), ifMatchParam()},
+		Responses:   deleteResponses("package"),
+	}, admins)
+}
+
+func (b *builder) clients() {
+	b.add("POST", "/api/clients/new", &Operation{
+		OperationID: "createClient",
+		Summary:     "Create a client",
+		Tags:        []string{"Clients"},
+		RequestBody: b.body(models.ClientData{}),
+		Responses: responses(
+			b.created("The created client", models.ClientResponse{}),
+			problemResponse(http.StatusBadRequest, "The body is not valid JSON"),
+			problemResponse(http.StatusConflict, "A client with this name already exists"),
+			problemResponse(http.StatusUnprocessableEntity, "A field is invalid"),
+		),
+	}, editors)
+	b.add("GET", "/api/clients", &Operation{
+		OperationID: "listClients",
+		Summary:     "List clients",
+		Tags:        []string{"Clients"},
+		Parameters: append(listParams("id", "name", "created_at", "updated_at"),
+			queryParam("name_contains", "Only clients whose name contains this text, ignoring case", &Schema{Type: "string"}),
+			queryParam("updated_since", "Only clients updated at or after this time", &Schema{Type: "string", Format: "date-time"}),
+		),
+		Responses: responses(
+			b.page("One page of clients", models.ClientResponse{}),
+			problemResponse(http.StatusBadRequest, "A paging, sort or filter parameter is invalid"),
+		),
+	})
+	b.add("GET", "/api/clients/{id}", &Operation{
+		OperationID: "getClient",
+		Summary:     "Get a client",
+		Tags:        []string{"Clients"},
+		Parameters:  []Parameter{idParam("client"), ifNoneMatchParam()},
+		Responses: responses(
+			b.tagged(http.StatusOK, "The client", models.ClientResponse{}),
+			notModifiedResponse(),
+			problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+			problemResponse(http.StatusNotFound, "No such client"),
+		),
+	})
+	b.add("GET", "/api/clients/{id}/projects", &Operation{
+		OperationID: "listClientProjects",
+		Summary:     "List the projects built for a client",
+		Tags:        []string{"Clients"},
+		Parameters:  []Parameter{idParam("client")},
+		Responses: responses(
+			b.json(http.StatusOK, "Every project built for the client", []models.ProjectResponse{}),
+			problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+			problemResponse(http.StatusNotFound, "No such client"),
+		),
+	})
+	b.add("PUT", "/api/clients/{id}", &Operation{
+		OperationID: "replaceClient",
+		Summary:     "Replace a client",
+		Tags:        []string{"Clients"},
+		Parameters:  []Parameter{idParam("client"), ifMatchParam()},
+		RequestBody: b.body(models.ClientData{}),
+		Responses:   b.writeResponses("client", models.ClientResponse{}, false),
+	}, editors)
+	b.add("PATCH", "/api/clients/{id}", &Operation{
+		OperationID: "patchClient",
+		Summary:     "Patch a client",
+		Tags:        []string{"Clients"},
+		Parameters:  []Parameter{idParam("client"), ifMatchParam()},
+		RequestBody: patchBody("ClientData"),
+		Responses:   b.patchResponses("client", models.ClientResponse{}),
+	}, editors)
+	b.add("DELETE", "/api/clients/{id}", &Operation{
+		OperationID: "deleteClient",
+		Summary:     "Move a client to the trash",
+		Tags:        []string{"Clients"},
+		Parameters:  []Parameter{idParam("client"), ifMatchParam()},
+		Responses:   deleteResponses("client"),
+	}, admins)
+}
+
+func (b *builder) stacks() {
+	b.add("POST", "/api/stacks/new", &Operation{
+		OperationID: "createStack",
+		Summary:     "Create a stack",
+		Description: "The slug is derived from the name when omitted.",
+		Tags:        []string{"Stacks"},
+		RequestBody: b.body(models.StackData{}),
+		Responses: responses(
+			b.json(http.StatusCreated, "The created stack", models.StackResponse{}),
+			problemResponse(http.StatusBadRequest, "The body is not valid JSON"),
+			problemResponse(http.StatusConflict, "A stack with this slug already exists"),
+			problemResponse(http.StatusUnprocessableEntity, "A field is invalid"),
+		),
+	}, editors)
+	b.add("GET", "/api/stacks", &Operation{
+		OperationID: "listStacks",
+		Summary:     "List stacks",
+		Description: "Ordered by name.",
+		Tags:        []string{"Stacks"},
+		Responses:   responses(b.json(http.StatusOK, "Every stack", []models.StackResponse{})),
+	})
+	b.add("GET", "/api/stacks/{slug}", &Operation{
+		OperationID: "getStack",
+		Summary:     "Get a stack",
+		Tags:        []string{"Stacks"},
+		Parameters:  []Parameter{slugParam()},
+		Responses: responses(
+			b.json(http.StatusOK, "The stack", models.StackResponse{}),
+			problemResponse(http.StatusNotFound, "No such stack"),
+		),
+	})
+	b.add("GET", "/api/stacks/{slug}/projects", &Operation{
+		OperationID: "listStackProjects",
+		Summary:     "List the projects built with a stack",
+		Tags:        []string{"Stacks"},
+		Parameters:  []Parameter{slugParam()},
+		Responses: responses(
+			b.json(http.StatusOK, "Every project built with the stack", []models.ProjectResponse{}),
+			problemResponse(http.StatusNotFound, "No such stack"),
+		),
+	})
+	stackWrite := func() map[string]Response {
+		return responses(
+			b.json(http.StatusOK, "The updated stack", models.StackResponse{}),
+			problemResponse(http.StatusBadRequest, "The body is not valid JSON"),
+			problemResponse(http.StatusNotFound, "No such stack"),
+			problemResponse(http.StatusConflict, "A stack with this slug already exists"),
+			problemResponse(http.StatusUnprocessableEntity, "A field is invalid"),
+		)
+	}
+	b.add("PUT", "/api/stacks/{slug}", &Operation{
+		OperationID: "replaceStack",
+		Summary:     "Replace a stack",
+		Description: "An empty slug is derived from the name again.",
+		Tags:        []string{"Stacks"},
+		Parameters:  []Parameter{slugParam()},
+		RequestBody: b.body(models.StackData{}),
+		Responses:   stackWrite(),
+	}, editors)
+	patch := stackWrite()
+	patch["415"] = problemResponse(http.StatusUnsupportedMediaType, "The patch media type is not supported").Response
+	b.add("PATCH", "/api/stacks/{slug}", &Operation{
+		OperationID: "patchStack",
+		Summary:     "Patch a stack",
+		Description: "Setting category or iconUrl to null clears it.",
+		Tags:        []string{"Stacks"},
+		Parameters:  []Parameter{slugParam()},
+		RequestBody: patchBody("StackData"),
+		Responses:   patch,
+	}, editors)
+	b.add("DELETE", "/api/stacks/{slug}", &Operation{
+		OperationID: "deleteStack",
+		Summary:     "Delete a stack",
+		Description: "The stack is detached from its projects and packages.",
+		Tags:        []string{"Stacks"},
+		Parameters:  []Parameter{slugParam()},
+		Responses: responses(
+			messageResponse("The stack was deleted"),
+			problemResponse(http.StatusNotFound, "No such stack"),
+		),
+	}, admins)
+}
+
+func (b *builder) trash() {
+	b.add("GET", "/api/trash", &Operation{
+		OperationID: "listTrash",
+		Summary:     "List soft-deleted entities",
+		Description: "Most recently deleted first.",
+		Tags:        []string{"Trash"},
+		Parameters:  []Parameter{kindParam()},
+		Responses: responses(
+			b.json(http.StatusOK, "Every entity in the trash", []models.TrashItem{}),
+			problemResponse(http.StatusBadRequest, "kind names an unknown entity"),
+		),
+	}, admins)
+	for _, entity := range []struct {
+		path, name string
+		response   any
+	}{
+		{"/api/projects/{id}/restore", "project", models.ProjectResponse{}},
+		{"/api/packages/{id}/restore", "package", models.PackageResponse{}},
+		{"/api/clients/{id}/restore", "client", models.ClientResponse{}},
+	} {
+		b.add("POST", entity.path, &Operation{
+			OperationID: "restore" + title(entity.name),
+			Summary:     "Move a " + entity.name + " out of the trash",
+			Tags:        []string{"Trash"},
+			Parameters:  []Parameter{idParam(entity.name)},
+			Responses: responses(
+				b.json(http.StatusOK, "The restored "+entity.name, entity.response),
+				problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+				problemResponse(http.StatusNotFound, "No such "+entity.name+" in the trash"),
+			),
+		}, admins)
+	}
+}
+
+func (b *builder) audit() {
+	b.add("GET", "/api/audit", &Operation{
+		OperationID: "listAuditEvents",
+		Summary:     "List audit events",
+		Description: "Newest first unless sort says otherwise.",
+		Tags:        []string{"Audit"},
+		Parameters: append(listParams("id", "created_at"),
+			queryParam("entity", "Only events for this entity type, e.g. Projects", &Schema{Type: "string"}),
+			queryParam("entity_id", "Only events for this entity ID", &Schema{Type: "integer"}),
+			queryParam("actor", "Only events caused by this user ID", &Schema{Type: "integer"}),
+			queryParam("since", "Only events at or after this time", &Schema{Type: "string", Format: "date-time"}),
+			queryParam("until", "Only events before this time", &Schema{Type: "string", Format: "date-time"}),
+		),
+		Responses: responses(
+			b.page("One page of audit events", models.AuditEventResponse{}),
+			problemResponse(http.StatusBadRequest, "A paging, sort or filter parameter is invalid"),
+		),
+	}, admins)
+}
+
+func (b *builder) search() {
+	b.add("GET", "/api/search", &Operation{
+		OperationID: "search",
+		Summary:     "Search projects, packages and clients",
+		Description: "Matches names, descriptions and stacks. Hits are ranked best first.",
+		Tags:        []string{"Search"},
+		Parameters: []Parameter{
+			requiredQueryParam("q", "Search terms", &Schema{Type: "string", MinLength: intPtr(1)}),
+			queryParam("limit", "Maximum number of hits, at most 100; defaults to 20", &Schema{Type: "integer", Minimum: floatPtr(1)}),
+			kindParam(),
+		},
+		Responses: responses(
+			b.json(http.StatusOK, "Ranked hits", []models.SearchHit{}),
+			problemResponse(http.StatusBadRequest, "q is missing, or limit or kind is invalid"),
+			problemResponse(http.StatusUnprocessableEntity, "q is blank"),
+		),
+	})
+}
+
+func (b *builder) docs() {
+	b.add("GET", "/openapi.json", &Operation{
+		OperationID: "getOpenAPI",
+		Summary:     "This OpenAPI document",
+		Tags:        []string{"Docs"},
+		Responses:   responses(schemaResponse(http.StatusOK, "The OpenAPI document", &Schema{Type: "object"})),
+	})
+	b.add("GET", "/metrics", &Operation{
+		OperationID: "metrics",
+		Summary:     "Prometheus metrics",
+		Tags:        []string{"Docs"},
+		Responses: responses(response{strconv.Itoa(http.StatusOK), Response{
+			Description: "Metrics in the Prometheus text format",
+			Content:     map[string]MediaType{"text/plain": {Schema: &Schema{Type: "string"}}},
+		}}),
+	})
+}
+
+// add registers op under path and method. roles, when given, are the roles
+// allowed to call it; the operation then requires a bearer token and may
+// answer 401 and 403.
+func (b *builder) add(method, path string, op *Operation, roles ...[]string) {
+	if len(roles) > 0 {
+		op.Security = []map[string][]string{{"bearerAuth": {}}}
+		requires := "Requires the " + strings.Join(roles[0], " or ") + " role."
+		op.Description = strings.TrimSpace(op.Description + " " + requires)
+		op.Responses["401"] = problemResponse(http.StatusUnauthorized, "The bearer token is missing or invalid").Response
+		op.Responses["403"] = problemResponse(http.StatusForbidden, "The caller's role may not do this").Response
+	}
+
+	// Requests are checked against the document before the handler runs
+	for _, param := range op.Parameters {
+		if _, ok := op.Responses["400"]; !ok && param.In != "header" {
+			op.Responses["400"] = problemResponse(http.StatusBadRequest, "A path or query parameter is invalid").Response
+		}
+	}
+	if op.RequestBody != nil {
+		if _, ok := op.Responses["415"]; !ok {
+			op.Responses["415"] = problemResponse(http.StatusUnsupportedMediaType, "The body media type is not supported").Response
+		}
+		op.Responses["413"] = problemResponse(http.StatusRequestEntityTooLarge, "The body is over the server's size limit").Response
+	}
+
+	item, ok := b.doc.Paths[path]
+	if !ok {
+		item = PathItem{}
+		b.doc.Paths[path] = item
+	}
+	item[strings.ToLower(method)] = op
+}
+
+// schema returns the schema of the type of v
+func (b *builder) schema(v any) *Schema {
+	return b.gen.schemaOf(reflect.TypeOf(v))
+}
+
+// body is a required JSON request body shaped like v
+func (b *builder) body(v any) *RequestBody {
+	return &RequestBody{
+		Required: true,
+		Content:  map[string]MediaType{jsonType: {Schema: b.schema(v)}},
+	}
+}
+
+// patchBody accepts a merge patch of the named data schema or a JSON Patch.
+// Merge patches may set fields to null, so they are not checked against the
+// data schema itself; the patched result is validated like a replace.
+func patchBody(data string) *RequestBody {
+	mergePatch := &MediaType{Schema: &Schema{
+		Type:        "object",
+		Description: "JSON Merge Patch of " + data + "; null clears a field",
+	}}
+	return &RequestBody{
+		Required: true,
+		Content: map[string]MediaType{
+			mergePatchType: *mergePatch,
+			jsonType:       *mergePatch,
+			jsonPatchType:  {Schema: &Schema{Type: "array", Items: ref("JSONPatchOperation")}},
+		},
+	}
+}
+
+// response pairs a status code with its description
+type response struct {
+	status string
+	Response
+}
+
+// responses collects rs into an operation's responses. Every operation may
+// also fail with an unexpected error, a timeout or an unavailable database,
+// which the default problem response covers.
+func responses(rs ...response) map[string]Response {
+	out := map[string]Response{
+		"default": {
+			Description: "Unexpected error, timeout or unavailable dependency",
+			Content:     map[string]MediaType{problem.ContentType: {Schema: ref("Problem")}},
+		},
+	}
+	for _, r := range rs {
+		out[r.status] = r.Response
+	}
+	return out
+}
+
+func (b *builder) json(status int, description string, v any) response {
+	return schemaResponse(status, description, b.schema(v))
+}
+
+func schemaResponse(status int, description string, schema *Schema) response {
+	return response{strconv.Itoa(status), Response{
+		Description: description,
+		Content:     map[string]MediaType{jsonType: {Schema: schema}},
+	}}
+}
+
+// tagged is a JSON response carrying the entity's version in ETag
+func (b *builder) tagged(status int, description string, v any) response {
+	r := b.json(status, description, v)
+	r.Headers = map[string]Header{
+		"ETag": {Description: "Version of the entity, followed for projects and packages by a hash of the entities they embed; send it back in If-Match to write", Schema: &Schema{Type: "string"}},
+	}
+	return r
+}
+
+func (b *builder) created(description string, v any) response {
+	return b.tagged(http.StatusCreated, description, v)
+}
+
+// page is a JSON array of v as returned by the paged list routes
+func (b *builder) page(description string, v any) response {
+	r := schemaResponse(http.StatusOK, description, &Schema{Type: "array", Items: b.schema(v)})
+	r.Headers = map[string]Header{
+		"X-Total-Count": {Description: "Number of items matching the filters across all pages", Schema: &Schema{Type: "integer"}},
+		"X-Next-Cursor": {Description: "Cursor of the next page, when there is one and cursor paging is used", Schema: &Schema{Type: "string"}},
+		"Link":          {Description: "RFC 8288 links to the next and previous pages", Schema: &Schema{Type: "string"}},
+	}
+	return r
+}
+
+func problemResponse(status int, description string) response {
+	return response{strconv.Itoa(status), Response{
+		Description: description,
+		Content:     map[string]MediaType{problem.ContentType: {Schema: ref("Problem")}},
+	}}
+}
+
+func messageResponse(description string) response {
+	return schemaResponse(http.StatusOK, description, ref("Message"))
+}
+
+func notModifiedResponse() response {
+	return response{strconv.Itoa(http.StatusNotModified), Response{Description: "If-None-Match already names the current version"}}
+}
+
+// writeResponses are the responses of a version-guarded replace. Packages
+// and clients have unique names; projects instead reject unknown client and
+// package IDs with 422.
+func (b *builder) writeResponses(entity string, v any, edges bool) map[string]Response {
+	out := responses(
+		b.tagged(http.StatusOK, "The updated "+entity, v),
+		problemResponse(http.StatusBadRequest, "The ID is not an integer or the body is not valid JSON"),
+		problemResponse(http.StatusNotFound, "No such "+entity),
+		problemResponse(http.StatusPreconditionFailed, "The "+entity+" was modified since it was read"),
+		problemResponse(http.StatusUnprocessableEntity, "A field is invalid"),
+		problemResponse(http.StatusPreconditionRequired, "If-Match is missing"),
+	)
+	if edges {
+		out["422"] = problemResponse(http.StatusUnprocessableEntity, "A field is invalid, or the client or a package does not exist").Response
+	} else {
+		out["409"] = problemResponse(http.StatusConflict, "Another "+entity+" already uses the name").Response
+	}
+	return out
+}
+
+// patchResponses are writeResponses plus the patch media type check
+func (b *builder) patchResponses(entity string, v any) map[string]Response {
+	out := b.writeResponses(entity, v, entity == "project")
+	out["415"] = problemResponse(http.StatusUnsupportedMediaType, "The patch media type is not supported").Response
+	return out
+}
+
+func deleteResponses(entity string) map[string]Response {
+	return responses(
+		messageResponse("The "+entity+" was moved to the trash"),
+		problemResponse(http.StatusBadRequest, "The ID is not an integer"),
+		problemResponse(http.StatusNotFound, "No such "+entity),
+		problemResponse(http.StatusPreconditionFailed, "The "+entity+" was modified since it was read"),
+		problemResponse(http.StatusPreconditionRequired, "If-Match is missing"),
+	)
+}
+
+func idParam(entity string) Parameter {
+	return Parameter{Name: "id", In: "path", Description: title(entity) + " ID", Required: true, Schema: &Schema{Type: "integer"}}
+}
+
+func revParam() Parameter {
+	return Parameter{Name: "rev", In: "path", Description: "Revision number, the version that leads the project's ETag", Required: true, Schema: &Schema{Type: "integer", Minimum: floatPtr(0)}}
+}
+
+func slugParam() Parameter {
+	return Parameter{Name: "slug", In: "path", Description: "Stack slug", Required: true, Schema: &Schema{Type: "string"}}
+}
+
+func ifMatchParam() Parameter {
+	return Parameter{
+		Name:        "If-Match",
+		In:          "header",
+		Description: "ETag from the last read, or * to skip the version check",
+		Required:    true,
+		Schema:      &Schema{Type: "string"},
+	}
+}
+
+func ifNoneMatchParam() Parameter {
+	return Parameter{Name: "If-None-Match", In: "header", Description: "ETag from the last read; answers 304 when unchanged", Schema: &Schema{Type: "string"}}
+}
+
+// kindParam is a comma-separated list of entity kinds, e.g. kind=project,client
+func kindParam() Parameter {
+	explode := false
+	p := queryParam("kind", "Entity kinds to include; all when omitted", &Schema{
+		Type:  "array",
+		Items: &Schema{Type: "string", Enum: []string{"project", "package", "client"}},
+	})
+	p.Style, p.Explode = "form", &explode
+	return p
+}
+
+func queryParam(name, description string, schema *Schema) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+func requiredQueryParam(name, description string, schema *Schema) Parameter {
+	p := queryParam(name, description, schema)
+	p.Required = true
+	return p
+}
+
+// listParams are the paging and sorting parameters read by listing.Parse
+func listParams(sortFields ...string) []Parameter {
+	return []Parameter{
+		queryParam("limit", "Page size, at most "+strconv.Itoa(listing.MaxLimit)+"; defaults to "+strconv.Itoa(listing.DefaultLimit), &Schema{Type: "integer", Minimum: floatPtr(1)}),
+		queryParam("offset", "Number of items to skip; switches to offset paging", &Schema{Type: "integer", Minimum: floatPtr(0)}),
+		queryParam("cursor", "X-Next-Cursor of the previous page; cannot be combined with offset", &Schema{Type: "string"}),
+		queryParam("sort", "Comma-separated fields to sort by, each optionally prefixed with - for descending: "+strings.Join(sortFields, ", "), &Schema{Type: "string"}),
+	}
+}
+
+func title(s string) string {
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,471 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"project-manager/internal/validation"
+)
+
+// RequestError describes how a request breaks the contract of its operation
+type RequestError struct {
+	Status int               // 400 for parameters and malformed JSON, 415 or 422 for bodies
+	Detail string            // Set when there are no field errors
+	Errors validation.Errors // Offending parameters or body fields
+}
+
+func (e *RequestError) Error() string {
+	if len(e.Errors) > 0 {
+		return e.Errors.Error()
+	}
+	return e.Detail
+}
+
+// muxVariable matches a route variable with its pattern, e.g. {rev:[0-9]+}
+var muxVariable = regexp.MustCompile(`\{([^:}]+):[^}]+\}`)
+
+// Path turns a mux route template into the path the document lists it under
+func Path(template string) string {
+	return muxVariable.ReplaceAllString(template, "{$1}")
+}
+
+// Operation returns the operation documented for method on a mux route
+// template, or nil when the route is not documented
+func (d *Document) Operation(method, template string) *Operation {
+	return d.Paths[Path(template)][strings.ToLower(method)]
+}
+
+// ValidateRequest checks the path variables, query parameters and body of r
+// against op. When the body is read it is replaced, so handlers can read it
+// again. Header parameters are left to the handlers: a missing If-Match is
+// answered with 428, which a generic check could not do.
+func (d *Document) ValidateRequest(op *Operation, r *http.Request, vars map[string]string) *RequestError {
+	var errs validation.Errors
+	for _, param := range op.Parameters {
+		switch param.In {
+		case "path":
+			errs = append(errs, d.validateParam(param, vars[param.Name])...)
+		case "query":
+			errs = append(errs, d.validateParam(param, r.URL.Query().Get(param.Name))...)
+		}
+	}
+	if len(errs) > 0 {
+		return &RequestError{Status: http.StatusBadRequest, Errors: errs}
+	}
+
+	if op.RequestBody == nil {
+		return nil
+	}
+	mediaType := jsonType
+	if header := r.Header.Get("Content-Type"); header != "" {
+		parsed, _, err := mime.ParseMediaType(header)
+		if err != nil {
+			return &RequestError{Status: http.StatusUnsupportedMediaType, Detail: "Content-Type is not a valid media type"}
+		}
+		mediaType = parsed
+	}
+	content, ok := op.RequestBody.Content[mediaType]
+	if !ok {
+		return &RequestError{
+			Status: http.StatusUnsupportedMediaType,
+			Detail: r.Method + " accepts " + strings.Join(mediaTypes(op.RequestBody.Content), " or "),
+		}
+	}
+
+	body, err := io.ReadAll(r.Body)
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &tooLarge):
+		return &RequestError{
+			Status: http.StatusRequestEntityTooLarge,
+			Detail: "The body is larger than " + strconv.FormatInt(tooLarge.Limit, 10) + " bytes",
+		}
+	case err != nil:
+		return &RequestError{Status: http.StatusBadRequest, Detail: "Could not read request body"}
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	value, err := decodeJSON(body)
+	if err != nil {
+		return &RequestError{Status: http.StatusBadRequest, Detail: "Invalid JSON format: " + err.Error()}
+	}
+	if errs := d.ValidateValue(content.Schema, value, "body"); len(errs) > 0 {
+		return &RequestError{Status: http.StatusUnprocessableEntity, Errors: errs}
+	}
+	return nil
+}
+
+// validateParam checks one path or query parameter given as its raw string.
+// Empty values count as absent, as they do for the handlers.
+func (d *Document) validateParam(param Parameter, raw string) validation.Errors {
+	if raw == "" {
+		if param.Required {
+			return validation.Errors{{Field: param.Name, Rule: "required", Message: param.Name + " is required"}}
+		}
+		return nil
+	}
+
+	if param.Schema.Type == "array" {
+		// Arrays are sent comma-separated (style form, explode false)
+		var items []any
+		for i, item := range strings.Split(raw, ",") {
+			value, errs := paramValue(param.Schema.Items, item, fmt.Sprintf("%s[%d]", param.Name, i))
+			if errs != nil {
+				return errs
+			}
+			items = append(items, value)
+		}
+		return d.ValidateValue(param.Schema, items, param.Name)
+	}
+
+	value, errs := paramValue(param.Schema, raw, param.Name)
+	if errs != nil {
+		return errs
+	}
+	return d.ValidateValue(param.Schema, value, param.Name)
+}
+
+// paramValue converts a raw parameter to the JSON value its schema describes
+func paramValue(s *Schema, raw, name string) (any, validation.Errors) {
+	switch s.Type {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(raw, 64); err != nil {
+			return nil, typeError(name, s.Type)
+		}
+		return json.Number(raw), nil
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, typeError(name, "boolean")
+		}
+		return b, nil
+	}
+	return raw, nil
+}
+
+// ValidateResponse checks a response written for op: its status must be
+// documented, or covered by the default response, its Content-Type must be
+// one the status lists and a JSON body must match the schema.
+func (d *Document) ValidateResponse(op *Operation, status int, header http.Header, body []byte) error {
+	response, ok := op.Responses[strconv.Itoa(status)]
+	if !ok {
+		if response, ok = op.Responses["default"]; !ok || status < 400 {
+			return fmt.Errorf("status %d is not documented", status)
+		}
+	}
+
+	if len(response.Content) == 0 {
+		if len(body) > 0 {
+			return fmt.Errorf("status %d is documented without a body but has one", status)
+		}
+		return nil
+	}
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return fmt.Errorf("Content-Type %q is not a valid media type", header.Get("Content-Type"))
+	}
+	content, ok := response.Content[mediaType]
+	if !ok {
+		return fmt.Errorf("Content-Type %s is not documented for status %d, want %s",
+			mediaType, status, strings.Join(mediaTypes(response.Content), " or "))
+	}
+	if !strings.HasSuffix(mediaType, "json") {
+		return nil
+	}
+
+	value, err := decodeJSON(body)
+	if err != nil {
+		return fmt.Errorf("body is not JSON: %w", err)
+	}
+	if errs := d.ValidateValue(content.Schema, value, "body"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateValue checks a decoded JSON value against s and returns one error
+// per offending field, named like validation names them: stacks[2] for an
+// item and client.name for a nested property. Messages use the same words
+// as internal/validation so clients see one vocabulary. name is used for
+// errors about the value itself.
+func (d *Document) ValidateValue(s *Schema, value any, name string) validation.Errors {
+	var errs validation.Errors
+	d.check(s, value, name, &errs)
+	return errs
+}
+
+// resolve follows a $ref to its component schema
+func (d *Document) resolve(s *Schema) *Schema {
+	if s.Ref == "" {
+		return s
+	}
+	return d.Components.Schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]
+}
+
+func (d *Document) check(s *Schema, value any, name string, errs *validation.Errors) {
+	s = d.resolve(s)
+	if value == nil {
+		if !s.Nullable && s.Type != "" {
+			*errs = append(*errs, validation.FieldError{Field: name, Rule: "type", Message: name + " must not be null"})
+		}
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			*errs = append(*errs, typeError(name, "object")...)
+			return
+		}
+		d.checkObject(s, object, name, errs)
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			*errs = append(*errs, typeError(name, "array")...)
+			return
+		}
+		d.checkArray(s, items, name, errs)
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			*errs = append(*errs, typeError(name, "string")...)
+			return
+		}
+		if msg, rule := checkString(s, str); msg != "" {
+			*errs = append(*errs, validation.FieldError{Field: name, Rule: rule, Message: name + " " + msg})
+		}
+	case "integer", "number":
+		number, ok := value.(json.Number)
+		if !ok {
+			*errs = append(*errs, typeError(name, s.Type)...)
+			return
+		}
+		if msg, rule := checkNumber(s, number); msg != "" {
+			*errs = append(*errs, validation.FieldError{Field: name, Rule: rule, Message: name + " " + msg})
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			*errs = append(*errs, typeError(name, "boolean")...)
+		}
+	}
+}
+
+// checkObject checks the properties of object in the order the schema
+// declares them, then any additional properties
+func (d *Document) checkObject(s *Schema, object map[string]any, name string, errs *validation.Errors) {
+	required := map[string]bool{}
+	for _, field := range s.Required {
+		required[field] = true
+	}
+	prefix := name + "."
+	if name == "body" {
+		prefix = ""
+	}
+
+	for _, field := range s.propertyNames() {
+		value, present := object[field]
+		fs := d.resolve(s.Properties[field])
+		blank := !present || (value == nil && !fs.Nullable)
+		if str, ok := value.(string); ok && fs.Type == "string" {
+			// Like validation, whitespace alone does not satisfy required
+			blank = strings.TrimSpace(str) == ""
+		}
+		if required[field] && blank {
+			*errs = append(*errs, validation.FieldError{Field: prefix + field, Rule: "required", Message: prefix + field + " is required"})
+			continue
+		}
+		if present {
+			d.check(fs, value, prefix+field, errs)
+		}
+	}
+
+	if s.AdditionalProperties != nil {
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if _, declared := s.Properties[key]; !declared {
+				d.check(s.AdditionalProperties, object[key], prefix+key, errs)
+			}
+		}
+	}
+}
+
+func (d *Document) checkArray(s *Schema, items []any, name string, errs *validation.Errors) {
+	fail := func(rule, msg string) {
+		*errs = append(*errs, validation.FieldError{Field: name, Rule: rule, Message: name + " " + msg})
+	}
+	switch {
+	case s.MinItems != nil && len(items) < *s.MinItems:
+		fail("min", fmt.Sprintf("must be at least %d items", *s.MinItems))
+		return
+	case s.MaxItems != nil && len(items) > *s.MaxItems:
+		fail("max", fmt.Sprintf("must be at most %d items", *s.MaxItems))
+		return
+	case s.UniqueItems && hasDuplicates(items):
+		fail("unique", "must not contain duplicates")
+		return
+	}
+	if s.Items != nil {
+		for i, item := range items {
+			d.check(s.Items, item, fmt.Sprintf("%s[%d]", name, i), errs)
+		}
+	}
+}
+
+// checkString returns the message and rule of the first constraint str
+// breaks, in the order validation applies the matching rules
+func checkString(s *Schema, str string) (string, string) {
+	// Items and parameters have no required list; minLength carries it
+	if s.MinLength != nil && *s.MinLength > 0 && strings.TrimSpace(str) == "" {
+		return "is required", "required"
+	}
+	if len(s.Enum) > 0 {
+		for _, option := range s.Enum {
+			if str == option {
+				return "", ""
+			}
+		}
+		var options []string
+		for _, option := range s.Enum {
+			if option != "" {
+				options = append(options, option)
+			}
+		}
+		return "must be one of: " + strings.Join(options, ", "), "oneof"
+	}
+	if s.Pattern != "" && !compile(s.Pattern).MatchString(str) {
+		switch {
+		case s.Format == "uri":
+			return "must be a valid http or https URL", "url"
+		case strings.HasSuffix(s.Pattern, trimmedPattern):
+			return "must not start or end with whitespace", "trimmed"
+		default:
+			return "must match " + s.Pattern, "pattern"
+		}
+	}
+	switch s.Format {
+	case "email":
+		if addr, err := mail.ParseAddress(str); err != nil || addr.Address != str {
+			return "must be a valid email address", "email"
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, str); err != nil {
+			return "must be an RFC 3339 timestamp", "format"
+		}
+	}
+	length := len([]rune(str))
+	if s.MinLength != nil && length < *s.MinLength {
+		return fmt.Sprintf("must be at least %d characters", *s.MinLength), "min"
+	}
+	if s.MaxLength != nil && length > *s.MaxLength {
+		return fmt.Sprintf("must be at most %d characters", *s.MaxLength), "max"
+	}
+	return "", ""
+}
+
+func checkNumber(s *Schema, number json.Number) (string, string) {
+	if s.Type == "integer" {
+		if _, err := strconv.ParseInt(number.String(), 10, 64); err != nil {
+			return "must be an integer", "type"
+		}
+	}
+	f, err := number.Float64()
+	if err != nil {
+		return "must be a number", "type"
+	}
+	if s.Minimum != nil && f < *s.Minimum {
+		return "must be at least " + strconv.FormatFloat(*s.Minimum, 'f', -1, 64), "min"
+	}
+	if s.Maximum != nil && f > *s.Maximum {
+		return "must be at most " + strconv.FormatFloat(*s.Maximum, 'f', -1, 64), "max"
+	}
+	return "", ""
+}
+
+func typeError(name, typ string) validation.Errors {
+	article := "a"
+	if typ == "integer" || typ == "object" || typ == "array" {
+		article = "an"
+	}
+	return validation.Errors{{Field: name, Rule: "type", Message: name + " must be " + article + " " + typ}}
+}
+
+func hasDuplicates(items []any) bool {
+	seen := map[string]bool{}
+	for _, item := range items {
+		key, _ := json.Marshal(item)
+		if seen[string(key)] {
+			return true
+		}
+		seen[string(key)] = true
+	}
+	return false
+}
+
+// decodeJSON decodes one JSON document, keeping numbers as json.Number so
+// integers can be told from fractions
+func decodeJSON(body []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func mediaTypes(content map[string]MediaType) []string {
+	types := make([]string, 0, len(content))
+	for mediaType := range content {
+		types = append(types, mediaType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// propertyNames lists the properties in declaration order, falling back to
+// alphabetical order for schemas written by hand
+func (s *Schema) propertyNames() []string {
+	if len(s.order) == len(s.Properties) {
+		return s.order
+	}
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var (
+	patternsMu sync.Mutex
+	patterns   = map[string]*regexp.Regexp{}
+)
+
+// compile caches the regular expressions of schema patterns
+func compile(pattern string) *regexp.Regexp {
+	patternsMu.Lock()
+	defer patternsMu.Unlock()
+	re, ok := patterns[pattern]
+	if !ok {
+		re = regexp.MustCompile(pattern)
+		patterns[pattern] = re
+	}
+	return re
+}
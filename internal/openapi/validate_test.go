@@ -0,0 +1,145 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project-manager/internal/openapi"
+)
+
+// fieldErrors renders validation errors as "field:rule" pairs for comparison
+func fieldErrors(t *testing.T, spec *openapi.Document, schema string, body string) string {
+	t.Helper()
+
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		t.Fatalf("decode %s: %v", body, err)
+	}
+	var pairs []string
+	for _, fe := range spec.ValidateValue(spec.Components.Schemas[schema], value, "body") {
+		pairs = append(pairs, fe.Field+":"+fe.Rule)
+	}
+	return strings.Join(pairs, " ")
+}
+
+func TestValidateValue(t *testing.T) {
+	spec := openapi.Spec()
+	cases := []struct {
+		name, schema, body, want string
+	}{
+		{"valid project", "ProjectData", `{"name":"Portal","imageUrl":"https://a.example.com/p.png","link":"https://a.example.com","description":"d","stacks":["Go"],"clientId":1}`, ""},
+		{"null stacks and client", "ProjectData", `{"name":"Portal","imageUrl":"https://a.example.com/p.png","link":"https://a.example.com","description":"d","stacks":null,"clientId":null}`, ""},
+		{"blank name and optional fields left out", "ProjectData", `{"name":"  ","imageUrl":"https://a.example.com/p.png","link":""}`, "name:required"},
+		{"untrimmed and duplicate stacks", "ProjectData", `{"name":" Portal","imageUrl":"x","link":"https://a.example.com","description":"d","stacks":["Go","Go"]}`, "name:trimmed imageUrl:url stacks:unique"},
+		{"bad stack item", "ProjectData", `{"name":"Portal","imageUrl":"https://a.example.com/p.png","link":"https://a.example.com","description":"d","stacks":["Go",""]}`, "stacks[1]:required"},
+		{"optional fields left empty", "PackageData", `{"name":"ent","link":"","description":""}`, ""},
+		{"optional URL set badly", "PackageData", `{"name":"ent","link":"ftp:/x"}`, "link:url"},
+		{"default role", "UserData", `{"email":"a@example.com","password":"long-enough","role":""}`, ""},
+		{"unknown role and short password", "UserData", `{"email":"a@example.com","password":"short","role":"owner"}`, "password:min role:oneof"},
+		{"fraction for an integer", "ProjectData", `{"name":"Portal","imageUrl":"https://a.example.com/p.png","link":"https://a.example.com","description":"d","clientId":1.5}`, "clientId:type"},
+		{"not an object", "ClientData", `["Acme"]`, "body:type"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fieldErrors(t, spec, tc.schema, tc.body); got != tc.want {
+				t.Errorf("errors = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	spec := openapi.Spec()
+	create := spec.Operation("POST", "/api/packages/new")
+	list := spec.Operation("GET", "/api/packages")
+	revision := spec.Operation("GET", "/api/projects/{id}/revisions/{rev:[0-9]+}")
+
+	cases := []struct {
+		name        string
+		op          *openapi.Operation
+		target      string
+		contentType string
+		body        string
+		vars        map[string]string
+		want        int // 0 when the request is valid
+	}{
+		{name: "valid body", op: create, target: "/api/packages/new", body: `{"name":"ent","stacks":["Go"]}`},
+		{name: "body without Content-Type", op: create, target: "/api/packages/new", contentType: "-", body: `{"name":"ent"}`},
+		{name: "invalid body", op: create, target: "/api/packages/new", body: `{"name":""}`, want: http.StatusUnprocessableEntity},
+		{name: "malformed body", op: create, target: "/api/packages/new", body: `{"name":`, want: http.StatusBadRequest},
+		{name: "unsupported media type", op: create, target: "/api/packages/new", contentType: "text/plain", body: `name=ent`, want: http.StatusUnsupportedMediaType},
+		{name: "valid query", op: list, target: "/api/packages?limit=10&updated_since=2024-01-02T15:04:05Z"},
+		{name: "bad query", op: list, target: "/api/packages?offset=-1", want: http.StatusBadRequest},
+		{name: "valid path", op: revision, target: "/api/projects/1/revisions/2", vars: map[string]string{"id": "1", "rev": "2"}},
+		{name: "bad path", op: revision, target: "/api/projects/x/revisions/2", vars: map[string]string{"id": "x", "rev": "2"}, want: http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			method := "GET"
+			if tc.body != "" {
+				method = "POST"
+			}
+			r := httptest.NewRequest(method, tc.target, strings.NewReader(tc.body))
+			switch tc.contentType {
+			case "":
+				r.Header.Set("Content-Type", "application/json")
+			case "-":
+			default:
+				r.Header.Set("Content-Type", tc.contentType)
+			}
+
+			err := spec.ValidateRequest(tc.op, r, tc.vars)
+			switch {
+			case tc.want == 0 && err != nil:
+				t.Fatalf("valid request rejected with %d: %v", err.Status, err)
+			case tc.want != 0 && err == nil:
+				t.Fatalf("invalid request accepted, want %d", tc.want)
+			case err != nil && err.Status != tc.want:
+				t.Fatalf("status = %d, want %d: %v", err.Status, tc.want, err)
+			}
+
+			// Handlers read the body after the middleware has
+			if body, _ := io.ReadAll(r.Body); tc.want == 0 && string(body) != tc.body {
+				t.Errorf("body after validation = %q, want %q", body, tc.body)
+			}
+		})
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+	spec := openapi.Spec()
+	get := spec.Operation("GET", "/api/stacks/{slug}")
+	jsonHeader := http.Header{"Content-Type": {"application/json"}}
+	problemHeader := http.Header{"Content-Type": {"application/problem+json"}}
+
+	cases := []struct {
+		name   string
+		status int
+		header http.Header
+		body   string
+		valid  bool
+	}{
+		{"documented body", 200, jsonHeader, `{"id":1,"name":"Go","slug":"go"}`, true},
+		{"problem under default", 500, problemHeader, `{"type":"/problems/internal-error","title":"Internal Server Error","status":500,"code":"internal_error"}`, true},
+		{"undocumented status", 201, jsonHeader, `{"id":1,"name":"Go"}`, false},
+		{"wrong media type", 200, http.Header{"Content-Type": {"text/plain"}}, `{"id":1,"name":"Go"}`, false},
+		{"wrong field type", 200, jsonHeader, `{"id":"1","name":"Go"}`, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := spec.ValidateResponse(get, tc.status, tc.header, []byte(tc.body))
+			if tc.valid && err != nil {
+				t.Errorf("valid response rejected: %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("drifting response accepted")
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package problem
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+
+	"project-manager/ent"
+	"project-manager/internal/validation"
+)
+
+// Machine-readable problem codes. Clients should switch on these rather than
+// on the human-readable title or detail.
+const (
+	CodeBadRequest       = "bad_request"
+	CodeUnauthorized     = "unauthorized"
+	CodeForbidden        = "forbidden"
+	CodeNotFound         = "not_found"
+	CodeMethodNotAllowed = "method_not_allowed"
+	CodeConflict         = "conflict"
+	CodeUnsupportedMedia = "unsupported_media_type"
+	CodeTooLarge         = "content_too_large"
+	CodePreconditionFail = "precondition_failed"
+	CodePreconditionReq  = "precondition_required"
+	CodeValidationFailed = "validation_failed"
+	CodeInternal         = "internal_error"
+	CodeUnavailable      = "service_unavailable"
+	CodeTimeout          = "timeout"
+)
+
+// ContentType is the media type of problem responses (RFC 7807)
+const ContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details body extended with a code
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+
+	// Errors lists every invalid field when Code is validation_failed, and
+	// every invalid parameter when a bad_request comes from the API contract
+	Errors validation.Errors `json:"errors,omitempty"`
+}
+
+// Write sends a problem response with the given status, code and detail
+func Write(w http.ResponseWriter, r *http.Request, status int, code, detail string) {
+	send(w, newProblem(r, status, code, detail))
+}
+
+func newProblem(r *http.Request, status int, code, detail string) Problem {
+	return Problem{
+		Type:     "/problems/" + strings.ReplaceAll(code, "_", "-"),
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: r.URL.Path,
+		Code:     code,
+	}
+}
+
+func send(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// BadRequest reports a malformed request, such as invalid JSON or a bad ID
+func BadRequest(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusBadRequest, CodeBadRequest, detail)
+}
+
+// Validation reports a well-formed request whose content is not acceptable
+func Validation(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusUnprocessableEntity, CodeValidationFailed, detail)
+}
+
+// ValidationErrors reports every field that failed validation at once
+func ValidationErrors(w http.ResponseWriter, r *http.Request, errs validation.Errors) {
+	p := newProblem(r, http.StatusUnprocessableEntity, CodeValidationFailed, "The request contains invalid fields")
+	p.Errors = errs
+	send(w, p)
+}
+
+// InvalidParameters reports path or query parameters that break the API contract
+func InvalidParameters(w http.ResponseWriter, r *http.Request, errs validation.Errors) {
+	p := newProblem(r, http.StatusBadRequest, CodeBadRequest, errs.Error())
+	p.Errors = errs
+	send(w, p)
+}
+
+// Unauthorized reports a missing or invalid credential
+func Unauthorized(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusUnauthorized, CodeUnauthorized, detail)
+}
+
+// Forbidden reports an authenticated caller without the required role
+func Forbidden(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusForbidden, CodeForbidden, detail)
+}
+
+// NotFound reports a missing resource
+func NotFound(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusNotFound, CodeNotFound, detail)
+}
+
+// MethodNotAllowed reports a known route called with an unsupported method
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	Write(w, r, http.StatusMethodNotAllowed, CodeMethodNotAllowed, r.Method+" is not supported on this route")
+}
+
+// Conflict reports a write that clashes with existing data
+func Conflict(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusConflict, CodeConflict, detail)
+}
+
+// PreconditionFailed reports a write whose If-Match no longer matches the resource
+func PreconditionFailed(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusPreconditionFailed, CodePreconditionFail, detail)
+}
+
+// PreconditionRequired reports a write sent without an If-Match header
+func PreconditionRequired(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusPreconditionRequired, CodePreconditionReq, detail)
+}
+
+// UnsupportedMediaType reports a body sent with a Content-Type the route cannot read
+func UnsupportedMediaType(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusUnsupportedMediaType, CodeUnsupportedMedia, detail)
+}
+
+// ContentTooLarge reports a body over the configured size limit
+func ContentTooLarge(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusRequestEntityTooLarge, CodeTooLarge, detail)
+}
+
+// ServiceUnavailable reports that a dependency, such as the database, cannot be reached
+func ServiceUnavailable(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusServiceUnavailable, CodeUnavailable, detail)
+}
+
+// GatewayTimeout reports that the request ran out of time before it completed
+func GatewayTimeout(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, http.StatusGatewayTimeout, CodeTimeout, detail)
+}
+
+// Internal logs err and reports a generic server error without leaking it.
+// Errors caused by the request's deadline, its cancellation or an
+// unreachable database are reported as 504 and 503 instead.
+func Internal(w http.ResponseWriter, r *http.Request, err error) {
+	if unavailable(w, r, err) {
+		return
+	}
+	slog.ErrorContext(r.Context(), "request failed", "method", r.Method, "path", r.URL.Path, "error", err)
+	Write(w, r, http.StatusInternalServerError, CodeInternal, "Internal server error")
+}
+
+// unavailable answers 504 or 503 when err comes from the request context or
+// a lost database connection. The driver may report a cancelled statement
+// with its own error, so the request context is checked as well.
+func unavailable(w http.ResponseWriter, r *http.Request, err error) bool {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(r.Context().Err(), context.DeadlineExceeded):
+		slog.WarnContext(r.Context(), "request timed out", "method", r.Method, "path", r.URL.Path, "error", err)
+		GatewayTimeout(w, r, "The request took too long and was cancelled")
+	case errors.Is(err, context.Canceled) || r.Context().Err() != nil:
+		// The client went away; nobody reads this response
+		slog.InfoContext(r.Context(), "request cancelled", "method", r.Method, "path", r.URL.Path)
+		ServiceUnavailable(w, r, "The request was cancelled")
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone), errors.As(err, &netErr):
+		slog.ErrorContext(r.Context(), "database unavailable", "method", r.Method, "path", r.URL.Path, "error", err)
+		ServiceUnavailable(w, r, "The database is unavailable, try again later")
+	default:
+		return false
+	}
+	return true
+}
+
+// FromError maps an error returned by ent to the matching problem. entity is
+// the human name of the resource, e.g. "Project", used in the detail text.
+func FromError(w http.ResponseWriter, r *http.Request, err error, entity string) {
+	var validationErr *ent.ValidationError
+	switch {
+	case ent.IsNotFound(err):
+		NotFound(w, r, entity+" not found")
+	case ent.IsConstraintError(err):
+		Conflict(w, r, entity+" conflicts with existing data")
+	case errors.As(err, &validationErr):
+		Validation(w, r, "Invalid value for "+validationErr.Name)
+	default:
+		Internal(w, r, err)
+	}
+}
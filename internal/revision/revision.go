@@ -0,0 +1,81 @@
+package revision
+
+import (
+	"context"
+
+	"project-manager/ent"
+	"project-manager/ent/hook"
+	"project-manager/ent/packages"
+	"project-manager/ent/projects"
+	"project-manager/ent/schema"
+	"project-manager/ent/stacks"
+)
+
+// Hook saves a ProjectRevisions snapshot whenever a project is created or a
+// write bumps its version, so revision numbers match the project's ETags.
+// Bulk updates are not seen, so writes that bump a version must use
+// UpdateOne. A failed snapshot fails the write, so writes must run in a
+// transaction for it to be rolled back. Register it with client.Projects.Use.
+func Hook() ent.Hook {
+	return hook.If(record, hook.Or(
+		hook.HasOp(ent.OpCreate),
+		hook.And(hook.HasOp(ent.OpUpdateOne), hook.HasAddedFields(projects.FieldVersion)),
+	))
+}
+
+func record(next ent.Mutator) ent.Mutator {
+	return hook.ProjectsFunc(func(ctx context.Context, m *ent.ProjectsMutation) (ent.Value, error) {
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		id, ok := m.ID()
+		if !ok {
+			return v, nil
+		}
+		if err := snapshot(ctx, m.Client(), id); err != nil {
+			return nil, err
+		}
+		return v, nil
+	})
+}
+
+// snapshot saves the current state of a project as a revision
+func snapshot(ctx context.Context, client *ent.Client, projectID int) error {
+	// Keep references to trashed clients and packages so they can be restored
+	ctx = schema.SkipSoftDelete(ctx)
+
+	project, err := client.Projects.Query().
+		Where(projects.ID(projectID)).
+		WithStacks(func(q *ent.StacksQuery) { q.Order(ent.Asc(stacks.FieldID)) }).
+		WithClient().
+		WithPackages(func(q *ent.PackagesQuery) { q.Order(ent.Asc(packages.FieldID)) }).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	stacks := []string{}
+	for _, stack := range project.Edges.Stacks {
+		stacks = append(stacks, stack.Name)
+	}
+	packageIDs := []int{}
+	for _, pkg := range project.Edges.Packages {
+		packageIDs = append(packageIDs, pkg.ID)
+	}
+
+	create := client.ProjectRevisions.Create().
+		SetProjectID(project.ID).
+		SetRevision(project.Version).
+		SetName(project.Name).
+		SetImageUrl(project.ImageUrl).
+		SetLink(project.Link).
+		SetDescription(project.Description).
+		SetStacks(stacks).
+		SetPackageIds(packageIDs)
+	if project.Edges.Client != nil {
+		create.SetClientID(project.Edges.Client.ID)
+	}
+	return create.Exec(ctx)
+}
@@ -0,0 +1,37 @@
+package router_test
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestProjectETagFollowsEmbeddedEntities(t *testing.T) {
+	s := newServer(t)
+
+	rec := s.do("GET", "/api/projects/1", "", "", nil)
+	tag := rec.Header().Get("ETag")
+	if rec.Code != http.StatusOK || tag == "" {
+		t.Fatalf("GET /api/projects/1 = %d with ETag %q", rec.Code, tag)
+	}
+	if rec := s.do("GET", "/api/projects/1", "", "", http.Header{"If-None-Match": {tag}}); rec.Code != http.StatusNotModified {
+		t.Fatalf("GET with the current ETag = %d, want 304", rec.Code)
+	}
+
+	// Renaming the embedded client leaves the project's version alone
+	body := `{"name":"Acme Corp","link":"https://acme.example.com","imageUrl":"https://acme.example.com/logo.png"}`
+	if rec := s.do("PUT", "/api/clients/1", body, "editor", ifMatch(`"1"`)); rec.Code != http.StatusOK {
+		t.Fatalf("PUT /api/clients/1 = %d\n%s", rec.Code, rec.Body)
+	}
+	rec = s.do("GET", "/api/projects/1", "", "", http.Header{"If-None-Match": {tag}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET after renaming the client = %d, want 200", rec.Code)
+	}
+	if rec.Header().Get("ETag") == tag {
+		t.Error("ETag did not change with the embedded client")
+	}
+
+	// Writes still accept the new tag, whose version is unchanged
+	if rec := s.do("DELETE", "/api/projects/1", "", "admin", ifMatch(rec.Header().Get("ETag"))); rec.Code != http.StatusOK {
+		t.Errorf("DELETE with the new ETag = %d\n%s", rec.Code, rec.Body)
+	}
+}
@@ -0,0 +1,221 @@
+package router_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"project-manager/ent"
+	"project-manager/ent/users"
+	"project-manager/internal/auth"
+	"project-manager/internal/config"
+	"project-manager/internal/models"
+	"project-manager/internal/openapi"
+	"project-manager/internal/service"
+	"project-manager/internal/testutil"
+
+	"github.com/gorilla/mux"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// adminPassword is the password of the seeded admin account
+const adminPassword = "correct-horse-battery"
+
+// Routes are served with every optional feature on so that all of them can be tested
+var (
+	testFeatures = config.Features{Swagger: true, Metrics: true, ValidateResponses: true}
+	testServer   = config.Server{MaxBodyBytes: 16 << 10}
+)
+
+// server is the full router over a seeded in-memory SQLite database
+type server struct {
+	router   *mux.Router
+	client   *ent.Client
+	services service.Services
+
+	// tokens holds an access token per role, and "refresh" the admin's refresh token
+	tokens map[string]string
+}
+
+// newServer returns a server over a fresh database seeded by seed. Every
+// call gets its own database, so cases cannot see each other's writes.
+func newServer(t *testing.T) *server {
+	t.Helper()
+
+	app := testutil.NewApp(t, testFeatures, testServer)
+	s := &server{
+		router:   app.Router,
+		client:   app.Client,
+		services: app.Services,
+		tokens:   map[string]string{},
+	}
+	s.seed(t)
+	return s
+}
+
+// seed creates three users, one per role, and a project linked to a client,
+// a package and the Go and React stacks. Every entity gets ID 1 except the
+// users, which are 1 (admin), 2 (editor) and 3 (viewer).
+func (s *server) seed(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+
+	for _, role := range []users.Role{users.RoleAdmin, users.RoleEditor, users.RoleViewer} {
+		name := strings.ToUpper(string(role[:1])) + string(role[1:])
+		user := testutil.CreateUser(t, s.client, string(role)+"@example.com", name, adminPassword, role)
+		access, refresh, err := auth.IssueTokens(user.ID, user.Role)
+		if err != nil {
+			t.Fatalf("issue %s tokens: %v", role, err)
+		}
+		s.tokens[string(role)] = access
+		if role == users.RoleAdmin {
+			s.tokens["refresh"] = refresh
+		}
+	}
+
+	client, err := s.services.Clients.Create(ctx, models.ClientData{
+		Name:     "Acme",
+		Link:     "https://acme.example.com",
+		ImageUrl: "https://acme.example.com/logo.png",
+	})
+	if err != nil {
+		t.Fatalf("seed client: %v", err)
+	}
+	pkg, err := s.services.Packages.Create(ctx, models.PackageData{
+		Name:        "gorilla/mux",
+		Link:        "https://github.com/gorilla/mux",
+		Description: "HTTP router",
+		Stacks:      []string{"Go"},
+	})
+	if err != nil {
+		t.Fatalf("seed package: %v", err)
+	}
+	_, err = s.services.Projects.Create(ctx, models.ProjectData{
+		Name:        "Portal",
+		ImageUrl:    "https://acme.example.com/portal.png",
+		Link:        "https://portal.acme.example.com",
+		Description: "Customer portal for Acme",
+		Stacks:      []string{"Go", "React"},
+		ClientID:    &client.ID,
+		PackageIDs:  []int{pkg.ID},
+	})
+	if err != nil {
+		t.Fatalf("seed project: %v", err)
+	}
+}
+
+// do serves one request. role picks the bearer token; an empty role sends none.
+func (s *server) do(method, path, body, role string, header http.Header) *httptest.ResponseRecorder {
+	body = strings.ReplaceAll(body, "$REFRESH_TOKEN", s.tokens["refresh"])
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if role != "" {
+		req.Header.Set("Authorization", "Bearer "+s.tokens[role])
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	return rec
+}
+
+// assertDocumented fails when a response to method and path does not match
+// the OpenAPI document. Unknown routes have nothing to match.
+func (s *server) assertDocumented(t *testing.T, method, path string, rec *httptest.ResponseRecorder) {
+	t.Helper()
+
+	var match mux.RouteMatch
+	if !s.router.Match(httptest.NewRequest(method, path, nil), &match) || match.Route == nil {
+		return
+	}
+	template, err := match.Route.GetPathTemplate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := openapi.Spec()
+	op := spec.Operation(method, template)
+	if op == nil {
+		return
+	}
+	if err := spec.ValidateResponse(op, rec.Code, rec.Header(), rec.Body.Bytes()); err != nil {
+		t.Errorf("%s %s response does not match the OpenAPI document: %v", method, template, err)
+	}
+}
+
+// volatileKeys are response fields whose values change from run to run
+var volatileKeys = map[string]bool{
+	"createdAt":     true,
+	"updatedAt":     true,
+	"deletedAt":     true,
+	"startedAt":     true,
+	"uptimeSeconds": true,
+	"latencyMs":     true,
+	"accessToken":   true,
+	"refreshToken":  true,
+}
+
+// normalize replaces volatile values in a decoded JSON document with a placeholder
+func normalize(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if volatileKeys[key] && value != nil {
+				v[key] = "<" + key + ">"
+			} else {
+				v[key] = normalize(value)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = normalize(v[i])
+		}
+	}
+	return v
+}
+
+// assertGolden compares a JSON response body with testdata/<name>.json,
+// ignoring volatile fields. Run the tests with -update to rewrite the file.
+func assertGolden(t *testing.T, name string, body []byte) {
+	t.Helper()
+
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("response is not JSON: %v\n%s", err, body)
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(normalize(doc)); err != nil {
+		t.Fatalf("re-encode response: %v", err)
+	}
+	got := buf.Bytes()
+
+	path := filepath.Join("testdata", name+".json")
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("write golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("response does not match %s\n--- got\n%s--- want\n%s", path, got, want)
+	}
+}
@@ -0,0 +1,82 @@
+package router_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"project-manager/internal/openapi"
+
+	"github.com/gorilla/mux"
+)
+
+// muxVariable matches a route variable with its pattern, e.g. {rev:[0-9]+}
+var muxVariable = regexp.MustCompile(`\{([^:}]+):[^}]+\}`)
+
+// TestEveryRouteIsDocumented fails when a route is mounted without a matching
+// operation in the OpenAPI document, or the document describes a route that
+// is not mounted
+func TestEveryRouteIsDocumented(t *testing.T) {
+	s := newServer(t)
+	spec := openapi.Spec()
+
+	mounted := map[string]bool{}
+	err := s.router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			// Swagger UI is a prefix route serving its own files, not part of the API
+			return nil
+		}
+		path := muxVariable.ReplaceAllString(template, "{$1}")
+		for _, method := range methods {
+			if method == http.MethodOptions {
+				continue
+			}
+			method = strings.ToLower(method)
+			mounted[method+" "+path] = true
+			if spec.Paths[path][method] == nil {
+				t.Errorf("%s %s is not in the OpenAPI document", strings.ToUpper(method), path)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, item := range spec.Paths {
+		for method := range item {
+			if !mounted[method+" "+path] {
+				t.Errorf("the OpenAPI document describes %s %s, which is not mounted", strings.ToUpper(method), path)
+			}
+		}
+	}
+}
+
+func TestOpenAPIIsServed(t *testing.T) {
+	s := newServer(t)
+
+	rec := s.do("GET", "/openapi.json", "", "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /openapi.json = %d", rec.Code)
+	}
+	var doc openapi.Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode document: %v", err)
+	}
+	if doc.OpenAPI != openapi.Version || len(doc.Paths) == 0 {
+		t.Errorf("served document has version %q and %d paths", doc.OpenAPI, len(doc.Paths))
+	}
+
+	// Swagger UI must load this document rather than the unregistered swag default
+	rec = s.do("GET", "/swagger/index.html", "", "", nil)
+	if !strings.Contains(rec.Body.String(), "openapi.json") {
+		t.Errorf("Swagger UI does not point at /openapi.json:\n%s", rec.Body)
+	}
+}
@@ -0,0 +1,125 @@
+// Package router mounts every API route on a mux.Router. main wraps the
+// result in CORS, metrics and access logging; tests serve it directly.
+package router
+
+import (
+	"net/http"
+
+	"project-manager/ent/users"
+	"project-manager/internal/config"
+	"project-manager/internal/handlers"
+	"project-manager/internal/metrics"
+	"project-manager/internal/openapi"
+	"project-manager/internal/problem"
+	"project-manager/middleware"
+
+	"github.com/gorilla/mux"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// New returns a router serving the routes of h. features decides whether
+// /metrics and the API documentation are mounted, and server supplies the request timeouts and body size limit.
+func New(h *handlers.Handler, features config.Features, server config.Server) *mux.Router {
+	r := mux.NewRouter()
+
+	// Prometheus metrics for routes, ent queries and the connection pool
+	if features.Metrics {
+		r.Handle("/metrics", metrics.Handler()).Methods("GET")
+	}
+
+	// Cancel handlers and their queries when they run past their route's timeout
+	r.Use(middleware.Timeout(server.RequestTimeout, server.RouteTimeouts))
+
+	// Hold responses to the OpenAPI document in development
+	if features.ValidateResponses {
+		r.Use(middleware.ValidateResponses(openapi.Spec()))
+	}
+
+	// Probe routes for the platform
+	r.HandleFunc("/healthz", handlers.HealthzHandler).Methods("GET")
+	r.HandleFunc("/readyz", h.ReadyzHandler).Methods("GET")
+	r.HandleFunc("/api/status", h.StatusHandler).Methods("GET", "OPTIONS")
+
+	// API routes are held to the OpenAPI document. Mutating routes check the
+	// role first, so anonymous callers never see how a body would be judged.
+	validate := middleware.ValidateRequests(openapi.Spec(), int64(server.MaxBodyBytes))
+	requireEditors := middleware.RequireRole(users.RoleAdmin, users.RoleEditor)
+	requireAdmins := middleware.RequireRole(users.RoleAdmin)
+	public := func(h http.HandlerFunc) http.Handler { return validate(h) }
+	editors := func(h http.HandlerFunc) http.Handler { return requireEditors(validate(h)) }
+	admins := func(h http.HandlerFunc) http.Handler { return requireAdmins(validate(h)) }
+
+	// Auth routes
+	r.Handle("/api/auth/login", public(h.LoginHandler)).Methods("POST", "OPTIONS")
+	r.Handle("/api/auth/refresh", public(h.RefreshTokenHandler)).Methods("POST", "OPTIONS")
+
+	// User routes
+	r.Handle("/api/users/new", admins(h.CreateUserHandler)).Methods("POST", "OPTIONS")
+	r.Handle("/api/users", admins(h.GetUsersHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/users/{id}", admins(h.DeleteUserHandler)).Methods("DELETE", "OPTIONS")
+
+	// Project routes
+	r.Handle("/api/projects/new", editors(h.CreateProjectHandler)).Methods("POST", "OPTIONS")
+	r.Handle("/api/projects", public(h.GetProjectsHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/projects/{id}", public(h.GetProjectByIDHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/projects/{id}", editors(h.UpdateProjectHandler)).Methods("PUT", "OPTIONS")
+	r.Handle("/api/projects/{id}", editors(h.PatchProjectHandler)).Methods("PATCH", "OPTIONS")
+	r.Handle("/api/projects/{id}", admins(h.DeleteProjectHandler)).Methods("DELETE", "OPTIONS")
+	r.Handle("/api/projects/{id}/revisions", public(h.GetProjectRevisionsHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/projects/{id}/revisions/diff", public(h.DiffProjectRevisionsHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/projects/{id}/revisions/{rev:[0-9]+}", public(h.GetProjectRevisionHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/projects/{id}/revisions/{rev:[0-9]+}/restore", editors(h.RestoreProjectRevisionHandler)).Methods("POST", "OPTIONS")
+
+	// Package routes
+	r.Handle("/api/packages/new", editors(h.CreatePackageHandler)).Methods("POST", "OPTIONS")
+	r.Handle("/api/packages", public(h.GetPackagesHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/packages/{id}", public(h.GetPackageByIDHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/packages/{id}/projects", public(h.GetPackageProjectsHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/packages/{id}", editors(h.UpdatePackageHandler)).Methods("PUT", "OPTIONS")
+	r.Handle("/api/packages/{id}", editors(h.PatchPackageHandler)).Methods("PATCH", "OPTIONS")
+	r.Handle("/api/packages/{id}", admins(h.DeletePackageHandler)).Methods("DELETE", "OPTIONS")
+
+	// Client routes
+	r.Handle("/api/clients/new", editors(h.CreateClientHandler)).Methods("POST", "OPTIONS")
+	r.Handle("/api/clients", public(h.GetClientsHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/clients/{id}", public(h.GetClientByIDHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/clients/{id}/projects", public(h.GetClientProjectsHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/clients/{id}", editors(h.UpdateClientHandler)).Methods("PUT", "OPTIONS")
+	r.Handle("/api/clients/{id}", editors(h.PatchClientHandler)).Methods("PATCH", "OPTIONS")
+	r.Handle("/api/clients/{id}", admins(h.DeleteClientHandler)).Methods("DELETE", "OPTIONS")
+
+	// Stack routes
+	r.Handle("/api/stacks/new", editors(h.CreateStackHandler)).Methods("POST", "OPTIONS")
+	r.Handle("/api/stacks", public(h.GetStacksHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/stacks/{slug}", public(h.GetStackBySlugHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/stacks/{slug}/projects", public(h.GetStackProjectsHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/stacks/{slug}", editors(h.UpdateStackHandler)).Methods("PUT", "OPTIONS")
+	r.Handle("/api/stacks/{slug}", editors(h.PatchStackHandler)).Methods("PATCH", "OPTIONS")
+	r.Handle("/api/stacks/{slug}", admins(h.DeleteStackHandler)).Methods("DELETE", "OPTIONS")
+
+	// Trash routes
+	r.Handle("/api/trash", admins(h.GetTrashHandler)).Methods("GET", "OPTIONS")
+	r.Handle("/api/projects/{id}/restore", admins(h.RestoreProjectHandler)).Methods("POST", "OPTIONS")
+	r.Handle("/api/packages/{id}/restore", admins(h.RestorePackageHandler)).Methods("POST", "OPTIONS")
+	r.Handle("/api/clients/{id}/restore", admins(h.RestoreClientHandler)).Methods("POST", "OPTIONS")
+
+	// Audit route
+	r.Handle("/api/audit", admins(h.GetAuditHandler)).Methods("GET", "OPTIONS")
+
+	// Search route
+	r.Handle("/api/search", public(h.SearchHandler)).Methods("GET", "OPTIONS")
+
+	// API documentation: the OpenAPI document and Swagger UI reading it
+	if features.Swagger {
+		r.Handle("/openapi.json", openapi.Handler()).Methods("GET")
+		r.PathPrefix("/swagger/").Handler(httpSwagger.Handler(httpSwagger.URL("/openapi.json")))
+	}
+
+	// Answer unknown routes and methods with problem+json like the handlers do
+	r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		problem.NotFound(w, r, "Route not found")
+	})
+	r.MethodNotAllowedHandler = http.HandlerFunc(problem.MethodNotAllowed)
+
+	return r
+}
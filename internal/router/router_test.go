@@ -0,0 +1,301 @@
+package router_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project-manager/internal/models"
+	"project-manager/internal/service"
+
+	"github.com/gorilla/mux"
+)
+
+// routeCase is one request against a freshly seeded server
+type routeCase struct {
+	name   string
+	method string
+	path   string
+	body   string
+	role   string // "admin", "editor" or "viewer"; empty sends no token
+	header http.Header
+	setup  func(t *testing.T, s *server)
+
+	status int
+	golden string // Name of the expected body under testdata, if any
+}
+
+func ifMatch(tag string) http.Header {
+	return http.Header{"If-Match": {tag}}
+}
+
+func mergePatch(tag string) http.Header {
+	return http.Header{"If-Match": {tag}, "Content-Type": {"application/merge-patch+json"}}
+}
+
+// Bodies reused across cases
+const (
+	projectBody = `{"name":"Dashboard","imageUrl":"https://acme.example.com/dash.png","link":"https://dash.acme.example.com","description":"Metrics dashboard","stacks":["Go","Vue"],"clientId":1,"packageIds":[1]}`
+	packageBody = `{"name":"ent","link":"https://entgo.io","description":"Entity framework","stacks":["Go"]}`
+	clientBody  = `{"name":"Globex","link":"https://globex.example.com","imageUrl":"https://globex.example.com/logo.png"}`
+	stackBody   = `{"name":"PostgreSQL","category":"database","iconUrl":"https://postgresql.org/icon.png"}`
+)
+
+// deleteSeeded moves the seeded project, package and client to the trash
+func deleteSeeded(t *testing.T, s *server) {
+	t.Helper()
+	ctx := context.Background()
+	if err := s.services.Projects.Delete(ctx, 1, service.AnyVersion); err != nil {
+		t.Fatalf("delete project: %v", err)
+	}
+	if err := s.services.Packages.Delete(ctx, 1, service.AnyVersion); err != nil {
+		t.Fatalf("delete package: %v", err)
+	}
+	if err := s.services.Clients.Delete(ctx, 1, service.AnyVersion); err != nil {
+		t.Fatalf("delete client: %v", err)
+	}
+}
+
+// reviseProject saves version 2 of the seeded project with a new name and no client
+func reviseProject(t *testing.T, s *server) {
+	t.Helper()
+	_, err := s.services.Projects.Replace(context.Background(), 1, 1, models.ProjectData{
+		Name:        "Portal v2",
+		ImageUrl:    "https://acme.example.com/portal.png",
+		Link:        "https://portal.acme.example.com",
+		Description: "Customer portal for Acme",
+		Stacks:      []string{"Go"},
+		PackageIDs:  []int{1},
+	})
+	if err != nil {
+		t.Fatalf("revise project: %v", err)
+	}
+}
+
+// htmlPackage creates a package whose name is markup, to check it is escaped where HTML is returned
+// reviseAndTrashClient revises the seeded project, then moves the client its
+// first revision refers to into the trash
+func reviseAndTrashClient(t *testing.T, s *server) {
+	t.Helper()
+	reviseProject(t, s)
+	if err := s.services.Clients.Delete(context.Background(), 1, service.AnyVersion); err != nil {
+		t.Fatalf("delete client: %v", err)
+	}
+}
+
+func htmlPackage(t *testing.T, s *server) {
+	t.Helper()
+	_, err := s.services.Packages.Create(context.Background(), models.PackageData{Name: "<img src=x onerror=alert(1)> widget"})
+	if err != nil {
+		t.Fatalf("create package: %v", err)
+	}
+}
+
+var routeCases = []routeCase{
+	// Probes and tooling
+	{name: "healthz", method: "GET", path: "/healthz", status: 200, golden: "healthz"},
+	{name: "readyz", method: "GET", path: "/readyz", status: 200, golden: "readyz"},
+	{name: "status", method: "GET", path: "/api/status", status: 200},
+	{name: "metrics", method: "GET", path: "/metrics", status: 200},
+	{name: "openapi", method: "GET", path: "/openapi.json", status: 200},
+	{name: "swagger ui", method: "GET", path: "/swagger/index.html", status: 200},
+	{name: "unknown route", method: "GET", path: "/api/nope", status: 404, golden: "route_not_found"},
+	{name: "method not allowed", method: "POST", path: "/api/projects", status: 405, golden: "method_not_allowed"},
+
+	// Auth
+	{name: "login", method: "POST", path: "/api/auth/login", body: `{"email":"admin@example.com","password":"` + adminPassword + `"}`, status: 200, golden: "login"},
+	{name: "login wrong password", method: "POST", path: "/api/auth/login", body: `{"email":"admin@example.com","password":"wrong"}`, status: 401, golden: "login_unauthorized"},
+	{name: "login unknown email", method: "POST", path: "/api/auth/login", body: `{"email":"nobody@example.com","password":"wrong"}`, status: 401},
+	{name: "login missing fields", method: "POST", path: "/api/auth/login", body: `{}`, status: 422, golden: "login_invalid"},
+	{name: "login malformed JSON", method: "POST", path: "/api/auth/login", body: `{"email":`, status: 400},
+	{name: "refresh", method: "POST", path: "/api/auth/refresh", body: `{"refreshToken":"$REFRESH_TOKEN"}`, status: 200},
+	{name: "refresh invalid token", method: "POST", path: "/api/auth/refresh", body: `{"refreshToken":"garbage"}`, status: 401},
+
+	// Users
+	{name: "create user", method: "POST", path: "/api/users/new", role: "admin", body: `{"email":"new@example.com","name":"New","password":"long-enough","role":"editor"}`, status: 201, golden: "user_created"},
+	{name: "create user duplicate email", method: "POST", path: "/api/users/new", role: "admin", body: `{"email":"editor@example.com","password":"long-enough"}`, status: 409},
+	{name: "create user invalid", method: "POST", path: "/api/users/new", role: "admin", body: `{"email":"not-an-email","password":"short","role":"owner"}`, status: 422, golden: "user_invalid"},
+	{name: "create user as editor", method: "POST", path: "/api/users/new", role: "editor", body: `{"email":"new@example.com","password":"long-enough"}`, status: 403, golden: "forbidden"},
+	{name: "create user without token", method: "POST", path: "/api/users/new", body: `{"email":"new@example.com","password":"long-enough"}`, status: 401, golden: "unauthorized"},
+	{name: "list users", method: "GET", path: "/api/users", role: "admin", status: 200, golden: "users"},
+	{name: "delete user", method: "DELETE", path: "/api/users/3", role: "admin", status: 200},
+	{name: "delete user not found", method: "DELETE", path: "/api/users/99", role: "admin", status: 404},
+	{name: "delete user malformed ID", method: "DELETE", path: "/api/users/abc", role: "admin", status: 400},
+
+	// Projects
+	{name: "create project", method: "POST", path: "/api/projects/new", role: "editor", body: projectBody, status: 201, golden: "project_created"},
+	{name: "create project invalid", method: "POST", path: "/api/projects/new", role: "editor", body: `{"name":" ","imageUrl":"nope","stacks":["Go","Go"]}`, status: 422, golden: "project_invalid"},
+	{name: "create project unknown client", method: "POST", path: "/api/projects/new", role: "editor", body: strings.Replace(projectBody, `"clientId":1`, `"clientId":99`, 1), status: 422},
+	{name: "create project malformed JSON", method: "POST", path: "/api/projects/new", role: "editor", body: `{"name":`, status: 400, golden: "malformed_json"},
+	{name: "create project wrong types", method: "POST", path: "/api/projects/new", role: "editor", body: `{"name":"Dashboard","imageUrl":"https://acme.example.com/dash.png","link":"https://dash.acme.example.com","description":"Metrics dashboard","stacks":"Go","clientId":"1","packageIds":[1.5]}`, status: 422, golden: "project_wrong_types"},
+	{name: "create project unsupported media type", method: "POST", path: "/api/projects/new", role: "editor", header: http.Header{"Content-Type": {"text/plain"}}, body: projectBody, status: 415},
+	{name: "create project wrong types anonymously", method: "POST", path: "/api/projects/new", body: `{"name":5}`, status: 401},
+	{name: "create project wrong types as viewer", method: "POST", path: "/api/projects/new", role: "viewer", body: `{"name":5}`, status: 403},
+	{name: "create project body too large", method: "POST", path: "/api/projects/new", role: "editor", body: `{"description":"` + strings.Repeat("x", 32<<10) + `"}`, status: 413},
+	{name: "create project as viewer", method: "POST", path: "/api/projects/new", role: "viewer", body: projectBody, status: 403},
+	{name: "list projects", method: "GET", path: "/api/projects", status: 200, golden: "projects"},
+	{name: "list projects filtered", method: "GET", path: "/api/projects?stack=react&client=1&sort=-name", status: 200},
+	{name: "list projects bad filter", method: "GET", path: "/api/projects?client=abc", status: 400},
+	{name: "list projects bad paging", method: "GET", path: "/api/projects?limit=0&updated_since=yesterday", status: 400, golden: "invalid_parameters"},
+	{name: "list projects bad sort", method: "GET", path: "/api/projects?sort=colour", status: 400},
+	{name: "get project", method: "GET", path: "/api/projects/1", status: 200, golden: "project"},
+	{name: "get project not modified", method: "GET", path: "/api/projects/1", header: http.Header{"If-None-Match": {"*"}}, status: 304},
+	{name: "get project not found", method: "GET", path: "/api/projects/99", status: 404, golden: "project_not_found"},
+	{name: "get project malformed ID", method: "GET", path: "/api/projects/abc", status: 400, golden: "project_malformed_id"},
+	{name: "replace project", method: "PUT", path: "/api/projects/1", role: "editor", header: ifMatch(`"1"`), body: projectBody, status: 200, golden: "project_replaced"},
+	{name: "replace project without If-Match", method: "PUT", path: "/api/projects/1", role: "editor", body: projectBody, status: 428},
+	{name: "replace project stale", method: "PUT", path: "/api/projects/1", role: "editor", header: ifMatch(`"7"`), body: projectBody, status: 412, golden: "project_stale"},
+	{name: "replace project invalid", method: "PUT", path: "/api/projects/1", role: "editor", header: ifMatch(`"1"`), body: `{"name":"Portal","link":"ftp://portal"}`, status: 422},
+	{name: "replace project not found", method: "PUT", path: "/api/projects/99", role: "editor", header: ifMatch("*"), body: projectBody, status: 404},
+	{name: "replace project malformed ID", method: "PUT", path: "/api/projects/abc", role: "editor", header: ifMatch("*"), body: projectBody, status: 400},
+	{name: "patch project", method: "PATCH", path: "/api/projects/1", role: "editor", header: mergePatch(`"1"`), body: `{"description":"Patched","clientId":null}`, status: 200, golden: "project_patched"},
+	{name: "patch project clearing optional fields", method: "PATCH", path: "/api/projects/1", role: "editor", header: mergePatch(`"1"`), body: `{"imageUrl":null,"link":null,"description":null}`, status: 200, golden: "project_cleared"},
+	{name: "patch project unsupported media type", method: "PATCH", path: "/api/projects/1", role: "editor", header: http.Header{"If-Match": {`"1"`}, "Content-Type": {"text/plain"}}, body: `x`, status: 415},
+	{name: "patch project not found", method: "PATCH", path: "/api/projects/99", role: "editor", header: mergePatch("*"), body: `{}`, status: 404},
+	{name: "delete project", method: "DELETE", path: "/api/projects/1", role: "admin", header: ifMatch(`"1"`), status: 200},
+	{name: "delete project as editor", method: "DELETE", path: "/api/projects/1", role: "editor", header: ifMatch(`"1"`), status: 403},
+	{name: "delete project not found", method: "DELETE", path: "/api/projects/99", role: "admin", header: ifMatch("*"), status: 404},
+	{name: "delete project malformed ID", method: "DELETE", path: "/api/projects/abc", role: "admin", header: ifMatch("*"), status: 400},
+
+	// Project revisions
+	{name: "list revisions", method: "GET", path: "/api/projects/1/revisions", setup: reviseProject, status: 200, golden: "revisions"},
+	{name: "list revisions not found", method: "GET", path: "/api/projects/99/revisions", status: 404},
+	{name: "get revision", method: "GET", path: "/api/projects/1/revisions/1", status: 200, golden: "revision"},
+	{name: "get revision not found", method: "GET", path: "/api/projects/1/revisions/9", status: 404},
+	{name: "diff revisions", method: "GET", path: "/api/projects/1/revisions/diff?from=1&to=2", setup: reviseProject, status: 200, golden: "revision_diff"},
+	{name: "diff revisions missing range", method: "GET", path: "/api/projects/1/revisions/diff", status: 400},
+	{name: "restore revision", method: "POST", path: "/api/projects/1/revisions/1/restore", role: "editor", header: ifMatch(`"2"`), setup: reviseProject, status: 200, golden: "revision_restored"},
+	{name: "restore revision stale", method: "POST", path: "/api/projects/1/revisions/1/restore", role: "editor", header: ifMatch(`"1"`), setup: reviseProject, status: 412},
+	{name: "restore revision with trashed client", method: "POST", path: "/api/projects/1/revisions/1/restore", role: "editor", header: ifMatch(`"2"`), setup: reviseAndTrashClient, status: 409, golden: "revision_trashed_client"},
+
+	// Packages
+	{name: "create package", method: "POST", path: "/api/packages/new", role: "editor", body: packageBody, status: 201, golden: "package_created"},
+	{name: "create package duplicate name", method: "POST", path: "/api/packages/new", role: "editor", body: `{"name":"gorilla/mux","stacks":[]}`, status: 409, golden: "package_conflict"},
+	{name: "create package invalid", method: "POST", path: "/api/packages/new", role: "editor", body: `{"name":"","link":"ftp:/x"}`, status: 422},
+	{name: "list packages", method: "GET", path: "/api/packages", status: 200, golden: "packages"},
+	{name: "list packages by stack", method: "GET", path: "/api/packages?stack=rust", status: 200, golden: "empty_list"},
+	{name: "get package", method: "GET", path: "/api/packages/1", status: 200, golden: "package"},
+	{name: "get package not found", method: "GET", path: "/api/packages/99", status: 404},
+	{name: "get package malformed ID", method: "GET", path: "/api/packages/abc", status: 400},
+	{name: "package projects", method: "GET", path: "/api/packages/1/projects", status: 200},
+	{name: "package projects not found", method: "GET", path: "/api/packages/99/projects", status: 404},
+	{name: "replace package", method: "PUT", path: "/api/packages/1", role: "editor", header: ifMatch(`"1"`), body: packageBody, status: 200, golden: "package_replaced"},
+	{name: "replace package stale", method: "PUT", path: "/api/packages/1", role: "editor", header: ifMatch(`"3"`), body: packageBody, status: 412},
+	{name: "patch package", method: "PATCH", path: "/api/packages/1", role: "editor", header: mergePatch(`"1"`), body: `{"link":null}`, status: 200},
+	{name: "delete package", method: "DELETE", path: "/api/packages/1", role: "admin", header: ifMatch(`"1"`), status: 200},
+	{name: "delete package not found", method: "DELETE", path: "/api/packages/99", role: "admin", header: ifMatch("*"), status: 404},
+
+	// Clients
+	{name: "create client", method: "POST", path: "/api/clients/new", role: "editor", body: clientBody, status: 201, golden: "client_created"},
+	{name: "create client duplicate name", method: "POST", path: "/api/clients/new", role: "editor", body: strings.Replace(clientBody, "Globex", "Acme", 1), status: 409},
+	{name: "create client invalid", method: "POST", path: "/api/clients/new", role: "editor", body: `{"name":"Initech"}`, status: 422, golden: "client_invalid"},
+	{name: "list clients", method: "GET", path: "/api/clients", status: 200, golden: "clients"},
+	{name: "get client", method: "GET", path: "/api/clients/1", status: 200},
+	{name: "get client not found", method: "GET", path: "/api/clients/99", status: 404},
+	{name: "get client malformed ID", method: "GET", path: "/api/clients/abc", status: 400},
+	{name: "client projects", method: "GET", path: "/api/clients/1/projects", status: 200, golden: "client_projects"},
+	{name: "client projects not found", method: "GET", path: "/api/clients/99/projects", status: 404},
+	{name: "replace client", method: "PUT", path: "/api/clients/1", role: "editor", header: ifMatch(`"1"`), body: clientBody, status: 200},
+	{name: "replace client duplicate name", method: "PUT", path: "/api/clients/1", role: "editor", header: ifMatch(`"1"`), setup: func(t *testing.T, s *server) {
+		if _, err := s.services.Clients.Create(context.Background(), models.ClientData{Name: "Globex", Link: "https://globex.example.com", ImageUrl: "https://globex.example.com/logo.png"}); err != nil {
+			t.Fatalf("create client: %v", err)
+		}
+	}, body: clientBody, status: 409},
+	{name: "patch client", method: "PATCH", path: "/api/clients/1", role: "editor", header: mergePatch(`"1"`), body: `{"name":"Acme Corp"}`, status: 200},
+	{name: "patch client clearing link", method: "PATCH", path: "/api/clients/1", role: "editor", header: mergePatch(`"1"`), body: `{"link":null}`, status: 200, golden: "client_cleared"},
+	{name: "delete client", method: "DELETE", path: "/api/clients/1", role: "admin", header: ifMatch(`"1"`), status: 200},
+	{name: "delete client stale", method: "DELETE", path: "/api/clients/1", role: "admin", header: ifMatch(`"2"`), status: 412},
+
+	// Stacks
+	{name: "create stack", method: "POST", path: "/api/stacks/new", role: "editor", body: stackBody, status: 201, golden: "stack_created"},
+	{name: "create stack duplicate slug", method: "POST", path: "/api/stacks/new", role: "editor", body: `{"name":"GO"}`, status: 409},
+	{name: "create stack invalid", method: "POST", path: "/api/stacks/new", role: "editor", body: `{"iconUrl":"nope"}`, status: 422},
+	{name: "list stacks", method: "GET", path: "/api/stacks", status: 200, golden: "stacks"},
+	{name: "get stack", method: "GET", path: "/api/stacks/go", status: 200},
+	{name: "get stack not found", method: "GET", path: "/api/stacks/cobol", status: 404},
+	{name: "stack projects", method: "GET", path: "/api/stacks/react/projects", status: 200},
+	{name: "replace stack", method: "PUT", path: "/api/stacks/go", role: "editor", body: `{"name":"Golang","slug":"go","category":"language"}`, status: 200, golden: "stack_replaced"},
+	{name: "patch stack", method: "PATCH", path: "/api/stacks/react", role: "editor", header: http.Header{"Content-Type": {"application/merge-patch+json"}}, body: `{"category":"frontend"}`, status: 200},
+	{name: "delete stack", method: "DELETE", path: "/api/stacks/react", role: "admin", status: 200},
+	{name: "delete stack not found", method: "DELETE", path: "/api/stacks/cobol", role: "admin", status: 404},
+
+	// Trash
+	{name: "list trash", method: "GET", path: "/api/trash", role: "admin", setup: deleteSeeded, status: 200, golden: "trash"},
+	{name: "list trash bad kind", method: "GET", path: "/api/trash?kind=stack", role: "admin", status: 400},
+	{name: "restore project", method: "POST", path: "/api/projects/1/restore", role: "admin", setup: deleteSeeded, status: 200},
+	{name: "restore project not in trash", method: "POST", path: "/api/projects/1/restore", role: "admin", status: 404, golden: "not_in_trash"},
+	{name: "restore package", method: "POST", path: "/api/packages/1/restore", role: "admin", setup: deleteSeeded, status: 200},
+	{name: "restore package malformed ID", method: "POST", path: "/api/packages/abc/restore", role: "admin", status: 400},
+	{name: "restore client", method: "POST", path: "/api/clients/1/restore", role: "admin", setup: deleteSeeded, status: 200},
+	{name: "restore client as editor", method: "POST", path: "/api/clients/1/restore", role: "editor", setup: deleteSeeded, status: 403},
+
+	// Audit
+	{name: "list audit", method: "GET", path: "/api/audit?entity=project", role: "admin", status: 200},
+	{name: "list audit bad filter", method: "GET", path: "/api/audit?since=yesterday", role: "admin", status: 400},
+
+	// Search
+	{name: "search", method: "GET", path: "/api/search?q=portal", status: 200, golden: "search"},
+	{name: "search escapes stored HTML", method: "GET", path: "/api/search?q=widget", setup: htmlPackage, status: 200, golden: "search_escaped"},
+	{name: "search without query", method: "GET", path: "/api/search", status: 400},
+	{name: "search bad kind", method: "GET", path: "/api/search?q=go&kind=user", status: 400, golden: "search_bad_kind"},
+}
+
+func TestRoutes(t *testing.T) {
+	for _, tc := range routeCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newServer(t)
+			if tc.setup != nil {
+				tc.setup(t, s)
+			}
+
+			rec := s.do(tc.method, tc.path, tc.body, tc.role, tc.header)
+			if rec.Code != tc.status {
+				t.Fatalf("%s %s = %d, want %d\n%s", tc.method, tc.path, rec.Code, tc.status, rec.Body)
+			}
+			s.assertDocumented(t, tc.method, tc.path, rec)
+			if tc.golden != "" {
+				assertGolden(t, tc.golden, rec.Body.Bytes())
+			}
+		})
+	}
+}
+
+// TestEveryRouteIsCovered fails when a route is mounted without any case in routeCases
+func TestEveryRouteIsCovered(t *testing.T) {
+	s := newServer(t)
+
+	covered := map[string]bool{}
+	for _, tc := range routeCases {
+		var match mux.RouteMatch
+		if s.router.Match(httptest.NewRequest(tc.method, tc.path, nil), &match) && match.Route != nil {
+			template, _ := match.Route.GetPathTemplate()
+			covered[tc.method+" "+template] = true
+		}
+	}
+
+	err := s.router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			// Prefix routes such as /swagger/ accept every method
+			methods = []string{"GET"}
+		}
+		for _, method := range methods {
+			if method == http.MethodOptions {
+				continue
+			}
+			if !covered[method+" "+template] {
+				t.Errorf("no test case for %s %s", method, template)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,247 @@
+package search
+
+import (
+	"context"
+	"html"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"project-manager/ent"
+	"project-manager/internal/models"
+)
+
+// Field weights mirror the A/B/C weights of the PostgreSQL searcher
+const (
+	weightTitle = 1.0
+	weightTags  = 0.4
+	weightBody  = 0.2
+
+	// prefixPenalty scales matches where the query term is only a prefix of the word
+	prefixPenalty = 0.5
+
+	snippetWords = 30
+)
+
+// Memory is an in-process inverted index over projects, packages and clients.
+// It is used where PostgreSQL text search is unavailable, such as the SQLite
+// client from ent/enttest. The index is rebuilt lazily after any mutation.
+type Memory struct {
+	client *ent.Client
+
+	mu    sync.Mutex
+	dirty bool
+	docs  []document
+	index map[string][]posting
+}
+
+type document struct {
+	kind  string
+	id    int
+	title string
+	body  string
+}
+
+type posting struct {
+	doc    int
+	weight float64
+}
+
+// NewMemory returns an index over client and registers a hook on it so that
+// writes invalidate the index
+func NewMemory(client *ent.Client) *Memory {
+	m := &Memory{client: client, dirty: true}
+	client.Use(m.invalidate)
+	return m
+}
+
+func (m *Memory) invalidate(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, mutation ent.Mutation) (ent.Value, error) {
+		v, err := next.Mutate(ctx, mutation)
+		if err == nil {
+			m.mu.Lock()
+			m.dirty = true
+			m.mu.Unlock()
+		}
+		return v, err
+	})
+}
+
+// Search implements Searcher. Every query term must match a word, either
+// exactly or as a prefix.
+func (m *Memory) Search(ctx context.Context, query string, kinds []string, limit int) ([]models.SearchHit, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.dirty {
+		if err := m.rebuild(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return []models.SearchHit{}, nil
+	}
+
+	scores := map[int]float64{}
+	matched := map[int]int{}
+	for _, term := range terms {
+		best := map[int]float64{}
+		for token, postings := range m.index {
+			factor := 0.0
+			switch {
+			case token == term:
+				factor = 1
+			case strings.HasPrefix(token, term):
+				factor = prefixPenalty
+			default:
+				continue
+			}
+			for _, p := range postings {
+				best[p.doc] += p.weight * factor
+			}
+		}
+		for doc, score := range best {
+			scores[doc] += score
+			matched[doc]++
+		}
+	}
+
+	hits := []models.SearchHit{}
+	for doc, score := range scores {
+		d := m.docs[doc]
+		if matched[doc] < len(terms) || !wantKind(kinds, d.kind) {
+			continue
+		}
+		hits = append(hits, models.SearchHit{
+			Kind:    d.kind,
+			ID:      d.id,
+			Title:   d.title,
+			Snippet: snippet(d, terms),
+			Score:   score,
+		})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if hits[i].Kind != hits[j].Kind {
+			return hits[i].Kind < hits[j].Kind
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+func (m *Memory) rebuild(ctx context.Context) error {
+	m.docs = nil
+	m.index = map[string][]posting{}
+
+	projects, err := m.client.Projects.Query().WithStacks().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range projects {
+		m.add(document{kind: KindProject, id: p.ID, title: p.Name, body: p.Description}, stackText(p.Edges.Stacks))
+	}
+
+	packages, err := m.client.Packages.Query().WithStacks().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range packages {
+		m.add(document{kind: KindPackage, id: p.ID, title: p.Name, body: p.Description}, stackText(p.Edges.Stacks))
+	}
+
+	clients, err := m.client.Clients.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, c := range clients {
+		m.add(document{kind: KindClient, id: c.ID, title: c.Name}, "")
+	}
+
+	m.dirty = false
+	return nil
+}
+
+func (m *Memory) add(d document, tags string) {
+	doc := len(m.docs)
+	m.docs = append(m.docs, d)
+
+	weights := map[string]float64{}
+	for _, token := range tokenize(d.title) {
+		weights[token] += weightTitle
+	}
+	for _, token := range tokenize(tags) {
+		weights[token] += weightTags
+	}
+	for _, token := range tokenize(d.body) {
+		weights[token] += weightBody
+	}
+	for token, weight := range weights {
+		m.index[token] = append(m.index[token], posting{doc: doc, weight: weight})
+	}
+}
+
+func stackText(list []*ent.Stacks) string {
+	names := make([]string, 0, len(list))
+	for _, s := range list {
+		names = append(names, s.Name)
+	}
+	return strings.Join(names, " ")
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// snippet returns a window of words around the first match, with matching
+// words wrapped in <mark> tags like ts_headline does. The words are
+// HTML-escaped, so the marks are the only markup.
+func snippet(d document, terms []string) string {
+	text := d.title
+	if d.body != "" {
+		text += ": " + d.body
+	}
+	words := strings.Fields(text)
+
+	first := -1
+	marked := make([]string, len(words))
+	for i, word := range words {
+		marked[i] = html.EscapeString(word)
+		for _, token := range tokenize(word) {
+			if hasTermPrefix(token, terms) {
+				marked[i] = "<mark>" + marked[i] + "</mark>"
+				if first < 0 {
+					first = i
+				}
+				break
+			}
+		}
+	}
+
+	start := 0
+	if first > snippetWords/2 {
+		start = first - snippetWords/2
+	}
+	end := min(start+snippetWords, len(marked))
+	return strings.Join(marked[start:end], " ")
+}
+
+func hasTermPrefix(token string, terms []string) bool {
+	for _, term := range terms {
+		if strings.HasPrefix(token, term) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
+	"project-manager/ent/projects"
+	"project-manager/ent/stacks"
+	"project-manager/internal/models"
+
+	"github.com/lib/pq"
+)
+
+// Postgres searches with tsvector documents weighted by field:
+// names rank above stacks, which rank above descriptions. The query is raw
+// SQL, so it filters out soft-deleted rows itself.
+type Postgres struct {
+	db *sql.DB
+}
+
+// NewPostgres returns a searcher that runs queries on db
+func NewPostgres(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+var postgresQuery = fmt.Sprintf(`
+WITH docs AS (
+	SELECT '%[1]s' AS kind, p.id, p.name AS title, coalesce(p.description, '') AS body,
+		coalesce((SELECT string_agg(s.name, ' ') FROM %[4]s s JOIN %[5]s j ON j.%[6]s = s.id WHERE j.%[7]s = p.id), '') AS tags
+	FROM %[8]s p
+	WHERE p.deleted_at IS NULL
+	UNION ALL
+	SELECT '%[2]s', p.id, p.name, coalesce(p.description, ''),
+		coalesce((SELECT string_agg(s.name, ' ') FROM %[4]s s JOIN %[9]s j ON j.%[10]s = s.id WHERE j.%[11]s = p.id), '')
+	FROM %[12]s p
+	WHERE p.deleted_at IS NULL
+	UNION ALL
+	SELECT '%[3]s', c.id, c.name, '', ''
+	FROM %[13]s c
+	WHERE c.deleted_at IS NULL
+), ranked AS (
+	SELECT kind, id, title, body,
+		setweight(to_tsvector('english', title), 'A') ||
+		setweight(to_tsvector('english', tags), 'B') ||
+		setweight(to_tsvector('english', body), 'C') AS doc
+	FROM docs
+	WHERE cardinality($3::text[]) = 0 OR kind = ANY($3)
+)
+SELECT kind, id, title,
+	-- The text is HTML-escaped first, so only the <mark> tags are markup
+	ts_headline('english', replace(replace(replace(replace(replace(
+		CASE WHEN body = '' THEN title ELSE title || ': ' || body END,
+		'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&#34;'), '''', '&#39;'), q,
+		'StartSel=<mark>, StopSel=</mark>, MaxFragments=1, MaxWords=30, MinWords=10'),
+	ts_rank(doc, q)
+FROM ranked, websearch_to_tsquery('english', $1) q
+WHERE doc @@ q
+ORDER BY 5 DESC, kind, id
+LIMIT $2`,
+	KindProject, KindPackage, KindClient,
+	stacks.Table,
+	projects.StacksTable, projects.StacksPrimaryKey[0], projects.StacksPrimaryKey[1], projects.Table,
+	packages.StacksTable, packages.StacksPrimaryKey[0], packages.StacksPrimaryKey[1], packages.Table,
+	clients.Table,
+)
+
+// Search implements Searcher
+func (s *Postgres) Search(ctx context.Context, query string, kinds []string, limit int) ([]models.SearchHit, error) {
+	if kinds == nil {
+		kinds = []string{} // A nil slice would be sent as NULL and match nothing
+	}
+	rows, err := s.db.QueryContext(ctx, postgresQuery, query, limit, pq.Array(kinds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := []models.SearchHit{}
+	for rows.Next() {
+		var hit models.SearchHit
+		if err := rows.Scan(&hit.Kind, &hit.ID, &hit.Title, &hit.Snippet, &hit.Score); err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+	return hits, rows.Err()
+}
@@ -0,0 +1,48 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+
+	"project-manager/ent"
+	"project-manager/internal/models"
+)
+
+const (
+	KindProject = "project"
+	KindPackage = "package"
+	KindClient  = "client"
+)
+
+// Searcher finds projects, packages and clients matching a free-text query
+type Searcher interface {
+	// Search returns at most limit hits, best first. An empty kinds list searches every kind.
+	Search(ctx context.Context, query string, kinds []string, limit int) ([]models.SearchHit, error)
+}
+
+// New picks the PostgreSQL full-text searcher when db is a PostgreSQL pool
+// with text search support, and the in-process index over client otherwise
+func New(client *ent.Client, db *sql.DB) Searcher {
+	if db != nil {
+		var ok bool
+		err := db.QueryRowContext(context.Background(),
+			`SELECT to_tsvector('english', 'probe') @@ to_tsquery('english', 'probe')`,
+		).Scan(&ok)
+		if err == nil && ok {
+			return NewPostgres(db)
+		}
+	}
+	return NewMemory(client)
+}
+
+func wantKind(kinds []string, kind string) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"project-manager/internal/config"
+)
+
+// ShutdownFunc releases a resource once the server has stopped taking
+// requests. ctx expires when the shutdown deadline passes.
+type ShutdownFunc func(ctx context.Context) error
+
+// Server is an HTTP server with configured timeouts that drains connections
+// and runs registered shutdown callbacks when it receives SIGINT or SIGTERM
+type Server struct {
+	cfg  config.Server
+	http *http.Server
+
+	mu     sync.Mutex
+	hooks  []shutdownHook
+	drains []func()
+}
+
+type shutdownHook struct {
+	name string
+	fn   ShutdownFunc
+}
+
+// New creates a server for handler from the server settings
+func New(cfg config.Server, handler http.Handler) *Server {
+	return &Server{
+		cfg: cfg,
+		http: &http.Server{
+			Addr:              cfg.Addr,
+			Handler:           handler,
+			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+			ReadTimeout:       cfg.ReadTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			IdleTimeout:       cfg.IdleTimeout,
+		},
+	}
+}
+
+// OnShutdown registers fn to run after in-flight requests have drained.
+// Callbacks run in reverse order of registration, like deferred calls, so a
+// subsystem registered after the database is stopped before it.
+func (s *Server) OnShutdown(name string, fn ShutdownFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hooks = append(s.hooks, shutdownHook{name, fn})
+}
+
+// OnDrain registers fn to run as soon as shutdown starts, before connections
+// are drained, e.g. to fail readiness probes
+func (s *Server) OnDrain(fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.drains = append(s.drains, fn)
+}
+
+// Run serves until ctx is done or the process receives SIGINT or SIGTERM.
+// It then runs the drain callbacks, keeps serving for cfg.ShutdownDelay,
+// stops accepting connections, waits for in-flight requests and runs
+// the shutdown callbacks, all within cfg.ShutdownTimeout. A second signal
+// during the shutdown kills the process.
+func (s *Server) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	listener, err := net.Listen("tcp", s.cfg.Addr)
+	if err != nil {
+		return s.shutdown(context.Background(), fmt.Errorf("failed listening on %s: %v", s.cfg.Addr, err))
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("starting server", "addr", listener.Addr().String())
+		serveErr <- s.http.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return s.shutdown(context.Background(), fmt.Errorf("server stopped: %v", err))
+	case <-ctx.Done():
+		stop()
+	}
+
+	s.mu.Lock()
+	drains := s.drains
+	s.mu.Unlock()
+	for _, fn := range drains {
+		fn()
+	}
+	if s.cfg.ShutdownDelay > 0 {
+		// Keep serving while load balancers notice the failing readiness probe
+		slog.Info("shutdown requested, failing readiness", "delay", s.cfg.ShutdownDelay.String())
+		time.Sleep(s.cfg.ShutdownDelay)
+	}
+
+	slog.Info("draining connections", "timeout", s.cfg.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	var drainErr error
+	if err := s.http.Shutdown(shutdownCtx); err != nil {
+		// Cut the connections that did not finish in time
+		s.http.Close()
+		drainErr = fmt.Errorf("failed draining connections: %v", err)
+	}
+	return s.shutdown(shutdownCtx, drainErr)
+}
+
+// shutdown runs the registered callbacks newest first and joins their errors with cause
+func (s *Server) shutdown(ctx context.Context, cause error) error {
+	s.mu.Lock()
+	hooks := s.hooks
+	s.hooks = nil
+	s.mu.Unlock()
+
+	errs := []error{cause}
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if err := hooks[i].fn(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutdown of %s failed: %v", hooks[i].name, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	slog.Info("server stopped")
+	return nil
+}
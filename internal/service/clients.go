@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"project-manager/ent"
+	"project-manager/ent/clients"
+	"project-manager/ent/schema"
+	"project-manager/internal/listing"
+	"project-manager/internal/models"
+)
+
+// ClientListSpec lists the fields clients can be sorted on
+var ClientListSpec = listing.Spec[*ent.Clients]{
+	IDName: "id",
+	Fields: map[string]listing.Field[*ent.Clients]{
+		"id":         {Column: clients.FieldID, Kind: listing.KindInt, Value: func(c *ent.Clients) any { return c.ID }},
+		"name":       {Column: clients.FieldName, Kind: listing.KindString, Value: func(c *ent.Clients) any { return c.Name }},
+		"created_at": {Column: clients.FieldCreatedAt, Kind: listing.KindTime, Value: func(c *ent.Clients) any { return c.CreatedAt }},
+		"updated_at": {Column: clients.FieldUpdatedAt, Kind: listing.KindTime, Value: func(c *ent.Clients) any { return c.UpdatedAt }},
+	},
+}
+
+// ClientFilter narrows a client listing. Zero fields do not filter.
+type ClientFilter struct {
+	NameContains string
+	UpdatedSince time.Time
+}
+
+// Clients reads and writes clients
+type Clients interface {
+	// List returns one page of the clients matching filter and the total number of matches
+	List(ctx context.Context, filter ClientFilter, page *listing.Params[*ent.Clients]) ([]*ent.Clients, int, error)
+	Get(ctx context.Context, id int) (*ent.Clients, error)
+	// Projects returns the projects built for the client
+	Projects(ctx context.Context, id int) ([]*ent.Projects, error)
+	Create(ctx context.Context, data models.ClientData) (*ent.Clients, error)
+	// Replace overwrites every field of the client, provided it is still at
+	// version. It returns ErrModified when it is not.
+	Replace(ctx context.Context, id, version int, data models.ClientData) (*ent.Clients, error)
+	// Delete moves the client to the trash, provided it is still at version
+	Delete(ctx context.Context, id, version int) error
+	// Restore moves the client out of the trash
+	Restore(ctx context.Context, id int) (*ent.Clients, error)
+}
+
+type clientService struct {
+	client *ent.Client
+}
+
+// NewClients returns the Clients service over client
+func NewClients(client *ent.Client) Clients {
+	return &clientService{client: client}
+}
+
+func (s *clientService) List(ctx context.Context, filter ClientFilter, page *listing.Params[*ent.Clients]) ([]*ent.Clients, int, error) {
+	query := s.client.Clients.Query()
+	if filter.NameContains != "" {
+		query.Where(clients.NameContainsFold(filter.NameContains))
+	}
+	if !filter.UpdatedSince.IsZero() {
+		query.Where(clients.UpdatedAtGTE(filter.UpdatedSince))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if after := page.After(); after != nil {
+		query.Where(after)
+	}
+	for _, order := range page.Order() {
+		query.Order(order)
+	}
+	limit, offset := page.Window()
+
+	list, err := query.Limit(limit).Offset(offset).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+func (s *clientService) Get(ctx context.Context, id int) (*ent.Clients, error) {
+	return s.client.Clients.Get(ctx, id)
+}
+
+func (s *clientService) Projects(ctx context.Context, id int) ([]*ent.Projects, error) {
+	client, err := s.client.Clients.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return WithProjectEdges(client.QueryProjects()).All(ctx)
+}
+
+func (s *clientService) Create(ctx context.Context, data models.ClientData) (*ent.Clients, error) {
+	var created *ent.Clients
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		var err error
+		created, err = tx.Clients.Create().
+			SetName(data.Name).
+			SetLink(data.Link).
+			SetImageUrl(data.ImageUrl).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created.Unwrap(), nil
+}
+
+func (s *clientService) Replace(ctx context.Context, id, version int, data models.ClientData) (*ent.Clients, error) {
+	var client *ent.Clients
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		update := tx.Clients.UpdateOneID(id).
+			AddVersion(1).
+			SetName(data.Name).
+			SetImageUrl(data.ImageUrl)
+		SetOrClear(data.Link, update.SetLink, update.ClearLink)
+		if version != AnyVersion {
+			update.Where(clients.Version(version))
+		}
+		var err error
+		client, err = update.Save(ctx)
+		return err
+	})
+	if ent.IsNotFound(err) {
+		return nil, s.modifiedOrMissing(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return client.Unwrap(), nil
+}
+
+func (s *clientService) Delete(ctx context.Context, id, version int) error {
+	var deleted int
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		del := tx.Clients.Delete().Where(clients.ID(id))
+		if version != AnyVersion {
+			del.Where(clients.Version(version))
+		}
+		var err error
+		deleted, err = del.Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return s.modifiedOrMissing(ctx, id)
+	}
+	return nil
+}
+
+func (s *clientService) Restore(ctx context.Context, id int) (*ent.Clients, error) {
+	var restored int
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		var err error
+		restored, err = tx.Clients.Update().
+			Where(clients.ID(id), clients.DeletedAtNotNil()).
+			ClearDeletedAt().
+			AddVersion(1).
+			Save(schema.SkipSoftDelete(ctx))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if restored == 0 {
+		return nil, ErrNotInTrash
+	}
+	return s.Get(ctx, id)
+}
+
+// modifiedOrMissing is called after a version-guarded write matched no row:
+// it returns ErrModified when the client still exists and not-found otherwise
+func (s *clientService) modifiedOrMissing(ctx context.Context, id int) error {
+	if _, err := s.client.Clients.Get(ctx, id); err != nil {
+		return err
+	}
+	return ErrModified
+}
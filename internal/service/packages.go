@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"project-manager/ent"
+	"project-manager/ent/packages"
+	"project-manager/ent/schema"
+	"project-manager/ent/stacks"
+	"project-manager/internal/database"
+	"project-manager/internal/listing"
+	"project-manager/internal/models"
+	"project-manager/internal/slug"
+)
+
+// PackageListSpec lists the fields packages can be sorted on
+var PackageListSpec = listing.Spec[*ent.Packages]{
+	IDName: "id",
+	Fields: map[string]listing.Field[*ent.Packages]{
+		"id":         {Column: packages.FieldID, Kind: listing.KindInt, Value: func(p *ent.Packages) any { return p.ID }},
+		"name":       {Column: packages.FieldName, Kind: listing.KindString, Value: func(p *ent.Packages) any { return p.Name }},
+		"created_at": {Column: packages.FieldCreatedAt, Kind: listing.KindTime, Value: func(p *ent.Packages) any { return p.CreatedAt }},
+		"updated_at": {Column: packages.FieldUpdatedAt, Kind: listing.KindTime, Value: func(p *ent.Packages) any { return p.UpdatedAt }},
+	},
+}
+
+// PackageFilter narrows a package listing. Zero fields do not filter.
+type PackageFilter struct {
+	NameContains string
+	Stack        string // Stack name or slug
+	UpdatedSince time.Time
+}
+
+// Packages reads and writes packages. Every package returned has its stacks loaded.
+type Packages interface {
+	// List returns one page of the packages matching filter and the total number of matches
+	List(ctx context.Context, filter PackageFilter, page *listing.Params[*ent.Packages]) ([]*ent.Packages, int, error)
+	Get(ctx context.Context, id int) (*ent.Packages, error)
+	// Projects returns the projects that use the package
+	Projects(ctx context.Context, id int) ([]*ent.Projects, error)
+	Create(ctx context.Context, data models.PackageData) (*ent.Packages, error)
+	// Replace overwrites every field of the package, provided it is still at
+	// version. Empty optional fields are cleared. It returns ErrModified when
+	// the package is at another version.
+	Replace(ctx context.Context, id, version int, data models.PackageData) (*ent.Packages, error)
+	// Delete moves the package to the trash, provided it is still at version
+	Delete(ctx context.Context, id, version int) error
+	// Restore moves the package out of the trash
+	Restore(ctx context.Context, id int) (*ent.Packages, error)
+}
+
+type packageService struct {
+	client *ent.Client
+}
+
+// NewPackages returns the Packages service over client
+func NewPackages(client *ent.Client) Packages {
+	return &packageService{client: client}
+}
+
+func (s *packageService) List(ctx context.Context, filter PackageFilter, page *listing.Params[*ent.Packages]) ([]*ent.Packages, int, error) {
+	query := s.client.Packages.Query()
+	if filter.NameContains != "" {
+		query.Where(packages.NameContainsFold(filter.NameContains))
+	}
+	if filter.Stack != "" {
+		query.Where(packages.HasStacksWith(stacks.Slug(slug.Make(filter.Stack))))
+	}
+	if !filter.UpdatedSince.IsZero() {
+		query.Where(packages.UpdatedAtGTE(filter.UpdatedSince))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if after := page.After(); after != nil {
+		query.Where(after)
+	}
+	for _, order := range page.Order() {
+		query.Order(order)
+	}
+	limit, offset := page.Window()
+
+	list, err := query.
+		WithStacks(byStackID).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+func (s *packageService) Get(ctx context.Context, id int) (*ent.Packages, error) {
+	return s.client.Packages.Query().
+		Where(packages.ID(id)).
+		WithStacks(byStackID).
+		Only(ctx)
+}
+
+func (s *packageService) Projects(ctx context.Context, id int) ([]*ent.Projects, error) {
+	pkg, err := s.client.Packages.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return WithProjectEdges(pkg.QueryProjects()).All(ctx)
+}
+
+func (s *packageService) Create(ctx context.Context, data models.PackageData) (*ent.Packages, error) {
+	var created *ent.Packages
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		stackIDs, err := database.EnsureStacks(ctx, tx, data.Stacks)
+		if err != nil {
+			return err
+		}
+
+		created, err = tx.Packages.Create().
+			SetName(data.Name).
+			SetLink(data.Link).
+			SetDescription(data.Description).
+			AddStackIDs(stackIDs...).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, created.ID)
+}
+
+func (s *packageService) Replace(ctx context.Context, id, version int, data models.PackageData) (*ent.Packages, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		stackIDs, err := database.EnsureStacks(ctx, tx, data.Stacks)
+		if err != nil {
+			return err
+		}
+
+		update := tx.Packages.UpdateOneID(id).
+			AddVersion(1).
+			SetName(data.Name).
+			ClearStacks().
+			AddStackIDs(stackIDs...)
+		SetOrClear(data.Link, update.SetLink, update.ClearLink)
+		SetOrClear(data.Description, update.SetDescription, update.ClearDescription)
+		if version != AnyVersion {
+			update.Where(packages.Version(version))
+		}
+		return update.Exec(ctx)
+	})
+	if ent.IsNotFound(err) {
+		return nil, s.modifiedOrMissing(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, id)
+}
+
+func (s *packageService) Delete(ctx context.Context, id, version int) error {
+	var deleted int
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		del := tx.Packages.Delete().Where(packages.ID(id))
+		if version != AnyVersion {
+			del.Where(packages.Version(version))
+		}
+		var err error
+		deleted, err = del.Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return s.modifiedOrMissing(ctx, id)
+	}
+	return nil
+}
+
+func (s *packageService) Restore(ctx context.Context, id int) (*ent.Packages, error) {
+	var restored int
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		var err error
+		restored, err = tx.Packages.Update().
+			Where(packages.ID(id), packages.DeletedAtNotNil()).
+			ClearDeletedAt().
+			AddVersion(1).
+			Save(schema.SkipSoftDelete(ctx))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if restored == 0 {
+		return nil, ErrNotInTrash
+	}
+	return s.Get(ctx, id)
+}
+
+// modifiedOrMissing is called after a version-guarded write matched no row:
+// it returns ErrModified when the package still exists and not-found otherwise
+func (s *packageService) modifiedOrMissing(ctx context.Context, id int) error {
+	if _, err := s.client.Packages.Get(ctx, id); err != nil {
+		return err
+	}
+	return ErrModified
+}
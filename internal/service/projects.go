@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"project-manager/ent"
+	"project-manager/ent/clients"
+	"project-manager/ent/packages"
+	"project-manager/ent/projects"
+	"project-manager/ent/schema"
+	"project-manager/ent/stacks"
+	"project-manager/internal/database"
+	"project-manager/internal/listing"
+	"project-manager/internal/models"
+	"project-manager/internal/slug"
+)
+
+// ProjectListSpec lists the fields projects can be sorted on
+var ProjectListSpec = listing.Spec[*ent.Projects]{
+	IDName: "id",
+	Fields: map[string]listing.Field[*ent.Projects]{
+		"id":         {Column: projects.FieldID, Kind: listing.KindInt, Value: func(p *ent.Projects) any { return p.ID }},
+		"name":       {Column: projects.FieldName, Kind: listing.KindString, Value: func(p *ent.Projects) any { return p.Name }},
+		"created_at": {Column: projects.FieldCreatedAt, Kind: listing.KindTime, Value: func(p *ent.Projects) any { return p.CreatedAt }},
+		"updated_at": {Column: projects.FieldUpdatedAt, Kind: listing.KindTime, Value: func(p *ent.Projects) any { return p.UpdatedAt }},
+	},
+}
+
+// ProjectFilter narrows a project listing. Zero fields do not filter.
+type ProjectFilter struct {
+	NameContains string
+	Stack        string // Stack name or slug
+	ClientID     int
+	PackageID    int
+	UpdatedSince time.Time
+}
+
+// Projects reads and writes projects. Every project returned has its client,
+// packages and stacks loaded.
+type Projects interface {
+	// List returns one page of the projects matching filter and the total number of matches
+	List(ctx context.Context, filter ProjectFilter, page *listing.Params[*ent.Projects]) ([]*ent.Projects, int, error)
+	Get(ctx context.Context, id int) (*ent.Projects, error)
+	Create(ctx context.Context, data models.ProjectData) (*ent.Projects, error)
+	// Replace overwrites every field and edge of the project, provided it is
+	// still at version. It returns ErrModified when it is not.
+	Replace(ctx context.Context, id, version int, data models.ProjectData) (*ent.Projects, error)
+	// Delete moves the project to the trash, provided it is still at version
+	Delete(ctx context.Context, id, version int) error
+	// Restore moves the project out of the trash
+	Restore(ctx context.Context, id int) (*ent.Projects, error)
+}
+
+type projectService struct {
+	client *ent.Client
+}
+
+// NewProjects returns the Projects service over client
+func NewProjects(client *ent.Client) Projects {
+	return &projectService{client: client}
+}
+
+func (s *projectService) List(ctx context.Context, filter ProjectFilter, page *listing.Params[*ent.Projects]) ([]*ent.Projects, int, error) {
+	query := s.client.Projects.Query()
+	if filter.NameContains != "" {
+		query.Where(projects.NameContainsFold(filter.NameContains))
+	}
+	if filter.Stack != "" {
+		query.Where(projects.HasStacksWith(stacks.Slug(slug.Make(filter.Stack))))
+	}
+	if filter.ClientID != 0 {
+		query.Where(projects.HasClientWith(clients.ID(filter.ClientID)))
+	}
+	if filter.PackageID != 0 {
+		query.Where(projects.HasPackagesWith(packages.ID(filter.PackageID)))
+	}
+	if !filter.UpdatedSince.IsZero() {
+		query.Where(projects.UpdatedAtGTE(filter.UpdatedSince))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if after := page.After(); after != nil {
+		query.Where(after)
+	}
+	for _, order := range page.Order() {
+		query.Order(order)
+	}
+	limit, offset := page.Window()
+
+	list, err := WithProjectEdges(query).Limit(limit).Offset(offset).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+func (s *projectService) Get(ctx context.Context, id int) (*ent.Projects, error) {
+	return WithProjectEdges(s.client.Projects.Query()).
+		Where(projects.ID(id)).
+		Only(ctx)
+}
+
+func (s *projectService) Create(ctx context.Context, data models.ProjectData) (*ent.Projects, error) {
+	var created *ent.Projects
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		// Resolve stack names to stack rows, creating new ones as needed
+		stackIDs, err := database.EnsureStacks(ctx, tx, data.Stacks)
+		if err != nil {
+			return err
+		}
+
+		created, err = tx.Projects.Create().
+			SetName(data.Name).
+			SetImageUrl(data.ImageUrl).
+			SetLink(data.Link).
+			SetDescription(data.Description).
+			AddStackIDs(stackIDs...).
+			SetNillableClientID(data.ClientID).
+			AddPackageIDs(data.PackageIDs...).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, created.ID)
+}
+
+func (s *projectService) Replace(ctx context.Context, id, version int, data models.ProjectData) (*ent.Projects, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		stackIDs, err := database.EnsureStacks(ctx, tx, data.Stacks)
+		if err != nil {
+			return err
+		}
+
+		update := tx.Projects.UpdateOneID(id).
+			AddVersion(1).
+			SetName(data.Name).
+			ClearStacks().
+			AddStackIDs(stackIDs...).
+			ClearPackages().
+			AddPackageIDs(data.PackageIDs...)
+		SetOrClear(data.ImageUrl, update.SetImageUrl, update.ClearImageUrl)
+		SetOrClear(data.Link, update.SetLink, update.ClearLink)
+		SetOrClear(data.Description, update.SetDescription, update.ClearDescription)
+		if data.ClientID != nil {
+			update.SetClientID(*data.ClientID)
+		} else {
+			update.ClearClient()
+		}
+		if version != AnyVersion {
+			update.Where(projects.Version(version))
+		}
+		return update.Exec(ctx)
+	})
+	if ent.IsNotFound(err) {
+		return nil, s.modifiedOrMissing(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, id)
+}
+
+func (s *projectService) Delete(ctx context.Context, id, version int) error {
+	var deleted int
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		del := tx.Projects.Delete().Where(projects.ID(id))
+		if version != AnyVersion {
+			del.Where(projects.Version(version))
+		}
+		var err error
+		deleted, err = del.Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return s.modifiedOrMissing(ctx, id)
+	}
+	return nil
+}
+
+// Restore uses UpdateOne, so that the revision hook records the new version
+func (s *projectService) Restore(ctx context.Context, id int) (*ent.Projects, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Client) error {
+		return tx.Projects.UpdateOneID(id).
+			Where(projects.DeletedAtNotNil()).
+			ClearDeletedAt().
+			AddVersion(1).
+			Exec(schema.SkipSoftDelete(ctx))
+	})
+	if ent.IsNotFound(err) {
+		return nil, ErrNotInTrash
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, id)
+}
+
+// modifiedOrMissing is called after a version-guarded write matched no row:
+// it returns ErrModified when the project still exists and not-found otherwise
+func (s *projectService) modifiedOrMissing(ctx context.Context, id int) error {
+	if _, err := s.client.Projects.Get(ctx, id); err != nil {
+		return err
+	}
+	return ErrModified
+}
+
+// WithProjectEdges eager-loads everything mapper.Project renders
+func WithProjectEdges(query *ent.ProjectsQuery) *ent.ProjectsQuery {
+	return query.
+		WithClient().
+		WithStacks(byStackID).
+		WithPackages(func(q *ent.PackagesQuery) {
+			q.Order(ent.Asc(packages.FieldID)).WithStacks(byStackID)
+		})
+}
+
+// byStackID orders eager-loaded stacks, which the database returns in no
+// particular order, so responses list them the same way every time
+func byStackID(q *ent.StacksQuery) {
+	q.Order(ent.Asc(stacks.FieldID))
+}
@@ -0,0 +1,67 @@
+// Package service reads and writes projects, packages and clients for the
+// HTTP handlers. Handlers depend on the interfaces here rather than on a
+// global ent client, so they can run against ent/enttest or a fake.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"project-manager/ent"
+)
+
+// AnyVersion skips the version check of a guarded write, as "If-Match: *" does
+const AnyVersion = 0
+
+var (
+	// ErrModified is returned by version-guarded writes when the entity
+	// still exists but is no longer at the expected version
+	ErrModified = errors.New("entity was modified since it was read")
+
+	// ErrNotInTrash is returned by Restore when the entity is live or gone
+	ErrNotInTrash = errors.New("entity is not in the trash")
+)
+
+// Services bundles the services the handlers are built with
+type Services struct {
+	Projects Projects
+	Packages Packages
+	Clients  Clients
+}
+
+// New returns the ent-backed services over client
+func New(client *ent.Client) Services {
+	return Services{
+		Projects: NewProjects(client),
+		Packages: NewPackages(client),
+		Clients:  NewClients(client),
+	}
+}
+
+// withTx runs fn on a client bound to a new transaction and commits it when fn
+// succeeds. Every write goes through it, so that an entity row, the stacks it
+// creates and the audit and revision rows its hooks add are saved together.
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Client) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx.Client()); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// SetOrClear calls set with value, or clear when value is empty, so that
+// replacing an entity removes optional fields the caller left out
+func SetOrClear[U any](value string, set func(string) U, clear func() U) {
+	if value == "" {
+		clear()
+		return
+	}
+	set(value)
+}
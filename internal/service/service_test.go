@@ -0,0 +1,260 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"project-manager/ent"
+	"project-manager/internal/listing"
+	"project-manager/internal/models"
+	"project-manager/internal/service"
+	"project-manager/internal/testutil"
+)
+
+// newServices returns services over a fresh in-memory SQLite database wired
+// with the same hooks as production
+func newServices(t *testing.T) (service.Services, *ent.Client) {
+	t.Helper()
+	client := testutil.DB(t)
+	return service.New(client), client
+}
+
+// firstPage parses listing params for a request with the given query string
+func firstPage[T any](t *testing.T, spec listing.Spec[T], query string) *listing.Params[T] {
+	t.Helper()
+	params, err := listing.Parse(httptest.NewRequest("GET", "/?"+query, nil), spec)
+	if err != nil {
+		t.Fatalf("listing.Parse(%q): %v", query, err)
+	}
+	return params
+}
+
+func TestProjectLifecycle(t *testing.T) {
+	ctx := context.Background()
+	svc, db := newServices(t)
+
+	client, err := svc.Clients.Create(ctx, models.ClientData{Name: "Acme", Link: "https://acme.test", ImageUrl: "https://acme.test/logo.png"})
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	pkg, err := svc.Packages.Create(ctx, models.PackageData{Name: "gorilla/mux", Stacks: []string{"Go"}})
+	if err != nil {
+		t.Fatalf("create package: %v", err)
+	}
+
+	data := models.ProjectData{
+		Name:        "Portal",
+		ImageUrl:    "https://acme.test/portal.png",
+		Link:        "https://portal.acme.test",
+		Description: "Customer portal",
+		Stacks:      []string{"Go", "React"},
+		ClientID:    &client.ID,
+		PackageIDs:  []int{pkg.ID},
+	}
+	project, err := svc.Projects.Create(ctx, data)
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	if project.Version != 1 || project.Edges.Client == nil || len(project.Edges.Packages) != 1 || len(project.Edges.Stacks) != 2 {
+		t.Fatalf("created project = %+v, want version 1 with client, package and two stacks", project)
+	}
+
+	data.ClientID = nil
+	data.Stacks = []string{"go"}
+	project, err = svc.Projects.Replace(ctx, project.ID, project.Version, data)
+	if err != nil {
+		t.Fatalf("replace project: %v", err)
+	}
+	if project.Version != 2 || project.Edges.Client != nil || len(project.Edges.Stacks) != 1 {
+		t.Fatalf("replaced project = %+v, want version 2 without client and with one stack", project)
+	}
+
+	if _, err := svc.Projects.Replace(ctx, project.ID, 1, data); !errors.Is(err, service.ErrModified) {
+		t.Errorf("replace at stale version: got %v, want ErrModified", err)
+	}
+	if err := svc.Projects.Delete(ctx, project.ID, 1); !errors.Is(err, service.ErrModified) {
+		t.Errorf("delete at stale version: got %v, want ErrModified", err)
+	}
+
+	if err := svc.Projects.Delete(ctx, project.ID, project.Version); err != nil {
+		t.Fatalf("delete project: %v", err)
+	}
+	if _, err := svc.Projects.Get(ctx, project.ID); !ent.IsNotFound(err) {
+		t.Errorf("get deleted project: got %v, want not found", err)
+	}
+
+	restored, err := svc.Projects.Restore(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("restore project: %v", err)
+	}
+	if restored.Version != 3 {
+		t.Errorf("restored version = %d, want 3", restored.Version)
+	}
+	// Every version, the restore included, has a revision
+	revisions, err := db.ProjectRevisions.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("count revisions: %v", err)
+	}
+	if revisions != 3 {
+		t.Errorf("revisions = %d, want 3", revisions)
+	}
+	if _, err := svc.Projects.Restore(ctx, project.ID); !errors.Is(err, service.ErrNotInTrash) {
+		t.Errorf("restore live project: got %v, want ErrNotInTrash", err)
+	}
+}
+
+func TestFailedAuditRollsBackTheWrite(t *testing.T) {
+	ctx := context.Background()
+	svc, db := newServices(t)
+	db.AuditEvents.Use(func(ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+			return nil, errors.New("audit table unavailable")
+		})
+	})
+
+	if _, err := svc.Packages.Create(ctx, models.PackageData{Name: "gorilla/mux", Stacks: []string{"Go"}}); err == nil {
+		t.Fatal("create package: got no error from a failed audit insert")
+	}
+	// Neither the package nor the stack it would have created was saved
+	if n := db.Packages.Query().CountX(ctx); n != 0 {
+		t.Errorf("packages = %d, want 0", n)
+	}
+	if n := db.Stacks.Query().CountX(ctx); n != 0 {
+		t.Errorf("stacks = %d, want 0", n)
+	}
+}
+
+func TestFailedRevisionRollsBackTheWrite(t *testing.T) {
+	ctx := context.Background()
+	svc, db := newServices(t)
+
+	data := models.ProjectData{Name: "Portal", ImageUrl: "https://acme.test/portal.png"}
+	project, err := svc.Projects.Create(ctx, data)
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+
+	db.ProjectRevisions.Use(func(ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+			return nil, errors.New("revisions table unavailable")
+		})
+	})
+	data.Name = "Customer portal"
+	if _, err := svc.Projects.Replace(ctx, project.ID, project.Version, data); err == nil {
+		t.Fatal("replace project: got no error from a failed revision insert")
+	}
+
+	// The project stays at the last version that has a revision
+	project, err = svc.Projects.Get(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("get project: %v", err)
+	}
+	if project.Version != 1 || project.Name != "Portal" {
+		t.Errorf("project = version %d named %q, want version 1 named Portal", project.Version, project.Name)
+	}
+}
+
+func TestWritesToMissingEntitiesAreNotFound(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newServices(t)
+
+	data := models.ClientData{Name: "Ghost", Link: "https://ghost.test", ImageUrl: "https://ghost.test/logo.png"}
+	if _, err := svc.Clients.Replace(ctx, 42, service.AnyVersion, data); !ent.IsNotFound(err) {
+		t.Errorf("replace missing client: got %v, want not found", err)
+	}
+	if err := svc.Packages.Delete(ctx, 42, 1); !ent.IsNotFound(err) {
+		t.Errorf("delete missing package: got %v, want not found", err)
+	}
+	if _, err := svc.Clients.Projects(ctx, 42); !ent.IsNotFound(err) {
+		t.Errorf("projects of missing client: got %v, want not found", err)
+	}
+}
+
+func TestDuplicateNamesAreConstraintErrors(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newServices(t)
+
+	pkg, err := svc.Packages.Create(ctx, models.PackageData{Name: "chi"})
+	if err != nil {
+		t.Fatalf("create package: %v", err)
+	}
+	if _, err := svc.Packages.Create(ctx, models.PackageData{Name: "chi"}); !ent.IsConstraintError(err) {
+		t.Errorf("create duplicate package: got %v, want constraint error", err)
+	}
+
+	// Names only need to be unique among live rows
+	if err := svc.Packages.Delete(ctx, pkg.ID, service.AnyVersion); err != nil {
+		t.Fatalf("delete package: %v", err)
+	}
+	if _, err := svc.Packages.Create(ctx, models.PackageData{Name: "chi"}); err != nil {
+		t.Fatalf("create package with a trashed name: %v", err)
+	}
+	if _, err := svc.Packages.Restore(ctx, pkg.ID); !ent.IsConstraintError(err) {
+		t.Errorf("restore package with a taken name: got %v, want constraint error", err)
+	}
+}
+
+func TestPackageReplaceClearsOmittedFields(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newServices(t)
+
+	pkg, err := svc.Packages.Create(ctx, models.PackageData{Name: "ent", Link: "https://entgo.io", Description: "ORM", Stacks: []string{"Go"}})
+	if err != nil {
+		t.Fatalf("create package: %v", err)
+	}
+
+	pkg, err = svc.Packages.Replace(ctx, pkg.ID, service.AnyVersion, models.PackageData{Name: "ent"})
+	if err != nil {
+		t.Fatalf("replace package: %v", err)
+	}
+	if pkg.Link != "" || pkg.Description != "" || len(pkg.Edges.Stacks) != 0 {
+		t.Errorf("replaced package = %+v, want link, description and stacks cleared", pkg)
+	}
+}
+
+func TestProjectListFiltersAndPages(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newServices(t)
+
+	client, err := svc.Clients.Create(ctx, models.ClientData{Name: "Acme", Link: "https://acme.test", ImageUrl: "https://acme.test/logo.png"})
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	for _, name := range []string{"Alpha", "Beta", "Gamma"} {
+		data := models.ProjectData{Name: name, ImageUrl: "https://img.test", Link: "https://link.test", Description: name, Stacks: []string{"Go"}}
+		if name != "Beta" {
+			data.ClientID = &client.ID
+		}
+		if _, err := svc.Projects.Create(ctx, data); err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+	}
+
+	list, total, err := svc.Projects.List(ctx, service.ProjectFilter{ClientID: client.ID}, firstPage(t, service.ProjectListSpec, "sort=-name"))
+	if err != nil {
+		t.Fatalf("list by client: %v", err)
+	}
+	if total != 2 || len(list) != 2 || list[0].Name != "Gamma" || list[1].Name != "Alpha" {
+		t.Errorf("list by client = %d total, %v; want Gamma then Alpha", total, names(list))
+	}
+
+	page := firstPage(t, service.ProjectListSpec, "limit=2&sort=name")
+	list, total, err = svc.Projects.List(ctx, service.ProjectFilter{Stack: "go"}, page)
+	if err != nil {
+		t.Fatalf("list by stack: %v", err)
+	}
+	// Window fetches one extra row so listing.Page can tell a next page exists
+	if total != 3 || len(list) != 3 {
+		t.Errorf("list by stack = %d total, %d rows; want 3 and 3", total, len(list))
+	}
+}
+
+func names(list []*ent.Projects) []string {
+	var out []string
+	for _, p := range list {
+		out = append(out, p.Name)
+	}
+	return out
+}
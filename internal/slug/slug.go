@@ -0,0 +1,29 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Make turns a display name such as "Next.js" or "C#" into a URL-safe slug
+// ("nextjs", "csharp"). Runs of other characters collapse into a single dash.
+func Make(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.NewReplacer("#", "sharp", "+", "plus", ".", "").Replace(name)
+
+	var b strings.Builder
+	dash := false
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteRune('-')
+			dash = true
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "-")
+}
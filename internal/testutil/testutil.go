@@ -0,0 +1,103 @@
+// Package testutil builds the fixtures shared by the tests of several
+// packages: an in-memory database wired like production, and the full
+// router over it.
+package testutil
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"project-manager/ent"
+	"project-manager/ent/enttest"
+	"project-manager/ent/users"
+	"project-manager/internal/auth"
+	"project-manager/internal/config"
+	"project-manager/internal/database"
+	"project-manager/internal/handlers"
+	"project-manager/internal/health"
+	"project-manager/internal/router"
+	"project-manager/internal/search"
+	"project-manager/internal/service"
+
+	"github.com/gorilla/mux"
+	_ "github.com/mattn/go-sqlite3" // Registers the sqlite3 driver the databases run on
+)
+
+// JWTSecret signs the tokens of every test server
+const JWTSecret = "test-secret"
+
+// DB returns a client over a fresh in-memory SQLite database named after t,
+// with the same hooks as production. It is closed when the test ends.
+func DB(t testing.TB) *ent.Client {
+	t.Helper()
+	dsn := "file:" + strings.ReplaceAll(t.Name(), "/", "_") + "?mode=memory&cache=shared&_fk=1"
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	database.UseHooks(client)
+	return client
+}
+
+// App is the full router over a fresh database
+type App struct {
+	Client   *ent.Client
+	Services service.Services
+	Health   *health.Registry // Empty; tests may register checks
+	Router   *mux.Router
+}
+
+// NewApp builds the router over DB(t) the way main does, with in-process search
+func NewApp(t testing.TB, features config.Features, server config.Server) *App {
+	t.Helper()
+	if err := auth.InitAuth(config.Auth{JWTSecret: JWTSecret}); err != nil {
+		t.Fatalf("init auth: %v", err)
+	}
+
+	client := DB(t)
+	services := service.New(client)
+	checks := health.NewRegistry()
+	h := handlers.New(client, services, search.NewMemory(client), checks)
+	return &App{
+		Client:   client,
+		Services: services,
+		Health:   checks,
+		Router:   router.New(h, features, server),
+	}
+}
+
+var (
+	hashMu sync.Mutex
+	hashes = map[string]string{}
+)
+
+// CreateUser saves a user with the given role who logs in with password.
+// Hashes are cached, since bcrypt is slow by design and tests seed many users.
+func CreateUser(t testing.TB, client *ent.Client, email, name, password string, role users.Role) *ent.Users {
+	t.Helper()
+
+	hashMu.Lock()
+	hash, ok := hashes[password]
+	if !ok {
+		var err error
+		if hash, err = auth.HashPassword(password); err != nil {
+			hashMu.Unlock()
+			t.Fatalf("hash password: %v", err)
+		}
+		hashes[password] = hash
+	}
+	hashMu.Unlock()
+
+	create := client.Users.Create().
+		SetEmail(email).
+		SetPasswordHash(hash).
+		SetRole(role)
+	if name != "" {
+		create.SetName(name)
+	}
+	user, err := create.Save(context.Background())
+	if err != nil {
+		t.Fatalf("create %s user: %v", role, err)
+	}
+	return user
+}
@@ -0,0 +1,203 @@
+package validation
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FieldError describes why a single field failed validation
+type FieldError struct {
+	Field   string `json:"field"` // JSON name, with an index for slice items, e.g. stacks[2]
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Errors collects every field error found in a struct
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fe := range e {
+		messages[i] = fe.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Struct validates every field of v, a pointer to or value of a struct,
+// against its `validate` and `items` tags. It returns nil when v is valid.
+//
+// Supported rules, comma separated:
+//
+//	required      the value must not be empty
+//	min=N, max=N  string length in characters, slice length, or integer value
+//	url           an absolute http or https URL
+//	email         an email address
+//	oneof=a b c   one of the space-separated values
+//	trimmed       no leading or trailing whitespace
+//	unique        slice items must not repeat (case-insensitive for strings)
+//
+// The `items` tag applies the same rules to each element of a slice field.
+func Struct(v any) Errors {
+	val := reflect.Indirect(reflect.ValueOf(v))
+	var errs Errors
+	walk(val, &errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func walk(val reflect.Value, errs *Errors) {
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fv := val.Field(i)
+
+		// Descend into embedded structs such as ProjectResponse.ProjectData
+		if field.Anonymous && fv.Kind() == reflect.Struct {
+			walk(fv, errs)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		name := jsonName(field)
+		if rules := field.Tag.Get("validate"); rules != "" {
+			check(name, fv, rules, errs)
+		}
+		if rules := field.Tag.Get("items"); rules != "" && fv.Kind() == reflect.Slice {
+			for j := 0; j < fv.Len(); j++ {
+				check(fmt.Sprintf("%s[%d]", name, j), fv.Index(j), rules, errs)
+			}
+		}
+	}
+}
+
+func check(name string, fv reflect.Value, rules string, errs *Errors) {
+	// Pointers are optional values; nil means not provided, anything else is checked
+	provided := false
+	if fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			if hasRule(rules, "required") {
+				*errs = append(*errs, FieldError{name, "required", name + " is required"})
+			}
+			return
+		}
+		fv = fv.Elem()
+		provided = true
+	}
+
+	if !provided && isEmpty(fv) {
+		if hasRule(rules, "required") {
+			*errs = append(*errs, FieldError{name, "required", name + " is required"})
+		}
+		return
+	}
+
+	for _, rule := range strings.Split(rules, ",") {
+		key, arg, _ := strings.Cut(rule, "=")
+		if msg := apply(key, arg, fv); msg != "" {
+			*errs = append(*errs, FieldError{name, key, name + " " + msg})
+			// One message per field is enough; later rules tend to repeat the problem
+			return
+		}
+	}
+}
+
+func apply(rule, arg string, fv reflect.Value) string {
+	switch rule {
+	case "required":
+		return ""
+	case "min", "max":
+		limit, _ := strconv.Atoi(arg)
+		size, unit := measure(fv)
+		if rule == "min" && size < limit {
+			return fmt.Sprintf("must be at least %d%s", limit, unit)
+		}
+		if rule == "max" && size > limit {
+			return fmt.Sprintf("must be at most %d%s", limit, unit)
+		}
+	case "url":
+		u, err := url.Parse(fv.String())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "must be a valid http or https URL"
+		}
+	case "email":
+		addr, err := mail.ParseAddress(fv.String())
+		if err != nil || addr.Address != fv.String() {
+			return "must be a valid email address"
+		}
+	case "oneof":
+		options := strings.Fields(arg)
+		for _, option := range options {
+			if fv.String() == option {
+				return ""
+			}
+		}
+		return "must be one of: " + strings.Join(options, ", ")
+	case "trimmed":
+		if s := fv.String(); s != strings.TrimSpace(s) {
+			return "must not start or end with whitespace"
+		}
+	case "unique":
+		seen := map[string]bool{}
+		for i := 0; i < fv.Len(); i++ {
+			key := strings.ToLower(fmt.Sprint(fv.Index(i).Interface()))
+			if seen[key] {
+				return "must not contain duplicates"
+			}
+			seen[key] = true
+		}
+	default:
+		panic("validation: unknown rule " + rule)
+	}
+	return ""
+}
+
+// measure returns the size used by min/max and the unit for messages
+func measure(fv reflect.Value) (int, string) {
+	switch fv.Kind() {
+	case reflect.String:
+		return utf8.RuneCountInString(fv.String()), " characters"
+	case reflect.Slice, reflect.Map:
+		return fv.Len(), " items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(fv.Int()), ""
+	}
+	return 0, ""
+}
+
+// isEmpty reports whether a string or collection is blank. Numbers are never
+// empty so that rules such as min=1 still reject a zero.
+func isEmpty(fv reflect.Value) bool {
+	switch fv.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(fv.String()) == ""
+	case reflect.Slice, reflect.Map:
+		return fv.Len() == 0
+	}
+	return false
+}
+
+func hasRule(rules, name string) bool {
+	for _, rule := range strings.Split(rules, ",") {
+		if rule == name {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
@@ -1,65 +1,112 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
+	"log/slog"
 	"net/http"
+	"os"
 
+	"project-manager/internal/auth"
+	"project-manager/internal/config"
 	"project-manager/internal/database"
 	handler "project-manager/internal/handlers"
+	"project-manager/internal/health"
+	"project-manager/internal/logging"
+	"project-manager/internal/metrics"
+	"project-manager/internal/router"
+	"project-manager/internal/search"
+	"project-manager/internal/server"
+	"project-manager/internal/service"
+	"project-manager/middleware"
 
 	"github.com/gorilla/handlers"
-	"github.com/gorilla/mux"
-	httpSwagger "github.com/swaggo/http-swagger"
 )
 
 func main() {
+	// Maintenance commands run instead of the server
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "purge":
+			runPurge(os.Args[2:])
+			return
+		case "migrate":
+			runMigrate(os.Args[2:])
+			return
+		}
+	}
+
+	// Load settings from the config file, the environment and flags
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if err := cfg.ValidateServer(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	logging.Setup(cfg.Log)
+	slog.Info("configuration loaded", "config", cfg)
+
 	// Initialize the database
-	client, err := database.InitDB()
+	client, err := database.InitDB(cfg.Database)
 	if err != nil {
 		log.Fatalf("Failed to connect to the database: %v", err)
 	}
-	defer client.Close()
 
-	// Create a new router
-	r := mux.NewRouter()
+	// Initialize token signing and the bootstrap admin account
+	if err := auth.InitAuth(cfg.Auth); err != nil {
+		log.Fatalf("Failed to initialize auth: %v", err)
+	}
+	if err := auth.SeedAdmin(client, cfg.Auth); err != nil {
+		log.Fatalf("Failed to seed admin user: %v", err)
+	}
 
-	// Project routes
-	r.HandleFunc("/api/projects/new", handler.CreateProjectHandler).Methods("POST", "OPTIONS")
-	r.HandleFunc("/api/projects", handler.GetProjectsHandler).Methods("GET", "OPTIONS")
-	r.HandleFunc("/api/projects/{id}", handler.GetProjectByIDHandler).Methods("GET", "OPTIONS")
-	r.HandleFunc("/api/projects/{id}", handler.UpdateProjectHandler).Methods("PUT", "OPTIONS")
-	r.HandleFunc("/api/projects/{id}", handler.DeleteProjectHandler).Methods("DELETE", "OPTIONS")
+	// Pick PostgreSQL text search, or the in-process index when unavailable or disabled
+	searchDB := database.DB
+	if !cfg.Features.PostgresSearch {
+		searchDB = nil
+	}
+	searcher := search.New(client, searchDB)
 
-	// Package routes
-	r.HandleFunc("/api/packages/new", handler.CreatePackageHandler).Methods("POST", "OPTIONS")
-	r.HandleFunc("/api/packages", handler.GetPackagesHandler).Methods("GET", "OPTIONS")
-	r.HandleFunc("/api/packages/{id}", handler.GetPackageByIDHandler).Methods("GET", "OPTIONS")
-	r.HandleFunc("/api/packages/{id}", handler.UpdatePackageHandler).Methods("PUT", "OPTIONS")
-	r.HandleFunc("/api/packages/{id}", handler.DeletePackageHandler).Methods("DELETE", "OPTIONS")
+	// Dependencies checked by /readyz and /api/status
+	checks := health.NewRegistry()
+	checks.Register("database", database.DB.PingContext)
+	checks.Register("migrations", database.CheckSchema)
 
-	// Client routes
-	r.HandleFunc("/api/clients/new", handler.CreateClientHandler).Methods("POST", "OPTIONS")
-	r.HandleFunc("/api/clients", handler.GetClientsHandler).Methods("GET", "OPTIONS")
-	r.HandleFunc("/api/clients/{id}", handler.GetClientByIDHandler).Methods("GET", "OPTIONS")
-	r.HandleFunc("/api/clients/{id}", handler.UpdateClientHandler).Methods("PUT", "OPTIONS")
-	r.HandleFunc("/api/clients/{id}", handler.DeleteClientHandler).Methods("DELETE", "OPTIONS")
+	// Handlers read and write through the services over the client
+	h := handler.New(client, service.New(client), searcher, checks)
 
-	// Swagger documentation route
-	r.PathPrefix("/swagger/").Handler(httpSwagger.WrapHandler)
+	// Mount every route; /metrics also needs the pool statistics registered
+	if cfg.Features.Metrics {
+		metrics.RegisterDBStats(database.DB, "postgres")
+	}
+	r := router.New(h, cfg.Features, cfg.Server)
 
 	// Enhanced CORS middleware
 	corsHandler := handlers.CORS(
-		handlers.AllowedOrigins([]string{"http://localhost:3000", "https://project-manager-server-side-production.up.railway.app", "https://onahsunday.vercel.app"}),
-		handlers.AllowedMethods([]string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}),
-		handlers.AllowedHeaders([]string{"Content-Type", "Authorization", "X-Requested-With"}),
+		handlers.AllowedOrigins(cfg.CORS.AllowedOrigins),
+		handlers.AllowedMethods([]string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}),
+		handlers.AllowedHeaders([]string{"Content-Type", "Authorization", "X-Requested-With", "If-Match", "If-None-Match", middleware.RequestIDHeader}),
 		handlers.AllowCredentials(),
-		handlers.ExposedHeaders([]string{"Content-Length"}),
+		handlers.ExposedHeaders([]string{"Content-Length", "Link", "X-Total-Count", "X-Next-Cursor", "ETag", middleware.RequestIDHeader}),
 		handlers.MaxAge(86400), // 24 hours
 	)(r)
 
-	// Start the server
-	log.Println("Starting server on :8080...")
-	if err := http.ListenAndServe(":8080", corsHandler); err != nil {
-		log.Fatalf("Could not start server: %v", err)
+	// Measure and log every request, CORS preflights included, under a request ID
+	var root http.Handler = corsHandler
+	if cfg.Features.Metrics {
+		root = metrics.Middleware(r)(root)
+	}
+	root = middleware.RequestID(middleware.AccessLog(r)(root))
+
+	// Start the server; the database is closed once requests have drained
+	srv := server.New(cfg.Server, root)
+	srv.OnDrain(checks.SetDraining)
+	srv.OnShutdown("database", func(ctx context.Context) error {
+		return client.Close()
+	})
+	if err := srv.Run(context.Background()); err != nil {
+		log.Fatalf("Server error: %v", err)
 	}
 }
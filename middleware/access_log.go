@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+
+	"project-manager/internal/metrics"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/gorilla/mux"
+)
+
+// AccessLog logs one line per request handled by router with its method,
+// route template, path, status, response size and duration
+func AccessLog(router *mux.Router) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m := httpsnoop.CaptureMetrics(next, w, r)
+
+			level := slog.LevelInfo
+			if m.Code >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			slog.Log(r.Context(), level, "request",
+				"method", r.Method,
+				"route", metrics.RouteTemplate(router, r),
+				"path", r.URL.Path,
+				"status", m.Code,
+				"bytes", m.Written,
+				"duration_ms", float64(m.Duration.Microseconds())/1000,
+				"remote_addr", r.RemoteAddr,
+			)
+		})
+	}
+}
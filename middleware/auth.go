@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"project-manager/ent/users"
+	"project-manager/internal/audit"
+	"project-manager/internal/auth"
+	"project-manager/internal/problem"
+)
+
+type contextKey string
+
+const claimsKey contextKey = "claims"
+
+// ClaimsFromContext returns the token claims stored by RequireRole, if any
+func ClaimsFromContext(ctx context.Context) (*auth.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*auth.Claims)
+	return claims, ok
+}
+
+// RequireRole rejects requests without a valid bearer access token, or whose
+// token carries a role that is not in the allowed list
+func RequireRole(roles ...users.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Let CORS preflight requests through untouched
+			if r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			header := r.Header.Get("Authorization")
+			tokenString, found := strings.CutPrefix(header, "Bearer ")
+			if !found || tokenString == "" {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+				problem.Unauthorized(w, r, "Missing bearer token")
+				return
+			}
+
+			claims, err := auth.ParseToken(tokenString, auth.TokenTypeAccess)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="api", error="invalid_token"`)
+				problem.Unauthorized(w, r, "Invalid or expired token")
+				return
+			}
+
+			if !hasRole(claims.Role, roles) {
+				problem.Forbidden(w, r, "Insufficient permissions")
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), claimsKey, claims)
+			if userID, err := claims.UserID(); err == nil {
+				ctx = audit.WithActor(ctx, userID)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func hasRole(role users.Role, allowed []users.Role) bool {
+	for _, r := range allowed {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
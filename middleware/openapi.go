@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+
+	"project-manager/internal/openapi"
+	"project-manager/internal/problem"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/gorilla/mux"
+)
+
+// ValidateRequests rejects requests that break the contract of their route
+// in spec before the handler runs: bad path or query parameters get 400, an
+// unsupported body media type 415, a body over maxBodyBytes 413 and a body
+// that does not match its schema 422. Bodies are capped at maxBodyBytes even
+// on routes without a schema; 0 means no limit. Routes missing from spec
+// pass through. Wrap it inside RequireRole so that only callers allowed on
+// a route get to learn how their request was judged.
+func ValidateRequests(spec *openapi.Document, maxBodyBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if maxBodyBytes > 0 {
+				r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+			}
+
+			op := routeOperation(spec, r)
+			if op == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			err := spec.ValidateRequest(op, r, mux.Vars(r))
+			switch {
+			case err == nil:
+				next.ServeHTTP(w, r)
+			case err.Status == http.StatusBadRequest && len(err.Errors) > 0:
+				problem.InvalidParameters(w, r, err.Errors)
+			case err.Status == http.StatusUnprocessableEntity:
+				problem.ValidationErrors(w, r, err.Errors)
+			case err.Status == http.StatusUnsupportedMediaType:
+				problem.UnsupportedMediaType(w, r, err.Detail)
+			case err.Status == http.StatusRequestEntityTooLarge:
+				problem.ContentTooLarge(w, r, err.Detail)
+			default:
+				problem.BadRequest(w, r, err.Detail)
+			}
+		})
+	}
+}
+
+// ValidateResponses checks every response of a documented route against
+// spec and logs the ones that drift from it. The response is sent unchanged;
+// the check costs a copy of each body, so it is meant for development.
+func ValidateResponses(spec *openapi.Document) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			op := routeOperation(spec, r)
+			if op == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			status := 0
+			var body bytes.Buffer
+			hooked := httpsnoop.Wrap(w, httpsnoop.Hooks{
+				WriteHeader: func(next httpsnoop.WriteHeaderFunc) httpsnoop.WriteHeaderFunc {
+					return func(code int) {
+						if status == 0 {
+							status = code
+						}
+						next(code)
+					}
+				},
+				Write: func(next httpsnoop.WriteFunc) httpsnoop.WriteFunc {
+					return func(b []byte) (int, error) {
+						if status == 0 {
+							status = http.StatusOK
+						}
+						body.Write(b)
+						return next(b)
+					}
+				},
+			})
+			next.ServeHTTP(hooked, r)
+
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if err := spec.ValidateResponse(op, status, w.Header(), body.Bytes()); err != nil {
+				slog.ErrorContext(r.Context(), "response does not match the OpenAPI document",
+					"method", r.Method, "path", r.URL.Path, "status", status, "error", err)
+			}
+		})
+	}
+}
+
+// routeOperation returns the operation spec documents for the matched route
+func routeOperation(spec *openapi.Document, r *http.Request) *openapi.Operation {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return nil
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return nil
+	}
+	return spec.Operation(r.Method, template)
+}
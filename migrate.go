@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"project-manager/internal/config"
+	"project-manager/internal/database"
+	"project-manager/internal/logging"
+)
+
+const migrateUsage = `usage: migrate <command>
+
+  up               apply every pending migration
+  down [n]         revert the last n migrations (default 1)
+  status           show the applied version and pending migrations
+  diff <name>      generate a migration from ent/schema changes; needs DEV_DATABASE_URL
+  new <name>       create an empty migration for hand-written SQL
+  force <version>  mark version as applied without running it; a database
+                   deployed before versioned migrations is adopted with
+                   "force 20261016044446" (the baseline) followed by "up"`
+
+// runMigrate implements the "migrate" command for versioned schema changes
+func runMigrate(args []string) {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "%s\n\nflags:\n", migrateUsage)
+		flags.PrintDefaults()
+	}
+	cfg, err := config.Load(flags, args)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	logging.Setup(cfg.Log)
+
+	command, rest := flags.Arg(0), flags.Args()
+	if len(rest) > 0 {
+		rest = rest[1:]
+	}
+	ctx := context.Background()
+
+	switch command {
+	case "diff":
+		name := requireArg(flags, rest, "name")
+		if cfg.Database.DevURL == "" {
+			log.Fatal("migrate diff needs DEV_DATABASE_URL or database.dev_url in the config file")
+		}
+		if err := database.DiffMigration(ctx, cfg.Database.DevURL, name); err != nil {
+			log.Fatalf("Failed to generate migration: %v", err)
+		}
+		slog.Info("wrote migration", "name", name, "dir", database.MigrationsDir)
+		return
+	case "new":
+		path, err := database.NewMigration(requireArg(flags, rest, "name"))
+		if err != nil {
+			log.Fatalf("Failed to create migration: %v", err)
+		}
+		slog.Info("created migration", "up", path)
+		return
+	case "up", "down", "status", "force":
+	default:
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	db, err := database.Connect(cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to the database: %v", err)
+	}
+	defer db.Close()
+
+	switch command {
+	case "up":
+		err = database.MigrateUp(ctx, db)
+	case "down":
+		steps := 1
+		if len(rest) > 0 {
+			if steps, err = strconv.Atoi(rest[0]); err != nil || steps < 1 {
+				log.Fatalf("Invalid number of steps %q", rest[0])
+			}
+		}
+		err = database.MigrateDown(ctx, db, steps)
+	case "force":
+		version, convErr := strconv.Atoi(requireArg(flags, rest, "version"))
+		if convErr != nil {
+			log.Fatalf("Invalid version: %v", convErr)
+		}
+		err = database.MigrateForce(ctx, db, version)
+	}
+	if err != nil {
+		log.Fatalf("Failed to migrate: %v", err)
+	}
+
+	status, err := database.Status(ctx, db)
+	if err != nil {
+		log.Fatalf("Failed to read migration status: %v", err)
+	}
+	fmt.Printf("version: %d (latest %d)\n", status.Current, status.Latest)
+	if status.Dirty {
+		fmt.Println("dirty: the last migration failed part way")
+	}
+	for _, v := range status.Pending {
+		fmt.Printf("pending: %d\n", v)
+	}
+}
+
+func requireArg(flags *flag.FlagSet, args []string, name string) string {
+	if len(args) == 0 || args[0] == "" {
+		fmt.Fprintf(flags.Output(), "missing <%s>\n\n", name)
+		flags.Usage()
+		os.Exit(2)
+	}
+	return args[0]
+}
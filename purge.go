@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"log/slog"
+	"time"
+
+	"project-manager/internal/config"
+	"project-manager/internal/database"
+	"project-manager/internal/logging"
+)
+
+// runPurge implements "purge [-retention 720h]": it permanently deletes trash
+// older than the retention, which defaults to trash.retention (30 days)
+func runPurge(args []string) {
+	flags := flag.NewFlagSet("purge", flag.ExitOnError)
+	override := flags.Duration("retention", 0, "how long deleted items stay in the trash, overriding trash.retention")
+	cfg, err := config.Load(flags, args)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	logging.Setup(cfg.Log)
+	retention := cfg.Trash.Retention
+	if *override > 0 {
+		retention = *override
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	client, err := database.InitDB(cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to the database: %v", err)
+	}
+	defer client.Close()
+
+	cutoff := time.Now().Add(-retention)
+	result, err := database.Purge(context.Background(), client, cutoff)
+	if err != nil {
+		log.Fatalf("Failed to purge trash: %v", err)
+	}
+	slog.Info("purged trash", "deleted_before", cutoff.Format(time.RFC3339),
+		"projects", result.Projects, "packages", result.Packages, "clients", result.Clients)
+}